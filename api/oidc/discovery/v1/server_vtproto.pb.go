@@ -48,6 +48,49 @@ func (m *ServerMetadata) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.AuthorizationDetailsTypesSupported) > 0 {
+		for iNdEx := len(m.AuthorizationDetailsTypesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorizationDetailsTypesSupported[iNdEx])
+			copy(dAtA[i:], m.AuthorizationDetailsTypesSupported[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.AuthorizationDetailsTypesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.BackchannelUserCodeParameterSupported {
+		i--
+		if m.BackchannelUserCodeParameterSupported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.BackchannelTokenDeliveryModesSupported) > 0 {
+		for iNdEx := len(m.BackchannelTokenDeliveryModesSupported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BackchannelTokenDeliveryModesSupported[iNdEx])
+			copy(dAtA[i:], m.BackchannelTokenDeliveryModesSupported[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.BackchannelTokenDeliveryModesSupported[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.BackchannelAuthenticationEndpoint) > 0 {
+		i -= len(m.BackchannelAuthenticationEndpoint)
+		copy(dAtA[i:], m.BackchannelAuthenticationEndpoint)
+		i = encodeVarint(dAtA, i, uint64(len(m.BackchannelAuthenticationEndpoint)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
 	if len(m.SignedMetadata) > 0 {
 		i -= len(m.SignedMetadata)
 		copy(dAtA[i:], m.SignedMetadata)
@@ -978,6 +1021,25 @@ func (m *ServerMetadata) SizeVT() (n int) {
 	if l > 0 {
 		n += 2 + l + sov(uint64(l))
 	}
+	l = len(m.BackchannelAuthenticationEndpoint)
+	if l > 0 {
+		n += 2 + l + sov(uint64(l))
+	}
+	if len(m.BackchannelTokenDeliveryModesSupported) > 0 {
+		for _, s := range m.BackchannelTokenDeliveryModesSupported {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if m.BackchannelUserCodeParameterSupported {
+		n += 3
+	}
+	if len(m.AuthorizationDetailsTypesSupported) > 0 {
+		for _, s := range m.AuthorizationDetailsTypesSupported {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -2765,6 +2827,122 @@ func (m *ServerMetadata) UnmarshalVT(dAtA []byte) error {
 			}
 			m.SignedMetadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackchannelAuthenticationEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackchannelAuthenticationEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackchannelTokenDeliveryModesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackchannelTokenDeliveryModesSupported = append(m.BackchannelTokenDeliveryModesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 59:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackchannelUserCodeParameterSupported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackchannelUserCodeParameterSupported = bool(v != 0)
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationDetailsTypesSupported", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizationDetailsTypesSupported = append(m.AuthorizationDetailsTypesSupported, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -358,6 +358,107 @@ func (x *GrantTokenExchange) GetActorTokenType() string {
 	return ""
 }
 
+// https://www.rfc-editor.org/rfc/rfc7523#section-2.1
+type GrantJWTBearer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// REQUIRED. A single JWT used as an authorization grant. The JWT
+	// identifies the subject on behalf of whom the access token is requested.
+	Assertion string `protobuf:"bytes,1,opt,name=assertion,proto3" json:"assertion,omitempty"`
+}
+
+func (x *GrantJWTBearer) Reset() {
+	*x = GrantJWTBearer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_flow_v1_token_grant_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantJWTBearer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantJWTBearer) ProtoMessage() {}
+
+func (x *GrantJWTBearer) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_flow_v1_token_grant_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantJWTBearer.ProtoReflect.Descriptor instead.
+func (*GrantJWTBearer) Descriptor() ([]byte, []int) {
+	return file_oidc_flow_v1_token_grant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GrantJWTBearer) GetAssertion() string {
+	if x != nil {
+		return x.Assertion
+	}
+	return ""
+}
+
+type GrantCIBA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// REQUIRED. The unique identifier to identify the authentication request
+	// made by the client, "auth_req_id" from the backchannel authentication
+	// response.
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#token_request
+	AuthReqId string `protobuf:"bytes,1,opt,name=auth_req_id,json=authReqId,proto3" json:"auth_req_id,omitempty"`
+}
+
+func (x *GrantCIBA) Reset() {
+	*x = GrantCIBA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_flow_v1_token_grant_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantCIBA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantCIBA) ProtoMessage() {}
+
+func (x *GrantCIBA) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_flow_v1_token_grant_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantCIBA.ProtoReflect.Descriptor instead.
+func (*GrantCIBA) Descriptor() ([]byte, []int) {
+	return file_oidc_flow_v1_token_grant_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GrantCIBA) GetAuthReqId() string {
+	if x != nil {
+		return x.AuthReqId
+	}
+	return ""
+}
+
 var File_oidc_flow_v1_token_grant_proto protoreflect.FileDescriptor
 
 var file_oidc_flow_v1_token_grant_proto_rawDesc = []byte{
@@ -403,18 +504,23 @@ var file_oidc_flow_v1_token_grant_proto_rawDesc = []byte{
 	0x15, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
 	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72,
 	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x9c, 0x01, 0x0a, 0x10,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31,
-	0x42, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x25, 0x7a, 0x6e, 0x74, 0x72, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c,
-	0x69, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x66, 0x6c, 0x6f, 0x77,
-	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6c, 0x6f, 0x77, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x46, 0x58,
-	0xaa, 0x02, 0x0c, 0x4f, 0x69, 0x64, 0x63, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0c, 0x4f, 0x69, 0x64, 0x63, 0x5c, 0x46, 0x6c, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x18, 0x4f, 0x69, 0x64, 0x63, 0x5c, 0x46, 0x6c, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x4f, 0x69, 0x64, 0x63,
-	0x3a, 0x3a, 0x46, 0x6c, 0x6f, 0x77, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x4a, 0x57, 0x54, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x09, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x43, 0x49, 0x42, 0x41, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x49, 0x64, 0x42, 0x9c, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x25, 0x7a, 0x6e, 0x74, 0x72, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x76, 0x31,
+	0x3b, 0x66, 0x6c, 0x6f, 0x77, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x46, 0x58, 0xaa, 0x02, 0x0c,
+	0x4f, 0x69, 0x64, 0x63, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x4f,
+	0x69, 0x64, 0x63, 0x5c, 0x46, 0x6c, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x4f, 0x69,
+	0x64, 0x63, 0x5c, 0x46, 0x6c, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x4f, 0x69, 0x64, 0x63, 0x3a, 0x3a, 0x46,
+	0x6c, 0x6f, 0x77, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -429,13 +535,15 @@ func file_oidc_flow_v1_token_grant_proto_rawDescGZIP() []byte {
 	return file_oidc_flow_v1_token_grant_proto_rawDescData
 }
 
-var file_oidc_flow_v1_token_grant_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_oidc_flow_v1_token_grant_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_oidc_flow_v1_token_grant_proto_goTypes = []interface{}{
 	(*GrantAuthorizationCode)(nil), // 0: oidc.flow.v1.GrantAuthorizationCode
 	(*GrantRefreshToken)(nil),      // 1: oidc.flow.v1.GrantRefreshToken
 	(*GrantDeviceCode)(nil),        // 2: oidc.flow.v1.GrantDeviceCode
 	(*GrantClientCredentials)(nil), // 3: oidc.flow.v1.GrantClientCredentials
 	(*GrantTokenExchange)(nil),     // 4: oidc.flow.v1.GrantTokenExchange
+	(*GrantJWTBearer)(nil),         // 5: oidc.flow.v1.GrantJWTBearer
+	(*GrantCIBA)(nil),              // 6: oidc.flow.v1.GrantCIBA
 }
 var file_oidc_flow_v1_token_grant_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -511,6 +619,30 @@ func file_oidc_flow_v1_token_grant_proto_init() {
 				return nil
 			}
 		}
+		file_oidc_flow_v1_token_grant_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantJWTBearer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_flow_v1_token_grant_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantCIBA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_oidc_flow_v1_token_grant_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_oidc_flow_v1_token_grant_proto_msgTypes[4].OneofWrappers = []interface{}{}
@@ -520,7 +652,7 @@ func file_oidc_flow_v1_token_grant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oidc_flow_v1_token_grant_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -49,6 +49,34 @@ func (m *Resource) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.IntrospectionClaims) > 0 {
+		for iNdEx := len(m.IntrospectionClaims) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IntrospectionClaims[iNdEx])
+			copy(dAtA[i:], m.IntrospectionClaims[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.IntrospectionClaims[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.IntrospectionClients) > 0 {
+		for iNdEx := len(m.IntrospectionClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IntrospectionClients[iNdEx])
+			copy(dAtA[i:], m.IntrospectionClients[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.IntrospectionClients[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RefreshTokenLifetime != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.RefreshTokenLifetime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccessTokenLifetime != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.AccessTokenLifetime))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Urls) > 0 {
 		for iNdEx := len(m.Urls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Urls[iNdEx])
@@ -106,6 +134,24 @@ func (m *Resource) SizeVT() (n int) {
 			n += 1 + l + sov(uint64(l))
 		}
 	}
+	if m.AccessTokenLifetime != nil {
+		n += 1 + sov(uint64(*m.AccessTokenLifetime))
+	}
+	if m.RefreshTokenLifetime != nil {
+		n += 1 + sov(uint64(*m.RefreshTokenLifetime))
+	}
+	if len(m.IntrospectionClients) > 0 {
+		for _, s := range m.IntrospectionClients {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.IntrospectionClaims) > 0 {
+		for _, s := range m.IntrospectionClaims {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -241,6 +287,110 @@ func (m *Resource) UnmarshalVT(dAtA []byte) error {
 			}
 			m.Urls = append(m.Urls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenLifetime", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccessTokenLifetime = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshTokenLifetime", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefreshTokenLifetime = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntrospectionClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntrospectionClients = append(m.IntrospectionClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntrospectionClaims", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntrospectionClaims = append(m.IntrospectionClaims, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -0,0 +1,180 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: oidc/scope/v1/scope.proto
+
+package scopev1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Scope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Scope) Reset() {
+	*x = Scope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_scope_v1_scope_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scope) ProtoMessage() {}
+
+func (x *Scope) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_scope_v1_scope_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scope.ProtoReflect.Descriptor instead.
+func (*Scope) Descriptor() ([]byte, []int) {
+	return file_oidc_scope_v1_scope_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Scope) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scope) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_oidc_scope_v1_scope_proto protoreflect.FileDescriptor
+
+var file_oidc_scope_v1_scope_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6f, 0x69, 0x64,
+	0x63, 0x2e, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x3d, 0x0a, 0x05, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x9e, 0x01, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x0a, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x7a,
+	0x6e, 0x74, 0x72, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x53, 0x58, 0xaa, 0x02, 0x0d, 0x4f,
+	0x69, 0x64, 0x63, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4f,
+	0x69, 0x64, 0x63, 0x5c, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4f,
+	0x69, 0x64, 0x63, 0x5c, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4f, 0x69, 0x64, 0x63, 0x3a,
+	0x3a, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_oidc_scope_v1_scope_proto_rawDescOnce sync.Once
+	file_oidc_scope_v1_scope_proto_rawDescData = file_oidc_scope_v1_scope_proto_rawDesc
+)
+
+func file_oidc_scope_v1_scope_proto_rawDescGZIP() []byte {
+	file_oidc_scope_v1_scope_proto_rawDescOnce.Do(func() {
+		file_oidc_scope_v1_scope_proto_rawDescData = protoimpl.X.CompressGZIP(file_oidc_scope_v1_scope_proto_rawDescData)
+	})
+	return file_oidc_scope_v1_scope_proto_rawDescData
+}
+
+var file_oidc_scope_v1_scope_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_oidc_scope_v1_scope_proto_goTypes = []interface{}{
+	(*Scope)(nil), // 0: oidc.scope.v1.Scope
+}
+var file_oidc_scope_v1_scope_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_oidc_scope_v1_scope_proto_init() }
+func file_oidc_scope_v1_scope_proto_init() {
+	if File_oidc_scope_v1_scope_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oidc_scope_v1_scope_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_oidc_scope_v1_scope_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_oidc_scope_v1_scope_proto_goTypes,
+		DependencyIndexes: file_oidc_scope_v1_scope_proto_depIdxs,
+		MessageInfos:      file_oidc_scope_v1_scope_proto_msgTypes,
+	}.Build()
+	File_oidc_scope_v1_scope_proto = out.File
+	file_oidc_scope_v1_scope_proto_rawDesc = nil
+	file_oidc_scope_v1_scope_proto_goTypes = nil
+	file_oidc_scope_v1_scope_proto_depIdxs = nil
+}
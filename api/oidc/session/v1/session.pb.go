@@ -49,6 +49,7 @@ const (
 	DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING DeviceCodeStatus = 2
 	DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING  DeviceCodeStatus = 3
 	DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED             DeviceCodeStatus = 4
+	DeviceCodeStatus_DEVICE_CODE_STATUS_DENIED                DeviceCodeStatus = 5
 )
 
 // Enum value maps for DeviceCodeStatus.
@@ -59,6 +60,7 @@ var (
 		2: "DEVICE_CODE_STATUS_AUTHORIZATION_PENDING",
 		3: "DEVICE_CODE_STATUS_CONFIRMATION_PENDING",
 		4: "DEVICE_CODE_STATUS_VALIDATED",
+		5: "DEVICE_CODE_STATUS_DENIED",
 	}
 	DeviceCodeStatus_value = map[string]int32{
 		"DEVICE_CODE_STATUS_UNSPECIFIED":           0,
@@ -66,6 +68,7 @@ var (
 		"DEVICE_CODE_STATUS_AUTHORIZATION_PENDING": 2,
 		"DEVICE_CODE_STATUS_CONFIRMATION_PENDING":  3,
 		"DEVICE_CODE_STATUS_VALIDATED":             4,
+		"DEVICE_CODE_STATUS_DENIED":                5,
 	}
 )
 
@@ -96,15 +99,72 @@ func (DeviceCodeStatus) EnumDescriptor() ([]byte, []int) {
 	return file_oidc_session_v1_session_proto_rawDescGZIP(), []int{0}
 }
 
+type BackchannelAuthenticationStatus int32
+
+const (
+	BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_UNSPECIFIED           BackchannelAuthenticationStatus = 0
+	BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_UNKNOWN               BackchannelAuthenticationStatus = 1
+	BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING BackchannelAuthenticationStatus = 2
+	BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED            BackchannelAuthenticationStatus = 3
+	BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_DENIED                BackchannelAuthenticationStatus = 4
+)
+
+// Enum value maps for BackchannelAuthenticationStatus.
+var (
+	BackchannelAuthenticationStatus_name = map[int32]string{
+		0: "BACKCHANNEL_AUTHENTICATION_STATUS_UNSPECIFIED",
+		1: "BACKCHANNEL_AUTHENTICATION_STATUS_UNKNOWN",
+		2: "BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING",
+		3: "BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED",
+		4: "BACKCHANNEL_AUTHENTICATION_STATUS_DENIED",
+	}
+	BackchannelAuthenticationStatus_value = map[string]int32{
+		"BACKCHANNEL_AUTHENTICATION_STATUS_UNSPECIFIED":           0,
+		"BACKCHANNEL_AUTHENTICATION_STATUS_UNKNOWN":               1,
+		"BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING": 2,
+		"BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED":            3,
+		"BACKCHANNEL_AUTHENTICATION_STATUS_DENIED":                4,
+	}
+)
+
+func (x BackchannelAuthenticationStatus) Enum() *BackchannelAuthenticationStatus {
+	p := new(BackchannelAuthenticationStatus)
+	*p = x
+	return p
+}
+
+func (x BackchannelAuthenticationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackchannelAuthenticationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_oidc_session_v1_session_proto_enumTypes[1].Descriptor()
+}
+
+func (BackchannelAuthenticationStatus) Type() protoreflect.EnumType {
+	return &file_oidc_session_v1_session_proto_enumTypes[1]
+}
+
+func (x BackchannelAuthenticationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackchannelAuthenticationStatus.Descriptor instead.
+func (BackchannelAuthenticationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_oidc_session_v1_session_proto_rawDescGZIP(), []int{1}
+}
+
 type AuthorizationCodeSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Client  *v1.Client                `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	Issuer  string                    `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	Subject string                    `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	Request *v11.AuthorizationRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	Client   *v1.Client                `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Issuer   string                    `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject  string                    `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Request  *v11.AuthorizationRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	AuthTime *uint64                   `protobuf:"fixed64,5,opt,name=auth_time,json=authTime,proto3,oneof" json:"auth_time,omitempty"`
+	Acr      *string                   `protobuf:"bytes,6,opt,name=acr,proto3,oneof" json:"acr,omitempty"`
 }
 
 func (x *AuthorizationCodeSession) Reset() {
@@ -167,6 +227,20 @@ func (x *AuthorizationCodeSession) GetRequest() *v11.AuthorizationRequest {
 	return nil
 }
 
+func (x *AuthorizationCodeSession) GetAuthTime() uint64 {
+	if x != nil && x.AuthTime != nil {
+		return *x.AuthTime
+	}
+	return 0
+}
+
+func (x *AuthorizationCodeSession) GetAcr() string {
+	if x != nil && x.Acr != nil {
+		return *x.Acr
+	}
+	return ""
+}
+
 type DeviceCodeSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -182,6 +256,12 @@ type DeviceCodeSession struct {
 	Subject          *string                         `protobuf:"bytes,8,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
 	Scope            *string                         `protobuf:"bytes,9,opt,name=scope,proto3,oneof" json:"scope,omitempty"`
 	Audience         *string                         `protobuf:"bytes,10,opt,name=audience,proto3,oneof" json:"audience,omitempty"`
+	AuthTime         *uint64                         `protobuf:"fixed64,11,opt,name=auth_time,json=authTime,proto3,oneof" json:"auth_time,omitempty"`
+	Acr              *string                         `protobuf:"bytes,12,opt,name=acr,proto3,oneof" json:"acr,omitempty"`
+	// Minimum polling interval in seconds, increased on each slow_down error.
+	Interval uint64 `protobuf:"fixed64,13,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Unix timestamp of the last token request.
+	LastPolledAt uint64 `protobuf:"fixed64,14,opt,name=last_polled_at,json=lastPolledAt,proto3" json:"last_polled_at,omitempty"`
 }
 
 func (x *DeviceCodeSession) Reset() {
@@ -286,6 +366,171 @@ func (x *DeviceCodeSession) GetAudience() string {
 	return ""
 }
 
+func (x *DeviceCodeSession) GetAuthTime() uint64 {
+	if x != nil && x.AuthTime != nil {
+		return *x.AuthTime
+	}
+	return 0
+}
+
+func (x *DeviceCodeSession) GetAcr() string {
+	if x != nil && x.Acr != nil {
+		return *x.Acr
+	}
+	return ""
+}
+
+func (x *DeviceCodeSession) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *DeviceCodeSession) GetLastPolledAt() uint64 {
+	if x != nil {
+		return x.LastPolledAt
+	}
+	return 0
+}
+
+type BackchannelAuthenticationSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client    *v1.Client                            `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Issuer    string                                `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ExpiresAt uint64                                `protobuf:"fixed64,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Request   *v11.BackchannelAuthenticationRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	Status    BackchannelAuthenticationStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=oidc.session.v1.BackchannelAuthenticationStatus" json:"status,omitempty"`
+	Subject   *string                               `protobuf:"bytes,6,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	Scope     *string                               `protobuf:"bytes,7,opt,name=scope,proto3,oneof" json:"scope,omitempty"`
+	Audience  *string                               `protobuf:"bytes,8,opt,name=audience,proto3,oneof" json:"audience,omitempty"`
+	AuthTime  *uint64                               `protobuf:"fixed64,9,opt,name=auth_time,json=authTime,proto3,oneof" json:"auth_time,omitempty"`
+	Acr       *string                               `protobuf:"bytes,10,opt,name=acr,proto3,oneof" json:"acr,omitempty"`
+	// Minimum polling interval in seconds, increased on each slow_down error.
+	Interval uint64 `protobuf:"fixed64,11,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Unix timestamp of the last token request.
+	LastPolledAt uint64 `protobuf:"fixed64,12,opt,name=last_polled_at,json=lastPolledAt,proto3" json:"last_polled_at,omitempty"`
+}
+
+func (x *BackchannelAuthenticationSession) Reset() {
+	*x = BackchannelAuthenticationSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_session_v1_session_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackchannelAuthenticationSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackchannelAuthenticationSession) ProtoMessage() {}
+
+func (x *BackchannelAuthenticationSession) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_session_v1_session_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackchannelAuthenticationSession.ProtoReflect.Descriptor instead.
+func (*BackchannelAuthenticationSession) Descriptor() ([]byte, []int) {
+	return file_oidc_session_v1_session_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BackchannelAuthenticationSession) GetClient() *v1.Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *BackchannelAuthenticationSession) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationSession) GetExpiresAt() uint64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *BackchannelAuthenticationSession) GetRequest() *v11.BackchannelAuthenticationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *BackchannelAuthenticationSession) GetStatus() BackchannelAuthenticationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_UNSPECIFIED
+}
+
+func (x *BackchannelAuthenticationSession) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationSession) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationSession) GetAudience() string {
+	if x != nil && x.Audience != nil {
+		return *x.Audience
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationSession) GetAuthTime() uint64 {
+	if x != nil && x.AuthTime != nil {
+		return *x.AuthTime
+	}
+	return 0
+}
+
+func (x *BackchannelAuthenticationSession) GetAcr() string {
+	if x != nil && x.Acr != nil {
+		return *x.Acr
+	}
+	return ""
+}
+
+func (x *BackchannelAuthenticationSession) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *BackchannelAuthenticationSession) GetLastPolledAt() uint64 {
+	if x != nil {
+		return x.LastPolledAt
+	}
+	return 0
+}
+
 var File_oidc_session_v1_session_proto protoreflect.FileDescriptor
 
 var file_oidc_session_v1_session_proto_rawDesc = []byte{
@@ -297,7 +542,7 @@ var file_oidc_session_v1_session_proto_rawDesc = []byte{
 	0x69, 0x64, 0x63, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x66, 0x6c, 0x6f,
 	0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xba, 0x01, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x6f, 0x74, 0x6f, 0x22, 0x89, 0x02, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
 	0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x76,
@@ -309,60 +554,129 @@ var file_oidc_session_v1_session_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
 	0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xc5, 0x03, 0x0a, 0x11, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x06, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x42, 0x0a,
-	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a,
-	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2a, 0xd3, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a,
-	0x1e, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x01, 0x12, 0x2c, 0x0a, 0x28, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x12, 0x20, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x61, 0x63, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x03, 0x61, 0x63, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x61, 0x63, 0x72, 0x22,
+	0xd6, 0x04, 0x0a, 0x11, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x06, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6f, 0x69, 0x64, 0x63, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x06, 0x48, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x61, 0x63, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x03, 0x61, 0x63, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x06, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x06, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x61, 0x63, 0x72, 0x22, 0xac, 0x04, 0x0a, 0x20, 0x42, 0x61, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30,
+	0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x06, 0x48, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x61, 0x63, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x03, 0x61, 0x63, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x06, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x61, 0x63, 0x72, 0x2a, 0xf2, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e,
+	0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01,
+	0x12, 0x2c, 0x0a, 0x28, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x2b,
+	0x0a, 0x27, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x44,
+	0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a,
+	0x19, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xa0, 0x02, 0x0a,
+	0x1f, 0x42, 0x61, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x31, 0x0a, 0x2d, 0x42, 0x41, 0x43, 0x4b, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29, 0x42, 0x41, 0x43, 0x4b, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x01, 0x12, 0x3b, 0x0a, 0x37, 0x42, 0x41, 0x43, 0x4b, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
 	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
-	0x2b, 0x0a, 0x27, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c,
-	0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x42, 0xae,
-	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x7a, 0x6e, 0x74, 0x72, 0x2e, 0x69, 0x6f, 0x2f,
-	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x53, 0x58, 0xaa, 0x02, 0x0f, 0x4f, 0x69, 0x64, 0x63,
-	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x4f, 0x69,
-	0x64, 0x63, 0x5c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b,
-	0x4f, 0x69, 0x64, 0x63, 0x5c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4f, 0x69,
-	0x64, 0x63, 0x3a, 0x3a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x30, 0x0a, 0x2c, 0x42, 0x41, 0x43, 0x4b, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x2c, 0x0a, 0x28, 0x42, 0x41, 0x43, 0x4b, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x04, 0x42,
+	0xae, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x7a, 0x6e, 0x74, 0x72, 0x2e, 0x69, 0x6f,
+	0x2f, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x53, 0x58, 0xaa, 0x02, 0x0f, 0x4f, 0x69, 0x64,
+	0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x4f,
+	0x69, 0x64, 0x63, 0x5c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1b, 0x4f, 0x69, 0x64, 0x63, 0x5c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4f,
+	0x69, 0x64, 0x63, 0x3a, 0x3a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -377,27 +691,33 @@ func file_oidc_session_v1_session_proto_rawDescGZIP() []byte {
 	return file_oidc_session_v1_session_proto_rawDescData
 }
 
-var file_oidc_session_v1_session_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_oidc_session_v1_session_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_oidc_session_v1_session_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_oidc_session_v1_session_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_oidc_session_v1_session_proto_goTypes = []interface{}{
-	(DeviceCodeStatus)(0),                  // 0: oidc.session.v1.DeviceCodeStatus
-	(*AuthorizationCodeSession)(nil),       // 1: oidc.session.v1.AuthorizationCodeSession
-	(*DeviceCodeSession)(nil),              // 2: oidc.session.v1.DeviceCodeSession
-	(*v1.Client)(nil),                      // 3: oidc.client.v1.Client
-	(*v11.AuthorizationRequest)(nil),       // 4: oidc.flow.v1.AuthorizationRequest
-	(*v11.DeviceAuthorizationRequest)(nil), // 5: oidc.flow.v1.DeviceAuthorizationRequest
+	(DeviceCodeStatus)(0),                        // 0: oidc.session.v1.DeviceCodeStatus
+	(BackchannelAuthenticationStatus)(0),         // 1: oidc.session.v1.BackchannelAuthenticationStatus
+	(*AuthorizationCodeSession)(nil),             // 2: oidc.session.v1.AuthorizationCodeSession
+	(*DeviceCodeSession)(nil),                    // 3: oidc.session.v1.DeviceCodeSession
+	(*BackchannelAuthenticationSession)(nil),     // 4: oidc.session.v1.BackchannelAuthenticationSession
+	(*v1.Client)(nil),                            // 5: oidc.client.v1.Client
+	(*v11.AuthorizationRequest)(nil),             // 6: oidc.flow.v1.AuthorizationRequest
+	(*v11.DeviceAuthorizationRequest)(nil),       // 7: oidc.flow.v1.DeviceAuthorizationRequest
+	(*v11.BackchannelAuthenticationRequest)(nil), // 8: oidc.flow.v1.BackchannelAuthenticationRequest
 }
 var file_oidc_session_v1_session_proto_depIdxs = []int32{
-	3, // 0: oidc.session.v1.AuthorizationCodeSession.client:type_name -> oidc.client.v1.Client
-	4, // 1: oidc.session.v1.AuthorizationCodeSession.request:type_name -> oidc.flow.v1.AuthorizationRequest
-	3, // 2: oidc.session.v1.DeviceCodeSession.client:type_name -> oidc.client.v1.Client
-	5, // 3: oidc.session.v1.DeviceCodeSession.request:type_name -> oidc.flow.v1.DeviceAuthorizationRequest
+	5, // 0: oidc.session.v1.AuthorizationCodeSession.client:type_name -> oidc.client.v1.Client
+	6, // 1: oidc.session.v1.AuthorizationCodeSession.request:type_name -> oidc.flow.v1.AuthorizationRequest
+	5, // 2: oidc.session.v1.DeviceCodeSession.client:type_name -> oidc.client.v1.Client
+	7, // 3: oidc.session.v1.DeviceCodeSession.request:type_name -> oidc.flow.v1.DeviceAuthorizationRequest
 	0, // 4: oidc.session.v1.DeviceCodeSession.status:type_name -> oidc.session.v1.DeviceCodeStatus
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 5: oidc.session.v1.BackchannelAuthenticationSession.client:type_name -> oidc.client.v1.Client
+	8, // 6: oidc.session.v1.BackchannelAuthenticationSession.request:type_name -> oidc.flow.v1.BackchannelAuthenticationRequest
+	1, // 7: oidc.session.v1.BackchannelAuthenticationSession.status:type_name -> oidc.session.v1.BackchannelAuthenticationStatus
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_oidc_session_v1_session_proto_init() }
@@ -430,15 +750,29 @@ func file_oidc_session_v1_session_proto_init() {
 				return nil
 			}
 		}
+		file_oidc_session_v1_session_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackchannelAuthenticationSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_oidc_session_v1_session_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_oidc_session_v1_session_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_oidc_session_v1_session_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oidc_session_v1_session_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -51,6 +51,16 @@ func (m *IntrospectRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.JwtResponse {
+		i--
+		if m.JwtResponse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.TokenTypeHint != nil {
 		i -= len(*m.TokenTypeHint)
 		copy(dAtA[i:], *m.TokenTypeHint)
@@ -115,6 +125,13 @@ func (m *IntrospectResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.Jwt != nil {
+		i -= len(*m.Jwt)
+		copy(dAtA[i:], *m.Jwt)
+		i = encodeVarint(dAtA, i, uint64(len(*m.Jwt)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Token != nil {
 		size, err := m.Token.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -160,6 +177,9 @@ func (m *IntrospectRequest) SizeVT() (n int) {
 		l = len(*m.TokenTypeHint)
 		n += 1 + l + sov(uint64(l))
 	}
+	if m.JwtResponse {
+		n += 2
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -178,6 +198,10 @@ func (m *IntrospectResponse) SizeVT() (n int) {
 		l = m.Token.SizeVT()
 		n += 1 + l + sov(uint64(l))
 	}
+	if m.Jwt != nil {
+		l = len(*m.Jwt)
+		n += 1 + l + sov(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -344,6 +368,26 @@ func (m *IntrospectRequest) UnmarshalVT(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.TokenTypeHint = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JwtResponse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JwtResponse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -467,6 +511,39 @@ func (m *IntrospectResponse) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jwt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Jwt = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
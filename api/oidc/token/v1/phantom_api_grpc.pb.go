@@ -0,0 +1,125 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: oidc/token/v1/phantom_api.proto
+
+package tokenv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PhantomTokenService_Resolve_FullMethodName = "/oidc.token.v1.PhantomTokenService/Resolve"
+)
+
+// PhantomTokenServiceClient is the client API for PhantomTokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PhantomTokenServiceClient interface {
+	Resolve(ctx context.Context, in *PhantomTokenRequest, opts ...grpc.CallOption) (*PhantomTokenResponse, error)
+}
+
+type phantomTokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPhantomTokenServiceClient(cc grpc.ClientConnInterface) PhantomTokenServiceClient {
+	return &phantomTokenServiceClient{cc}
+}
+
+func (c *phantomTokenServiceClient) Resolve(ctx context.Context, in *PhantomTokenRequest, opts ...grpc.CallOption) (*PhantomTokenResponse, error) {
+	out := new(PhantomTokenResponse)
+	err := c.cc.Invoke(ctx, PhantomTokenService_Resolve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PhantomTokenServiceServer is the server API for PhantomTokenService service.
+// All implementations should embed UnimplementedPhantomTokenServiceServer
+// for forward compatibility
+type PhantomTokenServiceServer interface {
+	Resolve(context.Context, *PhantomTokenRequest) (*PhantomTokenResponse, error)
+}
+
+// UnimplementedPhantomTokenServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPhantomTokenServiceServer struct {
+}
+
+func (UnimplementedPhantomTokenServiceServer) Resolve(context.Context, *PhantomTokenRequest) (*PhantomTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
+
+// UnsafePhantomTokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PhantomTokenServiceServer will
+// result in compilation errors.
+type UnsafePhantomTokenServiceServer interface {
+	mustEmbedUnimplementedPhantomTokenServiceServer()
+}
+
+func RegisterPhantomTokenServiceServer(s grpc.ServiceRegistrar, srv PhantomTokenServiceServer) {
+	s.RegisterService(&PhantomTokenService_ServiceDesc, srv)
+}
+
+func _PhantomTokenService_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PhantomTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhantomTokenServiceServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhantomTokenService_Resolve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhantomTokenServiceServer).Resolve(ctx, req.(*PhantomTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PhantomTokenService_ServiceDesc is the grpc.ServiceDesc for PhantomTokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PhantomTokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "oidc.token.v1.PhantomTokenService",
+	HandlerType: (*PhantomTokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Resolve",
+			Handler:    _PhantomTokenService_Resolve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oidc/token/v1/phantom_api.proto",
+}
@@ -37,7 +37,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RevocatonService_Revoke_FullMethodName = "/oidc.token.v1.RevocatonService/Revoke"
+	RevocatonService_Revoke_FullMethodName          = "/oidc.token.v1.RevocatonService/Revoke"
+	RevocatonService_RevokeBySubject_FullMethodName = "/oidc.token.v1.RevocatonService/RevokeBySubject"
+	RevocatonService_RevokeByClient_FullMethodName  = "/oidc.token.v1.RevocatonService/RevokeByClient"
+	RevocatonService_RevokeByGrant_FullMethodName   = "/oidc.token.v1.RevocatonService/RevokeByGrant"
 )
 
 // RevocatonServiceClient is the client API for RevocatonService service.
@@ -45,6 +48,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RevocatonServiceClient interface {
 	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*RevokeResponse, error)
+	RevokeBySubject(ctx context.Context, in *RevokeBySubjectRequest, opts ...grpc.CallOption) (*RevokeBySubjectResponse, error)
+	RevokeByClient(ctx context.Context, in *RevokeByClientRequest, opts ...grpc.CallOption) (*RevokeByClientResponse, error)
+	RevokeByGrant(ctx context.Context, in *RevokeByGrantRequest, opts ...grpc.CallOption) (*RevokeByGrantResponse, error)
 }
 
 type revocatonServiceClient struct {
@@ -64,11 +70,41 @@ func (c *revocatonServiceClient) Revoke(ctx context.Context, in *RevokeRequest,
 	return out, nil
 }
 
+func (c *revocatonServiceClient) RevokeBySubject(ctx context.Context, in *RevokeBySubjectRequest, opts ...grpc.CallOption) (*RevokeBySubjectResponse, error) {
+	out := new(RevokeBySubjectResponse)
+	err := c.cc.Invoke(ctx, RevocatonService_RevokeBySubject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *revocatonServiceClient) RevokeByClient(ctx context.Context, in *RevokeByClientRequest, opts ...grpc.CallOption) (*RevokeByClientResponse, error) {
+	out := new(RevokeByClientResponse)
+	err := c.cc.Invoke(ctx, RevocatonService_RevokeByClient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *revocatonServiceClient) RevokeByGrant(ctx context.Context, in *RevokeByGrantRequest, opts ...grpc.CallOption) (*RevokeByGrantResponse, error) {
+	out := new(RevokeByGrantResponse)
+	err := c.cc.Invoke(ctx, RevocatonService_RevokeByGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RevocatonServiceServer is the server API for RevocatonService service.
 // All implementations should embed UnimplementedRevocatonServiceServer
 // for forward compatibility
 type RevocatonServiceServer interface {
 	Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error)
+	RevokeBySubject(context.Context, *RevokeBySubjectRequest) (*RevokeBySubjectResponse, error)
+	RevokeByClient(context.Context, *RevokeByClientRequest) (*RevokeByClientResponse, error)
+	RevokeByGrant(context.Context, *RevokeByGrantRequest) (*RevokeByGrantResponse, error)
 }
 
 // UnimplementedRevocatonServiceServer should be embedded to have forward compatible implementations.
@@ -78,6 +114,15 @@ type UnimplementedRevocatonServiceServer struct {
 func (UnimplementedRevocatonServiceServer) Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (UnimplementedRevocatonServiceServer) RevokeBySubject(context.Context, *RevokeBySubjectRequest) (*RevokeBySubjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBySubject not implemented")
+}
+func (UnimplementedRevocatonServiceServer) RevokeByClient(context.Context, *RevokeByClientRequest) (*RevokeByClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeByClient not implemented")
+}
+func (UnimplementedRevocatonServiceServer) RevokeByGrant(context.Context, *RevokeByGrantRequest) (*RevokeByGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeByGrant not implemented")
+}
 
 // UnsafeRevocatonServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RevocatonServiceServer will
@@ -108,6 +153,60 @@ func _RevocatonService_Revoke_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RevocatonService_RevokeBySubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBySubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevocatonServiceServer).RevokeBySubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RevocatonService_RevokeBySubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevocatonServiceServer).RevokeBySubject(ctx, req.(*RevokeBySubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RevocatonService_RevokeByClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeByClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevocatonServiceServer).RevokeByClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RevocatonService_RevokeByClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevocatonServiceServer).RevokeByClient(ctx, req.(*RevokeByClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RevocatonService_RevokeByGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeByGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevocatonServiceServer).RevokeByGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RevocatonService_RevokeByGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevocatonServiceServer).RevokeByGrant(ctx, req.(*RevokeByGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RevocatonService_ServiceDesc is the grpc.ServiceDesc for RevocatonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,6 +218,18 @@ var RevocatonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _RevocatonService_Revoke_Handler,
 		},
+		{
+			MethodName: "RevokeBySubject",
+			Handler:    _RevocatonService_RevokeBySubject_Handler,
+		},
+		{
+			MethodName: "RevokeByClient",
+			Handler:    _RevocatonService_RevokeByClient_Handler,
+		},
+		{
+			MethodName: "RevokeByGrant",
+			Handler:    _RevocatonService_RevokeByGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oidc/token/v1/revocation_api.proto",
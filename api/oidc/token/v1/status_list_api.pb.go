@@ -0,0 +1,267 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: oidc/token/v1/status_list_api.proto
+
+package tokenv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	v1 "zntr.io/solid/api/oidc/core/v1"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Token status list, resource servers retrieve the statuses of all indexed
+// tokens to check revocations without introspection.
+// https://datatracker.ietf.org/doc/draft-ietf-oauth-status-list/
+type StatusListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// REQUIRED. Token issuer URL.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+}
+
+func (x *StatusListRequest) Reset() {
+	*x = StatusListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_token_v1_status_list_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusListRequest) ProtoMessage() {}
+
+func (x *StatusListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_token_v1_status_list_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusListRequest.ProtoReflect.Descriptor instead.
+func (*StatusListRequest) Descriptor() ([]byte, []int) {
+	return file_oidc_token_v1_status_list_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatusListRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+type StatusListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error *v1.Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// OPTIONAL. The signed status list token.
+	StatusList *string `protobuf:"bytes,2,opt,name=status_list,json=statusList,proto3,oneof" json:"status_list,omitempty"`
+}
+
+func (x *StatusListResponse) Reset() {
+	*x = StatusListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_token_v1_status_list_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusListResponse) ProtoMessage() {}
+
+func (x *StatusListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_token_v1_status_list_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusListResponse.ProtoReflect.Descriptor instead.
+func (*StatusListResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_token_v1_status_list_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatusListResponse) GetError() *v1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *StatusListResponse) GetStatusList() string {
+	if x != nil && x.StatusList != nil {
+		return *x.StatusList
+	}
+	return ""
+}
+
+var File_oidc_token_v1_status_list_api_proto protoreflect.FileDescriptor
+
+var file_oidc_token_v1_status_list_api_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b,
+	0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x12, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x32, 0x61, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x20,
+	0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xa6, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x69,
+	0x64, 0x63, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x27, 0x7a, 0x6e, 0x74, 0x72, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x69, 0x64,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f,
+	0x76, 0x31, 0x3b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x54, 0x58,
+	0xaa, 0x02, 0x0d, 0x4f, 0x69, 0x64, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0d, 0x4f, 0x69, 0x64, 0x63, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x19, 0x4f, 0x69, 0x64, 0x63, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4f,
+	0x69, 0x64, 0x63, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_oidc_token_v1_status_list_api_proto_rawDescOnce sync.Once
+	file_oidc_token_v1_status_list_api_proto_rawDescData = file_oidc_token_v1_status_list_api_proto_rawDesc
+)
+
+func file_oidc_token_v1_status_list_api_proto_rawDescGZIP() []byte {
+	file_oidc_token_v1_status_list_api_proto_rawDescOnce.Do(func() {
+		file_oidc_token_v1_status_list_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_oidc_token_v1_status_list_api_proto_rawDescData)
+	})
+	return file_oidc_token_v1_status_list_api_proto_rawDescData
+}
+
+var file_oidc_token_v1_status_list_api_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_oidc_token_v1_status_list_api_proto_goTypes = []interface{}{
+	(*StatusListRequest)(nil),  // 0: oidc.token.v1.StatusListRequest
+	(*StatusListResponse)(nil), // 1: oidc.token.v1.StatusListResponse
+	(*v1.Error)(nil),           // 2: oidc.core.v1.Error
+}
+var file_oidc_token_v1_status_list_api_proto_depIdxs = []int32{
+	2, // 0: oidc.token.v1.StatusListResponse.error:type_name -> oidc.core.v1.Error
+	0, // 1: oidc.token.v1.StatusListService.Get:input_type -> oidc.token.v1.StatusListRequest
+	1, // 2: oidc.token.v1.StatusListService.Get:output_type -> oidc.token.v1.StatusListResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_oidc_token_v1_status_list_api_proto_init() }
+func file_oidc_token_v1_status_list_api_proto_init() {
+	if File_oidc_token_v1_status_list_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oidc_token_v1_status_list_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_token_v1_status_list_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_oidc_token_v1_status_list_api_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_oidc_token_v1_status_list_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oidc_token_v1_status_list_api_proto_goTypes,
+		DependencyIndexes: file_oidc_token_v1_status_list_api_proto_depIdxs,
+		MessageInfos:      file_oidc_token_v1_status_list_api_proto_msgTypes,
+	}.Build()
+	File_oidc_token_v1_status_list_api_proto = out.File
+	file_oidc_token_v1_status_list_api_proto_rawDesc = nil
+	file_oidc_token_v1_status_list_api_proto_goTypes = nil
+	file_oidc_token_v1_status_list_api_proto_depIdxs = nil
+}
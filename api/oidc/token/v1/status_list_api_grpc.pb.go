@@ -0,0 +1,125 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: oidc/token/v1/status_list_api.proto
+
+package tokenv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	StatusListService_Get_FullMethodName = "/oidc.token.v1.StatusListService/Get"
+)
+
+// StatusListServiceClient is the client API for StatusListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatusListServiceClient interface {
+	Get(ctx context.Context, in *StatusListRequest, opts ...grpc.CallOption) (*StatusListResponse, error)
+}
+
+type statusListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatusListServiceClient(cc grpc.ClientConnInterface) StatusListServiceClient {
+	return &statusListServiceClient{cc}
+}
+
+func (c *statusListServiceClient) Get(ctx context.Context, in *StatusListRequest, opts ...grpc.CallOption) (*StatusListResponse, error) {
+	out := new(StatusListResponse)
+	err := c.cc.Invoke(ctx, StatusListService_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatusListServiceServer is the server API for StatusListService service.
+// All implementations should embed UnimplementedStatusListServiceServer
+// for forward compatibility
+type StatusListServiceServer interface {
+	Get(context.Context, *StatusListRequest) (*StatusListResponse, error)
+}
+
+// UnimplementedStatusListServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStatusListServiceServer struct {
+}
+
+func (UnimplementedStatusListServiceServer) Get(context.Context, *StatusListRequest) (*StatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+
+// UnsafeStatusListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatusListServiceServer will
+// result in compilation errors.
+type UnsafeStatusListServiceServer interface {
+	mustEmbedUnimplementedStatusListServiceServer()
+}
+
+func RegisterStatusListServiceServer(s grpc.ServiceRegistrar, srv StatusListServiceServer) {
+	s.RegisterService(&StatusListService_ServiceDesc, srv)
+}
+
+func _StatusListService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusListServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusListService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusListServiceServer).Get(ctx, req.(*StatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StatusListService_ServiceDesc is the grpc.ServiceDesc for StatusListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatusListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "oidc.token.v1.StatusListService",
+	HandlerType: (*StatusListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _StatusListService_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oidc/token/v1/status_list_api.proto",
+}
@@ -26,6 +26,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/dchest/uniuri"
 	"github.com/go-jose/go-jose/v4"
@@ -36,15 +37,15 @@ import (
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/jarm"
 	"zntr.io/solid/sdk/jwsreq"
-	"zntr.io/solid/sdk/pairwise"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/token/jwt"
+	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/services"
 	"zntr.io/solid/server/storage"
 )
 
 // Authorization handles authorization HTTP requests.
-func Authorization(issuer string, authz services.Authorization, clients storage.ClientReader, jarmEncoder jarm.ResponseEncoder, pairwiseEncoder pairwise.Encoder) http.Handler {
+func Authorization(issuer string, authz services.Authorization, clients storage.ClientReader, jarmEncoder jarm.ResponseEncoder) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Only GET verb
 		if r.Method != http.MethodGet {
@@ -74,15 +75,6 @@ func Authorization(issuer string, authz services.Authorization, clients storage.
 			return
 		}
 
-		// Apply pairwise encoding
-		if client.SubjectType == oidc.SubjectTypePairwise {
-			sub, err = pairwiseEncoder.Encode(client.SectorIdentifier, sub)
-			if err != nil {
-				respond.WithError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
-				return
-			}
-		}
-
 		// Prepare client request decoder
 		clientRequestDecoder := jwsreq.AuthorizationRequestDecoder(jwt.DefaultVerifier(func(ctx context.Context) (*jose.JSONWebKeySet, error) {
 			var jwks jose.JSONWebKeySet
@@ -104,10 +96,11 @@ func Authorization(issuer string, authz services.Authorization, clients storage.
 
 		// Send request to reactor
 		res, err := authz.Authorize(ctx, &flowv1.AuthorizeRequest{
-			Client:  client,
-			Issuer:  issuer,
-			Subject: sub,
-			Request: ar,
+			Client:   client,
+			Issuer:   issuer,
+			Subject:  sub,
+			Request:  ar,
+			AuthTime: types.UInt64Ref(uint64(time.Now().Unix())),
 		})
 		if err != nil {
 			log.Println("unable to process authorization request:", err)
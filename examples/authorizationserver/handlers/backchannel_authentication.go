@@ -0,0 +1,97 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	"zntr.io/solid/examples/authorizationserver/respond"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/clientauthentication"
+	"zntr.io/solid/server/services"
+)
+
+// BackchannelAuthentication handles backchannel authentication HTTP requests.
+func BackchannelAuthentication(issuer string, backchannelz services.Backchannel) http.Handler {
+	type response struct {
+		AuthReqID string `json:"auth_req_id"`
+		ExpiresIn uint64 `json:"expires_in"`
+		Interval  uint64 `json:"interval,omitempty"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only POST verb
+		if r.Method != http.MethodPost {
+			respond.WithError(w, r, http.StatusMethodNotAllowed, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
+		r.ParseForm()
+
+		// Parameters
+		ctx := r.Context()
+
+		// Retrieve client front context
+		client, ok := clientauthentication.FromContext(ctx)
+		if client == nil || !ok {
+			respond.WithError(w, r, http.StatusUnauthorized, rfcerrors.InvalidClient().Build())
+			return
+		}
+
+		// Parse requested expiry
+		var requestedExpiry *uint64
+		if raw := r.FormValue("requested_expiry"); raw != "" {
+			expiry, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				respond.WithError(w, r, http.StatusBadRequest, rfcerrors.InvalidRequest().Build())
+				return
+			}
+			requestedExpiry = types.UInt64Ref(expiry)
+		}
+
+		// Send to reactor
+		res, err := backchannelz.Authenticate(ctx, &flowv1.BackchannelAuthenticationRequest{
+			Issuer:                  issuer,
+			Client:                  client,
+			Scope:                   r.FormValue("scope"),
+			ClientNotificationToken: optionalString(r.FormValue("client_notification_token")),
+			AcrValues:               optionalString(r.FormValue("acr_values")),
+			LoginHint:               r.FormValue("login_hint"),
+			BindingMessage:          optionalString(r.FormValue("binding_message")),
+			RequestedExpiry:         requestedExpiry,
+			Audience:                optionalString(r.FormValue("audience")),
+			Resource:                r.Form["resource"],
+		})
+		if err != nil {
+			log.Println("unable to process backchannel authentication request:", err)
+			respond.WithError(w, r, http.StatusBadRequest, res.Error)
+			return
+		}
+
+		// Send json reponse
+		respond.WithJSON(w, http.StatusOK, &response{
+			AuthReqID: res.AuthReqId,
+			ExpiresIn: res.ExpiresIn,
+			Interval:  res.Interval,
+		})
+	})
+}
@@ -0,0 +1,116 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	"zntr.io/solid/examples/authorizationserver/middleware"
+	"zntr.io/solid/examples/authorizationserver/respond"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/server/services"
+)
+
+// BackchannelValidation handles the end-user decision for a backchannel
+// authentication request. This endpoint simulates the authentication device.
+func BackchannelValidation(issuer string, backchannelz services.Backchannel) http.Handler {
+	httpClient := &http.Client{
+		Timeout: 5 * time.Second,
+	}
+
+	// Notify the client callback endpoint (ping mode)
+	notify := func(n *flowv1.BackchannelNotification) error {
+		// Prepare callback body
+		body, err := json.Marshal(map[string]string{
+			"auth_req_id": n.AuthReqId,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to encode notification: %w", err)
+		}
+
+		// Prepare request
+		req, err := http.NewRequest(http.MethodPost, n.Endpoint, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("unable to prepare notification request: %w", err)
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", n.ClientNotificationToken))
+		req.Header.Set("Content-Type", "application/json")
+
+		// Send request
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("unable to send notification: %w", err)
+		}
+		defer resp.Body.Close()
+
+		// Check status
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("client notification endpoint returned %d", resp.StatusCode)
+		}
+
+		// No error
+		return nil
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only POST verb
+		if r.Method != http.MethodPost {
+			respond.WithError(w, r, http.StatusMethodNotAllowed, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
+		r.ParseForm()
+
+		ctx := r.Context()
+
+		// Retrieve subject form context
+		sub, ok := middleware.Subject(ctx)
+		if !ok || sub == "" {
+			respond.WithError(w, r, http.StatusUnauthorized, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
+		// Send request to reactor
+		res, err := backchannelz.Validate(ctx, &flowv1.BackchannelAuthenticationValidationRequest{
+			Issuer:    issuer,
+			Subject:   sub,
+			AuthReqId: r.PostFormValue("auth_req_id"),
+			Denied:    r.PostFormValue("deny") != "",
+		})
+		if err != nil {
+			log.Println("unable to process backchannel validation request:", err)
+			respond.WithError(w, r, http.StatusBadRequest, res.Error)
+			return
+		}
+
+		// Notify client
+		if res.Notification != nil {
+			if err := notify(res.Notification); err != nil {
+				log.Println("unable to notify client:", err)
+			}
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
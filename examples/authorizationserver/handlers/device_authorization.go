@@ -18,7 +18,6 @@
 package handlers
 
 import (
-	"fmt"
 	"log"
 	"net/http"
 
@@ -31,11 +30,12 @@ import (
 // DeviceAuthorization handles device authorization HTTP requests.
 func DeviceAuthorization(issuer string, devicez services.Device) http.Handler {
 	type response struct {
-		DeviceCode      string `json:"device_code"`
-		UserCode        string `json:"user_code"`
-		VerificationURI string `json:"verification_uri"`
-		ExpiresIn       uint64 `json:"expires_in"`
-		Interval        uint64 `json:"interval"`
+		DeviceCode              string `json:"device_code"`
+		UserCode                string `json:"user_code"`
+		VerificationURI         string `json:"verification_uri"`
+		VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+		ExpiresIn               uint64 `json:"expires_in"`
+		Interval                uint64 `json:"interval"`
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -56,6 +56,7 @@ func DeviceAuthorization(issuer string, devicez services.Device) http.Handler {
 			ClientId: r.FormValue("client_id"),
 			Scope:    optionalString(r.FormValue("scope")),
 			Audience: optionalString(r.FormValue("audience")),
+			Resource: r.Form["resource"],
 		})
 		if err != nil {
 			log.Println("unable to process device authorization request:", err)
@@ -65,11 +66,12 @@ func DeviceAuthorization(issuer string, devicez services.Device) http.Handler {
 
 		// Send json reponse
 		respond.WithJSON(w, http.StatusOK, &response{
-			DeviceCode:      res.DeviceCode,
-			UserCode:        res.UserCode,
-			VerificationURI: fmt.Sprintf("%s/device", issuer),
-			ExpiresIn:       res.ExpiresIn,
-			Interval:        res.Interval,
+			DeviceCode:              res.DeviceCode,
+			UserCode:                res.UserCode,
+			VerificationURI:         res.VerificationUri,
+			VerificationURIComplete: res.GetVerificationUriComplete(),
+			ExpiresIn:               res.ExpiresIn,
+			Interval:                res.Interval,
 		})
 	})
 }
@@ -47,19 +47,40 @@ func Device(issuer string, devicez services.Device) http.Handler {
   <body>
 	<form action="" method="post">
 	  <label for="user_code">Enter user code:
-		  <input type="text" name="user_code">
+		  <input type="text" name="user_code" value="{{ . }}">
 	  </label>
 	</form>
   </body>
 </html>`))
 
 		// Write template to output
-		if err := form.Execute(w, nil); err != nil {
+		if err := form.Execute(w, r.URL.Query().Get("user_code")); err != nil {
 			respond.WithError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
 			return
 		}
 	}
 
+	// Confirmation form
+	confirmForm := template.Must(template.New("user-code-confirm").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+  </head>
+  <body>
+	<p><b>{{ if .ClientName }}{{ .ClientName }}{{ else }}{{ .ClientId }}{{ end }}</b> is requesting access to your account.</p>
+	<ul>
+	  {{ with .Scope }}<li>Scope: {{ . }}</li>{{ end }}
+	  {{ with .Audience }}<li>Audience: {{ . }}</li>{{ end }}
+	  {{ range .Resource }}<li>Resource: {{ . }}</li>{{ end }}
+	</ul>
+	<form action="" method="post">
+	  <input type="hidden" name="user_code" value="{{ .UserCode }}">
+	  <input type="hidden" name="confirmation_code" value="{{ .ConfirmationCode }}">
+	  <button type="submit" name="approve" value="1">Approve</button>
+	  <button type="submit" name="deny" value="1">Deny</button>
+	</form>
+  </body>
+</html>`))
+
 	// Validate user code
 	validateUserCode := func(w http.ResponseWriter, r *http.Request, sub string) {
 		r.ParseForm()
@@ -70,17 +91,43 @@ func Device(issuer string, devicez services.Device) http.Handler {
 			return
 		}
 
-		// Send request to reactor
-		res, err := devicez.Validate(r.Context(), &flowv1.DeviceCodeValidationRequest{
+		// Prepare request
+		req := &flowv1.DeviceCodeValidationRequest{
 			Issuer:   issuer,
 			Subject:  sub,
 			UserCode: r.PostFormValue("user_code"),
-		})
+		}
+		if confirmationCode := r.PostFormValue("confirmation_code"); confirmationCode != "" {
+			req.ConfirmationCode = &confirmationCode
+			req.Denied = r.PostFormValue("deny") != ""
+		}
+
+		// Send request to reactor
+		res, err := devicez.Validate(r.Context(), req)
 		if err != nil {
 			log.Println("unable to process authorization request:", err)
 			respond.WithError(w, r, http.StatusBadRequest, res.Error)
 			return
 		}
+
+		// Decision registered
+		if req.ConfirmationCode != nil {
+			return
+		}
+
+		// Ask the user to confirm the request
+		if err := confirmForm.Execute(w, map[string]interface{}{
+			"UserCode":         req.UserCode,
+			"ConfirmationCode": res.GetConfirmationCode(),
+			"ClientId":         res.ClientId,
+			"ClientName":       res.ClientName,
+			"Scope":            res.GetScope(),
+			"Audience":         res.GetAudience(),
+			"Resource":         res.Resource,
+		}); err != nil {
+			respond.WithError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -28,7 +28,7 @@ import (
 )
 
 // Metadata handle OIDC Discovery HTTP requests.
-func Metadata(issuer string, signer token.Serializer) http.Handler {
+func Metadata(issuer string, signer token.Serializer, authorizationDetailsTypes []string) http.Handler {
 	// Prepare metadata
 	md := &discoveryv1.ServerMetadata{
 		Issuer:  issuer,
@@ -55,6 +55,7 @@ func Metadata(issuer string, signer token.Serializer) http.Handler {
 			oidc.GrantTypeRefreshToken,
 			oidc.GrantTypeDeviceCode,
 			oidc.GrantTypeTokenExchange,
+			oidc.GrantTypeCIBA,
 		},
 		TokenEndpoint: fmt.Sprintf("%s/token", issuer),
 		TokenEndpointAuthMethodsSupported: []string{
@@ -77,6 +78,9 @@ func Metadata(issuer string, signer token.Serializer) http.Handler {
 		PushedAuthorizationRequestEndpointAuthMethodsSupported: []string{oidc.AuthMethodPrivateKeyJWT, oidc.AuthMethodClientAttestationJWT},
 		RequestParameterSupported:                              true,
 		RequestObjectSigningAlgValuesSupported:                 []string{"ES384"},
+		BackchannelAuthenticationEndpoint:                      fmt.Sprintf("%s/bc-authorize", issuer),
+		BackchannelTokenDeliveryModesSupported:                 []string{oidc.BackchannelTokenDeliveryModePoll, oidc.BackchannelTokenDeliveryModePing},
+		AuthorizationDetailsTypesSupported:                     authorizationDetailsTypes,
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
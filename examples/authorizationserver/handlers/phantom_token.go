@@ -0,0 +1,68 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/examples/authorizationserver/respond"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/server/clientauthentication"
+	"zntr.io/solid/server/services"
+)
+
+// PhantomToken handles phantom token resolution HTTP requests sent by an API
+// gateway, the signed representation is returned with caching hints.
+func PhantomToken(issuer string, tokenz services.Token) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		var (
+			ctx   = r.Context()
+			token = r.FormValue("token")
+		)
+
+		// Retrieve client front context
+		client, ok := clientauthentication.FromContext(ctx)
+		if client == nil || !ok {
+			respond.WithError(w, r, http.StatusUnauthorized, rfcerrors.InvalidClient().Build())
+			return
+		}
+
+		// Send request to reactor
+		res, err := tokenz.ResolvePhantom(ctx, &tokenv1.PhantomTokenRequest{
+			Issuer: issuer,
+			Client: client,
+			Token:  token,
+		})
+		if err != nil {
+			log.Println("unable to process phantom token request:", err)
+			respond.WithError(w, r, http.StatusBadRequest, res.Error)
+			return
+		}
+
+		// Gateways can cache the signed representation for the given max age
+		w.Header().Set("Content-Type", "application/jwt")
+		w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", res.MaxAge))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(res.Token.GetPhantom()))
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"zntr.io/solid/examples/authorizationserver/respond"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/dpop"
+	"zntr.io/solid/sdk/rar"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/server/clientauthentication"
 	"zntr.io/solid/server/services"
@@ -38,20 +39,26 @@ import (
 // Token handles token HTTP requests.
 func Token(issuer string, tokenz services.Token, dpopVerifier dpop.Verifier) http.Handler {
 	type response struct {
-		AccessToken  string `json:"access_token"`
-		ExpiresIn    uint64 `json:"expires_in"`
-		TokenType    string `json:"token_type"`
-		RefreshToken string `json:"refresh_token,omitempty"`
-		Scope        string `json:"scope"`
+		AccessToken          string       `json:"access_token"`
+		ExpiresIn            uint64       `json:"expires_in"`
+		TokenType            string       `json:"token_type"`
+		RefreshToken         string       `json:"refresh_token,omitempty"`
+		IDToken              string       `json:"id_token,omitempty"`
+		Scope                string       `json:"scope"`
+		AuthorizationDetails []rar.Detail `json:"authorization_details,omitempty"`
 	}
 
 	messageBuilder := func(r *http.Request, client *clientv1.Client) *flowv1.TokenRequest {
 		grantType := r.FormValue("grant_type")
 
 		msg := &flowv1.TokenRequest{
-			Issuer:    issuer,
-			Client:    client,
-			GrantType: grantType,
+			Issuer:               issuer,
+			Client:               client,
+			GrantType:            grantType,
+			Scope:                optionalString(r.FormValue("scope")),
+			Audience:             optionalString(r.FormValue("audience")),
+			Resource:             r.Form["resource"],
+			AuthorizationDetails: r.FormValue("authorization_details"),
 		}
 
 		switch grantType {
@@ -79,6 +86,18 @@ func Token(issuer string, tokenz services.Token, dpopVerifier dpop.Verifier) htt
 					RefreshToken: r.FormValue("refresh_token"),
 				},
 			}
+		case oidc.GrantTypeJWTBearer:
+			msg.Grant = &flowv1.TokenRequest_JwtBearer{
+				JwtBearer: &flowv1.GrantJWTBearer{
+					Assertion: r.FormValue("assertion"),
+				},
+			}
+		case oidc.GrantTypeCIBA:
+			msg.Grant = &flowv1.TokenRequest_Ciba{
+				Ciba: &flowv1.GrantCIBA{
+					AuthReqId: r.FormValue("auth_req_id"),
+				},
+			}
 		}
 
 		// Return request
@@ -153,6 +172,15 @@ func Token(issuer string, tokenz services.Token, dpopVerifier dpop.Verifier) htt
 		if res.RefreshToken != nil {
 			jsonResponse.RefreshToken = res.RefreshToken.Value
 		}
+		if res.IdToken != nil {
+			jsonResponse.IDToken = res.IdToken.Value
+		}
+
+		// Return granted authorization details
+		// https://www.rfc-editor.org/rfc/rfc9396#section-7
+		if details, err := rar.Parse(res.AccessToken.Metadata.AuthorizationDetails); err == nil {
+			jsonResponse.AuthorizationDetails = details
+		}
 
 		// Send json reponse
 		respond.WithJSON(w, http.StatusOK, jsonResponse)
@@ -20,9 +20,11 @@ package handlers
 import (
 	"log"
 	"net/http"
+	"strings"
 
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/examples/authorizationserver/respond"
+	"zntr.io/solid/sdk/rar"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/token"
 	"zntr.io/solid/server/clientauthentication"
@@ -53,31 +55,49 @@ func TokenIntrospection(issuer string, tokenz services.Token) http.Handler {
 			Client:        client,
 			Token:         tokenRaw,
 			TokenTypeHint: optionalString(tokenTypeHintRaw),
+			// https://www.rfc-editor.org/rfc/rfc9701#section-4
+			JwtResponse: strings.Contains(r.Header.Get("Accept"), "application/token-introspection+jwt"),
 		}
 
 		// Send request to reactor
 		res, err := tokenz.Introspect(ctx, msg)
 		if err != nil {
-			log.Println("unable to process introspection request: %w", err)
+			log.Println("unable to process introspection request:", err)
 			respond.WithError(w, r, http.StatusBadRequest, res.Error)
 			return
 		}
 
+		// Send JWT-secured response
+		if res.Jwt != nil {
+			w.Header().Set("Content-Type", "application/token-introspection+jwt")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(res.GetJwt()))
+			return
+		}
+
 		active := (res.Token.Status == tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE) && token.IsUsable(res.Token)
 		resp := map[string]interface{}{
 			"active": active,
 		}
 		if active {
-			resp["scope"] = res.Token.Metadata.Scope
-			resp["client_id"] = res.Token.Metadata.ClientId
 			resp["exp"] = res.Token.Metadata.ExpiresAt
 			resp["iat"] = res.Token.Metadata.IssuedAt
 			resp["nbf"] = res.Token.Metadata.NotBefore
-			resp["sub"] = res.Token.Metadata.Subject
 			resp["aud"] = res.Token.Metadata.Audience
 			resp["iss"] = res.Token.Metadata.Issuer
 			resp["jti"] = res.Token.TokenId
 
+			// Add claims disclosed to the caller
+			if res.Token.Metadata.Scope != "" {
+				resp["scope"] = res.Token.Metadata.Scope
+			}
+			if res.Token.Metadata.ClientId != "" {
+				resp["client_id"] = res.Token.Metadata.ClientId
+			}
+			if res.Token.Metadata.Subject != "" {
+				resp["sub"] = res.Token.Metadata.Subject
+			}
+
 			// Add confirmation
 			if res.Token.Confirmation != nil {
 				resp["token_type"] = "DPoP"
@@ -94,6 +114,18 @@ func TokenIntrospection(issuer string, tokenz services.Token) http.Handler {
 			if res.Token.Metadata.AuthTime != nil {
 				resp["auth_time"] = res.Token.Metadata.AuthTime
 			}
+
+			// Add delegation chain
+			// https://www.rfc-editor.org/rfc/rfc8693.html#name-act-actor-claim
+			if act := token.ActorChain(res.Token.Actor); act != nil {
+				resp["act"] = act
+			}
+
+			// Add authorization details
+			// https://www.rfc-editor.org/rfc/rfc9396#section-9.1
+			if details, err := rar.Parse(res.Token.Metadata.AuthorizationDetails); err == nil && len(details) > 0 {
+				resp["authorization_details"] = details
+			}
 		}
 
 		// Send json reponse
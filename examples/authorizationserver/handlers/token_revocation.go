@@ -54,8 +54,12 @@ func TokenRevocation(issuer string, tokenz services.Token) http.Handler {
 			TokenTypeHint: optionalString(tokenTypeHint),
 		})
 		if err != nil {
-			log.Println("unable to process revocation request: %w", err)
-			respond.WithError(w, r, http.StatusBadRequest, res.Error)
+			log.Println("unable to process revocation request:", err)
+			status := http.StatusBadRequest
+			if res.Error.GetErr() == "server_error" {
+				status = http.StatusInternalServerError
+			}
+			respond.WithError(w, r, status, res.Error)
 			return
 		}
 	})
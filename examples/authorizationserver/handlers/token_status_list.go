@@ -0,0 +1,53 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/examples/authorizationserver/respond"
+	"zntr.io/solid/server/services"
+)
+
+// TokenStatusList handles token status list HTTP requests sent by resource
+// servers to check revocations without introspection.
+func TokenStatusList(issuer string, tokenz services.Token) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// Send request to reactor
+		res, err := tokenz.StatusList(ctx, &tokenv1.StatusListRequest{
+			Issuer: issuer,
+		})
+		if err != nil {
+			log.Println("unable to process status list request:", err)
+			status := http.StatusBadRequest
+			if res.Error.GetErr() == "server_error" {
+				status = http.StatusInternalServerError
+			}
+			respond.WithError(w, r, status, res.Error)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/statuslist+jwt")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(res.GetStatusList()))
+	})
+}
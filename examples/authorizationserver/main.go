@@ -1,6 +1,8 @@
 package main
 
 import (
+	"crypto"
+	"fmt"
 	"log"
 	"net/http"
 
@@ -12,38 +14,88 @@ import (
 	"zntr.io/solid/sdk/generator"
 	"zntr.io/solid/sdk/jarm"
 	"zntr.io/solid/sdk/pairwise"
+	sdktoken "zntr.io/solid/sdk/token"
 	"zntr.io/solid/sdk/token/jwt"
 	"zntr.io/solid/sdk/token/verifiable"
+	"zntr.io/solid/server/authorizationdetails"
 	"zntr.io/solid/server/services/authorization"
+	"zntr.io/solid/server/services/backchannel"
 	"zntr.io/solid/server/services/device"
 	"zntr.io/solid/server/services/token"
 	"zntr.io/solid/server/storage/inmemory"
 )
 
 func main() {
+	issuer := "http://127.0.0.1:8080"
+
 	// Generators
 	authorizationCodes := generator.DefaultAuthorizationCode()
 	requestURIs := generator.DefaultRequestURI()
 	deviceCodes := generator.DefaultDeviceCode()
 	deviceUserCodes := generator.DefaultDeviceUserCode()
+	authReqIDs := generator.DefaultAuthenticationRequestID()
 
 	// Create storage
 	clients := inmemory.Clients()
 	tokens := inmemory.Tokens()
 	resources := inmemory.Resources()
+	scopes := inmemory.Scopes()
 	proofs := inmemory.DPoPProofs()
 	authRequests := inmemory.AuthorizationRequests()
 	authSessions := inmemory.AuthorizationCodeSessions()
 	deviceSessions := inmemory.DeviceCodeSessions()
+	deviceUserCodeAttempts := inmemory.DeviceUserCodeAttempts()
+	backchannelSessions := inmemory.BackchannelAuthenticationSessions()
+	assertionJTIs := inmemory.AssertionJTIs()
 
 	// Token generator
 	accessTokens := verifiable.Token(verifiable.UUIDv7Source(), []byte("very-secret-key-for-access-token-verification"))
 	refreshTokens := verifiable.Token(verifiable.UUIDv7Source(), []byte("very-secret-key-for-refresh-token-verification"))
+	phantomTokens := verifiable.Token(verifiable.UUIDv7Source(), []byte("very-secret-key-for-phantom-token-verification"))
+
+	// Token lifetimes, shared by the token service and the generators
+	lifetimes := sdktoken.DefaultLifetimePolicy()
+
+	// Keys
+	keys := keyProvider()
+	keySet := keySetProvider()
+	pairwiseEncoder := pairwise.Hash([]byte("U|(vBPu45_Vkvv*Tr*8Y[^s?,$ka@bQziM5]9.+[{.n47]'zokA7-j8ypJ=W]WS"))
+	idTokens := sdktoken.IDToken(jwt.IDTokenSigner(jose.ES384, keys), crypto.SHA384, pairwiseEncoder, sdktoken.WithLifetimePolicy(lifetimes))
+	introspections := sdktoken.Introspection(string(jose.ES384), jwt.TokenIntrospection(jose.ES384, keys))
+	statusLists := sdktoken.StatusListToken(jwt.StatusListSigner(jose.ES384, keys))
+	signedPhantomTokens := sdktoken.AccessToken(jwt.AccessTokenSigner(jose.ES384, keys), sdktoken.WithLifetimePolicy(lifetimes))
+	tokenVerifier := jwt.DefaultVerifier(keySet, []jose.SignatureAlgorithm{jose.ES384})
+
+	// Authorization details
+	authorizationDetails, err := authorizationdetails.StaticRegistry(map[string]authorizationdetails.Validator{
+		"payment_initiation": authorizationdetails.ValidatorFunc(paymentInitiation),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Prepare services
-	authz := authorization.New(clients, authRequests, authSessions, authorizationCodes, requestURIs)
-	tokenz := token.New(accessTokens, refreshTokens, clients, authRequests, authSessions, deviceSessions, tokens, resources)
-	devicez := device.New(clients, deviceSessions, deviceCodes, deviceUserCodes)
+	authz := authorization.New(clients, authRequests, authSessions, authorizationCodes, requestURIs, resources,
+		authorization.WithScopes(scopes),
+		authorization.WithAuthorizationDetails(authorizationDetails),
+	)
+	tokenz := token.New(accessTokens, refreshTokens, clients, authRequests, authSessions, deviceSessions, tokens, resources,
+		token.WithIDTokenGenerator(idTokens),
+		token.WithPhantomTokens(phantomTokens, signedPhantomTokens),
+		token.WithIntrospectionGenerator(introspections),
+		token.WithLifetimePolicy(lifetimes),
+		token.WithStatusLists(statusLists, tokens),
+		token.WithTokenVerifier(tokenVerifier),
+		token.WithScopes(scopes),
+		token.WithAssertionJTIs(assertionJTIs),
+		token.WithBackchannelSessions(backchannelSessions),
+	)
+	devicez := device.New(clients, deviceSessions, deviceCodes, deviceUserCodes, deviceUserCodeAttempts, resources, fmt.Sprintf("%s/device", issuer),
+		device.WithScopes(scopes),
+	)
+	backchannelz := backchannel.New(backchannelSessions, authReqIDs, resources,
+		backchannel.WithScopes(scopes),
+	)
 
 	// Middlewares
 	secHeaders := middleware.SecurityHaders()
@@ -51,24 +103,24 @@ func main() {
 	clientAuth := middleware.ClientAuthentication(clients, []jose.SignatureAlgorithm{jose.ES256, jose.ES384})
 
 	// Request encoders
-	keys := keyProvider()
-	keySet := keySetProvider()
 	jarmEncoder := jarm.Encoder(jwt.JARMSigner(jose.ES384, keys))
 	dpopVerifier := dpop.DefaultVerifier(proofs, jwt.DefaultVerifier(keySet, []jose.SignatureAlgorithm{jose.ES384}))
-	pairwiseEncoder := pairwise.Hash([]byte("U|(vBPu45_Vkvv*Tr*8Y[^s?,$ka@bQziM5]9.+[{.n47]'zokA7-j8ypJ=W]WS"))
-	issuer := "http://127.0.0.1:8080"
 
 	// Create router
-	http.Handle("/.well-known/oauth-authorization-server", handlers.Metadata(issuer, jwt.ServerMetadata(jose.ES384, keys)))
-	http.Handle("/.well-known/openid-configuration", handlers.Metadata(issuer, jwt.ServerMetadata(jose.ES384, keys)))
+	http.Handle("/.well-known/oauth-authorization-server", handlers.Metadata(issuer, jwt.ServerMetadata(jose.ES384, keys), authorizationDetails.Types()))
+	http.Handle("/.well-known/openid-configuration", handlers.Metadata(issuer, jwt.ServerMetadata(jose.ES384, keys), authorizationDetails.Types()))
 	http.Handle("/keys", handlers.JWKS(keySet))
 	http.Handle("/par", middleware.Adapt(handlers.PushedAuthorizationRequest(issuer, authz, dpopVerifier), clientAuth))
-	http.Handle("/authorize", middleware.Adapt(handlers.Authorization(issuer, authz, clients, jarmEncoder, pairwiseEncoder), secHeaders, basicAuth))
+	http.Handle("/authorize", middleware.Adapt(handlers.Authorization(issuer, authz, clients, jarmEncoder), secHeaders, basicAuth))
 	http.Handle("/token", middleware.Adapt(handlers.Token(issuer, tokenz, dpopVerifier), clientAuth))
 	http.Handle("/token/introspect", middleware.Adapt(handlers.TokenIntrospection(issuer, tokenz), clientAuth))
 	http.Handle("/token/revoke", middleware.Adapt(handlers.TokenRevocation(issuer, tokenz), clientAuth))
+	http.Handle("/token/status", handlers.TokenStatusList(issuer, tokenz))
+	http.Handle("/token/phantom", middleware.Adapt(handlers.PhantomToken(issuer, tokenz), clientAuth))
 	http.Handle("/device/authorize", middleware.Adapt(handlers.DeviceAuthorization(issuer, devicez), clientAuth))
 	http.Handle("/device", middleware.Adapt(handlers.Device(issuer, devicez), secHeaders, basicAuth))
+	http.Handle("/bc-authorize", middleware.Adapt(handlers.BackchannelAuthentication(issuer, backchannelz), clientAuth))
+	http.Handle("/bc-authorize/validate", middleware.Adapt(handlers.BackchannelValidation(issuer, backchannelz), secHeaders, basicAuth))
 
 	log.Fatal(http.ListenAndServe(":8080", nil))
 }
@@ -3,10 +3,14 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/go-jose/go-jose/v4"
 
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	"zntr.io/solid/sdk/jwk"
+	"zntr.io/solid/sdk/rar"
+	"zntr.io/solid/server/authorizationdetails"
 )
 
 var jwkPrivateKey = []byte(`{
@@ -53,3 +57,20 @@ func keySetProvider() jwk.KeySetProviderFunc {
 		}, nil
 	}
 }
+
+// paymentInitiation validates `payment_initiation` authorization details.
+func paymentInitiation(_ context.Context, _ *clientv1.Client, detail rar.Detail) error {
+	amount, ok := detail["instructedAmount"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("instructedAmount is mandatory: %w", authorizationdetails.ErrInvalidAuthorizationDetails)
+	}
+	if currency, _ := amount["currency"].(string); currency == "" {
+		return fmt.Errorf("instructedAmount currency is mandatory: %w", authorizationdetails.ErrInvalidAuthorizationDetails)
+	}
+	if value, _ := amount["amount"].(string); value == "" {
+		return fmt.Errorf("instructedAmount amount is mandatory: %w", authorizationdetails.ErrInvalidAuthorizationDetails)
+	}
+
+	// No error
+	return nil
+}
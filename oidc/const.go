@@ -34,6 +34,8 @@ const (
 	GrantTypeSAML2Bearer = "urn:ietf:params:oauth:grant-type:saml2-bearer"
 	// GrantTypeTokenExchange represent token exchange flow (RFC8693)
 	GrantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	// GrantTypeCIBA represents Client Initiated Backchannel Authentication grant type.
+	GrantTypeCIBA = "urn:openid:params:grant-type:ciba"
 )
 
 // Scopes ----------------------------------------------------------------------
@@ -169,6 +171,16 @@ const (
 	TokenExchangeJWTType = "urn:ietf:params:oauth:token-type:jwt"
 )
 
+// Token Type Hints ------------------------------------------------------------
+// https://www.rfc-editor.org/rfc/rfc7009#section-2.1
+
+const (
+	// TokenTypeHintAccessToken indicates that the submitted token is an access token.
+	TokenTypeHintAccessToken = "access_token"
+	// TokenTypeHintRefreshToken indicates that the submitted token is a refresh token.
+	TokenTypeHintRefreshToken = "refresh_token"
+)
+
 // Prompt ----------------------------------------------------------------------
 
 const (
@@ -191,3 +203,18 @@ const (
 	// error, typically account_selection_required.
 	PromptSelectAccount = "select_account"
 )
+
+// Backchannel Token Delivery Modes --------------------------------------------
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.5
+
+const (
+	// BackchannelTokenDeliveryModePoll lets the client poll the token endpoint.
+	BackchannelTokenDeliveryModePoll = "poll"
+	// BackchannelTokenDeliveryModePing notifies the client callback endpoint
+	// once the end-user is authenticated, the client then calls the token
+	// endpoint.
+	BackchannelTokenDeliveryModePing = "ping"
+	// BackchannelTokenDeliveryModePush sends the tokens to the client callback
+	// endpoint.
+	BackchannelTokenDeliveryModePush = "push"
+)
@@ -34,6 +34,9 @@ type AuthorizationCode interface {
 // DeviceUserCode describes device user code generator contract.
 type DeviceUserCode interface {
 	Generate(ctx context.Context, issuer string) (string, error)
+	// Normalize converts an end-user input to the generated user code format,
+	// it returns an error when the input can't be a generated user code.
+	Normalize(ctx context.Context, issuer, in string) (string, error)
 }
 
 //go:generate mockgen -destination mock/device_code.gen.go -package mock zntr.io/solid/sdk/generator DeviceCode
@@ -58,3 +61,12 @@ type RequestURI interface {
 	Validate(ctx context.Context, issuer, in string) error
 	Generate(ctx context.Context, issuer string) (string, error)
 }
+
+//go:generate mockgen -destination mock/authentication_request_id.gen.go -package mock zntr.io/solid/sdk/generator AuthenticationRequestID
+
+// AuthenticationRequestID describes backchannel authentication request
+// identifier generator contract.
+type AuthenticationRequestID interface {
+	Validate(ctx context.Context, issuer, in string) error
+	Generate(ctx context.Context, issuer string) (string, error)
+}
@@ -0,0 +1,60 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package generator
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/dchest/uniuri"
+)
+
+const (
+	// DefaultAuthenticationRequestIDLen defines default backchannel
+	// authentication request identifier length.
+	DefaultAuthenticationRequestIDLen = 32
+)
+
+// DefaultAuthenticationRequestID returns the default backchannel authentication
+// request identifier generator.
+func DefaultAuthenticationRequestID() AuthenticationRequestID {
+	return &authenticationRequestIDGenerator{}
+}
+
+// -----------------------------------------------------------------------------
+
+type authenticationRequestIDGenerator struct{}
+
+func (c *authenticationRequestIDGenerator) Generate(_ context.Context, _ string) (string, error) {
+	code := uniuri.NewLen(DefaultAuthenticationRequestIDLen)
+	return code, nil
+}
+
+func (c *authenticationRequestIDGenerator) Validate(_ context.Context, issuer, in string) error {
+	// Normalize
+	in = strings.TrimSpace(in)
+
+	// Check length
+	if len(in) != DefaultAuthenticationRequestIDLen {
+		return errors.New("invalid authentication request identifier syntax")
+	}
+
+	// No error
+	return nil
+}
@@ -19,7 +19,9 @@ package generator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dchest/uniuri"
 )
@@ -39,6 +41,19 @@ var (
 	DefaultNumDeviceCodeCharset = []byte("0123456789")
 )
 
+var (
+	// alphaDeviceCodeConfusables maps characters commonly mistyped for the
+	// alphabetic charset.
+	alphaDeviceCodeConfusables = map[rune]rune{
+		'2': 'Z', '5': 'S', '6': 'G', '8': 'B', 'U': 'V',
+	}
+	// numDeviceCodeConfusables maps characters commonly mistyped for the
+	// numeric charset.
+	numDeviceCodeConfusables = map[rune]rune{
+		'O': '0', 'Q': '0', 'D': '0', 'I': '1', 'L': '1', 'Z': '2', 'S': '5', 'G': '6', 'B': '8',
+	}
+)
+
 // -----------------------------------------------------------------------------
 
 // DefaultDeviceUserCode returns the default device code generator.
@@ -53,6 +68,14 @@ func (c *deviceCodeAlphaGenerator) Generate(_ context.Context, _ string) (string
 	return fmt.Sprintf("%s-%s", code[:4], code[4:]), nil
 }
 
+func (c *deviceCodeAlphaGenerator) Normalize(_ context.Context, _, in string) (string, error) {
+	code, err := normalizeDeviceUserCode(in, DefaultAlphaDeviceCodeLen, DefaultAlphaDeviceCodeCharset, alphaDeviceCodeConfusables)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s", code[:4], code[4:]), nil
+}
+
 // -----------------------------------------------------------------------------
 
 // DefaultNumDeviceUserCode returns the default device code generator.
@@ -66,3 +89,47 @@ func (c *deviceCodeNumGenerator) Generate(_ context.Context, _ string) (string,
 	code := uniuri.NewLenChars(DefaultNumDeviceCodeLen, DefaultNumDeviceCodeCharset)
 	return fmt.Sprintf("%s-%s-%s", code[:3], code[3:6], code[6:]), nil
 }
+
+func (c *deviceCodeNumGenerator) Normalize(_ context.Context, _, in string) (string, error) {
+	code, err := normalizeDeviceUserCode(in, DefaultNumDeviceCodeLen, DefaultNumDeviceCodeCharset, numDeviceCodeConfusables)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%s", code[:3], code[3:6], code[6:]), nil
+}
+
+// -----------------------------------------------------------------------------
+
+// normalizeDeviceUserCode removes separators, upper-cases and replaces
+// confusable characters of the given input, then ensures the result matches
+// the expected code length and charset.
+func normalizeDeviceUserCode(in string, length int, charset []byte, confusables map[rune]rune) (string, error) {
+	var sb strings.Builder
+	for _, r := range strings.ToUpper(in) {
+		// Skip separators
+		switch r {
+		case '-', ' ', '.', '_':
+			continue
+		}
+
+		// Replace confusable characters
+		if c, ok := confusables[r]; ok {
+			r = c
+		}
+
+		// Check charset
+		if r > 0x7f || !strings.ContainsRune(string(charset), r) {
+			return "", errors.New("user code contains invalid characters")
+		}
+
+		sb.WriteRune(r)
+	}
+
+	// Check length
+	if sb.Len() != length {
+		return "", fmt.Errorf("user code must be %d characters long", length)
+	}
+
+	// No error
+	return sb.String(), nil
+}
@@ -40,3 +40,55 @@ func Test_devicecodeNumGenerator_Generate(t *testing.T) {
 	}
 	fmt.Println(got)
 }
+
+func Test_deviceUserCode_Normalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		gen     DeviceUserCode
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "alpha: blank", gen: DefaultDeviceUserCode(), in: "", wantErr: true},
+		{name: "alpha: too short", gen: DefaultDeviceUserCode(), in: "WDJB-MJH", wantErr: true},
+		{name: "alpha: too long", gen: DefaultDeviceUserCode(), in: "WDJB-MJHTX", wantErr: true},
+		{name: "alpha: invalid character", gen: DefaultDeviceUserCode(), in: "WDJB-MJHA", wantErr: true},
+		{name: "alpha: canonical", gen: DefaultDeviceUserCode(), in: "WDJB-MJHT", want: "WDJB-MJHT"},
+		{name: "alpha: lower case", gen: DefaultDeviceUserCode(), in: "wdjb-mjht", want: "WDJB-MJHT"},
+		{name: "alpha: without dash", gen: DefaultDeviceUserCode(), in: "WDJBMJHT", want: "WDJB-MJHT"},
+		{name: "alpha: spaces", gen: DefaultDeviceUserCode(), in: " WDJB MJHT ", want: "WDJB-MJHT"},
+		{name: "alpha: confusables", gen: DefaultDeviceUserCode(), in: "wdj8-mjht", want: "WDJB-MJHT"},
+		{name: "num: invalid character", gen: DefaultNumDeviceUserCode(), in: "019-45X-6789", wantErr: true},
+		{name: "num: canonical", gen: DefaultNumDeviceUserCode(), in: "019-450-730", want: "019-450-730"},
+		{name: "num: without dashes", gen: DefaultNumDeviceUserCode(), in: "019450730", want: "019-450-730"},
+		{name: "num: confusables", gen: DefaultNumDeviceUserCode(), in: "O19-45o-73o", want: "019-450-730"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.gen.Normalize(context.Background(), "https://honest.as.example.com", tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Normalize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_deviceUserCode_Normalize_Generated(t *testing.T) {
+	for _, gen := range []DeviceUserCode{DefaultDeviceUserCode(), DefaultNumDeviceUserCode()} {
+		code, err := gen.Generate(context.Background(), "https://honest.as.example.com")
+		if err != nil {
+			t.Fatalf("unexpected error occurs, got %v", err)
+		}
+		got, err := gen.Normalize(context.Background(), "https://honest.as.example.com", code)
+		if err != nil {
+			t.Fatalf("unexpected error occurs, got %v", err)
+		}
+		if got != code {
+			t.Errorf("Normalize() = %v, want %v", got, code)
+		}
+	}
+}
@@ -27,6 +27,10 @@ const (
 	// HeaderType describes JWT token header type.
 	//nolint:gosec // detected as hardcoded credentials
 	HeaderType = "oauth-authz-req+jwt"
+
+	// authorizationDetailsClaim is the request object claim carrying the
+	// authorization details as a JSON array.
+	authorizationDetailsClaim = "authorization_details"
 )
 
 //go:generate mockgen -destination mock/authorization_decoder.gen.go -package mock zntr.io/solid/sdk/jwsreq AuthorizationDecoder
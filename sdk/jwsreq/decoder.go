@@ -54,6 +54,17 @@ func (d *tokenDecoder) Decode(ctx context.Context, value string) (*flowv1.Author
 		return nil, fmt.Errorf("unable to decode request claims: %w", err)
 	}
 
+	// Authorization details are carried as a JSON string
+	if v, ok := claims[authorizationDetailsClaim]; ok {
+		if _, isString := v.(string); !isString {
+			raw, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to reencode authorization details: %w", err)
+			}
+			claims[authorizationDetailsClaim] = string(raw)
+		}
+	}
+
 	// Re-encode to json
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(claims); err != nil {
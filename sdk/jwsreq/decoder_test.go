@@ -131,6 +131,30 @@ func Test_jwtDecoder_Decode(t *testing.T) {
 				Scope: "openid",
 			},
 		},
+		{
+			name: "valid with authorization details",
+			args: args{
+				value: "fake-token",
+			},
+			prepare: func(verifier *tokenmock.MockVerifier) {
+				verifier.EXPECT().Claims(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx any, key any, claims any) {
+					switch v := claims.(type) {
+					case *map[string]any:
+						*v = map[string]any{
+							"scope": "openid",
+							"authorization_details": []any{
+								map[string]any{"type": "payment_initiation"},
+							},
+						}
+					}
+				}).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.AuthorizationRequest{
+				Scope:                "openid",
+				AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
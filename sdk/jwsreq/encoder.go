@@ -62,6 +62,16 @@ func (enc *tokenEncoder) Encode(ctx context.Context, ar *flowv1.AuthorizationReq
 		return "", fmt.Errorf("unable to serialize request payload: %w", err)
 	}
 
+	// Authorization details are sent as a JSON array
+	// https://www.rfc-editor.org/rfc/rfc9396#section-3
+	if ar.AuthorizationDetails != "" {
+		var details any
+		if err = json.Unmarshal([]byte(ar.AuthorizationDetails), &details); err != nil {
+			return "", fmt.Errorf("unable to decode authorization details: %w", err)
+		}
+		claims[authorizationDetailsClaim] = details
+	}
+
 	// Sign request
 	req, err := enc.signer.Serialize(ctx, claims)
 	if err != nil {
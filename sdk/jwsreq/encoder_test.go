@@ -101,6 +101,50 @@ func Test_jwtEncoder_Encode(t *testing.T) {
 			wantErr: false,
 			want:    "fake-token",
 		},
+		{
+			name: "invalid authorization details",
+			args: args{
+				ar: &flowv1.AuthorizationRequest{
+					Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:         "code",
+					Scope:                "openid",
+					ClientId:             "s6BhdRkqt3",
+					State:                "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					RedirectUri:          "https://client.example.org/cb",
+					CodeChallenge:        "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod:  "S256",
+					AuthorizationDetails: `[{"type":`,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid with authorization details",
+			args: args{
+				ar: &flowv1.AuthorizationRequest{
+					Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:         "code",
+					Scope:                "openid",
+					ClientId:             "s6BhdRkqt3",
+					State:                "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					RedirectUri:          "https://client.example.org/cb",
+					CodeChallenge:        "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod:  "S256",
+					AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+				},
+			},
+			prepare: func(signer *tokenmock.MockSerializer) {
+				signer.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, claims any) (string, error) {
+					details, ok := claims.(map[string]any)["authorization_details"].([]any)
+					if !ok || len(details) != 1 {
+						return "", fmt.Errorf("authorization details must be encoded as an array")
+					}
+					return "fake-token", nil
+				})
+			},
+			wantErr: false,
+			want:    "fake-token",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
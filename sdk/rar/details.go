@@ -0,0 +1,150 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package rar encodes the authorization details of Rich Authorization
+// Requests.
+// https://www.rfc-editor.org/rfc/rfc9396
+package rar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"zntr.io/solid/sdk/types"
+)
+
+// Detail describes one authorization details object, the "type" field
+// determines the structure of the other fields.
+type Detail map[string]any
+
+// Type returns the authorization details type.
+func (d Detail) Type() string {
+	t, _ := d["type"].(string)
+	return t
+}
+
+// Parse decodes the given JSON array of authorization details and validates
+// the common fields. A blank input returns no details.
+// https://www.rfc-editor.org/rfc/rfc9396#section-2.2
+func Parse(raw string) ([]Detail, error) {
+	// Nothing requested
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	// Decode as an array of objects
+	var details []Detail
+	if err := json.Unmarshal([]byte(raw), &details); err != nil {
+		return nil, fmt.Errorf("authorization details must be a JSON array of objects: %w", err)
+	}
+	if len(details) == 0 {
+		return nil, errors.New("authorization details must not be empty")
+	}
+
+	// Validate common fields
+	for i, d := range details {
+		if d == nil {
+			return nil, fmt.Errorf("authorization details %d must be an object", i)
+		}
+		if d.Type() == "" {
+			return nil, fmt.Errorf("authorization details %d must have a type", i)
+		}
+		for _, field := range []string{"locations", "actions", "datatypes", "privileges"} {
+			if err := checkStringArray(d, field); err != nil {
+				return nil, fmt.Errorf("authorization details %d: %w", i, err)
+			}
+		}
+		if v, ok := d["identifier"]; ok {
+			if _, ok := v.(string); !ok {
+				return nil, fmt.Errorf("authorization details %d: identifier must be a string", i)
+			}
+		}
+	}
+
+	// No error
+	return details, nil
+}
+
+// Encode returns the JSON array representation of the given authorization
+// details, blank when there are no details.
+func Encode(details []Detail) (string, error) {
+	if len(details) == 0 {
+		return "", nil
+	}
+
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode authorization details: %w", err)
+	}
+
+	// No error
+	return string(raw), nil
+}
+
+// Contains reports whether each requested authorization details object is
+// equal to one of the granted ones.
+func Contains(granted, requested []Detail) bool {
+	for _, r := range requested {
+		found := false
+		for _, g := range granted {
+			if reflect.DeepEqual(g, r) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Types returns the distinct types of the given authorization details.
+func Types(details []Detail) []string {
+	var names types.StringArray
+	for _, d := range details {
+		names.AddIfNotContains(d.Type())
+	}
+
+	return names
+}
+
+// -----------------------------------------------------------------------------
+
+func checkStringArray(d Detail, field string) error {
+	v, ok := d[field]
+	if !ok {
+		return nil
+	}
+
+	values, ok := v.([]any)
+	if !ok {
+		return fmt.Errorf("%s must be an array of strings", field)
+	}
+	for _, value := range values {
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s must be an array of strings", field)
+		}
+	}
+
+	// No error
+	return nil
+}
@@ -0,0 +1,122 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rar
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []Detail
+		wantErr bool
+	}{
+		{name: "blank", raw: "", want: nil},
+		{name: "not an array", raw: `{"type":"payment_initiation"}`, wantErr: true},
+		{name: "empty array", raw: `[]`, wantErr: true},
+		{name: "not an object", raw: `["payment_initiation"]`, wantErr: true},
+		{name: "null object", raw: `[null]`, wantErr: true},
+		{name: "missing type", raw: `[{"actions":["read"]}]`, wantErr: true},
+		{name: "invalid type", raw: `[{"type":1}]`, wantErr: true},
+		{name: "invalid actions", raw: `[{"type":"account_information","actions":"read"}]`, wantErr: true},
+		{name: "invalid locations", raw: `[{"type":"account_information","locations":[1]}]`, wantErr: true},
+		{name: "invalid identifier", raw: `[{"type":"account_information","identifier":["a"]}]`, wantErr: true},
+		{
+			name: "valid",
+			raw:  `[{"type":"payment_initiation","actions":["initiate"],"instructedAmount":{"currency":"EUR","amount":"123.50"}}]`,
+			want: []Detail{
+				{
+					"type":    "payment_initiation",
+					"actions": []any{"initiate"},
+					"instructedAmount": map[string]any{
+						"currency": "EUR",
+						"amount":   "123.50",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	raw := `[{"actions":["sign"],"type":"sign","locations":["https://signing.example.com"]}]`
+
+	details, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("unexpected error occurs, got %v", err)
+	}
+	got, err := Encode(details)
+	if err != nil {
+		t.Fatalf("unexpected error occurs, got %v", err)
+	}
+	if got != `[{"actions":["sign"],"locations":["https://signing.example.com"],"type":"sign"}]` {
+		t.Errorf("Encode() = %v", got)
+	}
+
+	if got, err := Encode(nil); err != nil || got != "" {
+		t.Errorf("Encode(nil) = %v, %v", got, err)
+	}
+}
+
+func TestContains(t *testing.T) {
+	payment := Detail{"type": "payment_initiation", "actions": []any{"initiate"}}
+	signing := Detail{"type": "sign", "actions": []any{"sign"}}
+	wider := Detail{"type": "sign", "actions": []any{"sign", "seal"}}
+
+	tests := []struct {
+		name      string
+		granted   []Detail
+		requested []Detail
+		want      bool
+	}{
+		{name: "nothing requested", granted: []Detail{payment}, requested: nil, want: true},
+		{name: "nothing granted", granted: nil, requested: []Detail{payment}, want: false},
+		{name: "subset", granted: []Detail{payment, signing}, requested: []Detail{signing}, want: true},
+		{name: "all", granted: []Detail{payment, signing}, requested: []Detail{signing, payment}, want: true},
+		{name: "wider", granted: []Detail{payment, signing}, requested: []Detail{wider}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Contains(tt.granted, tt.requested); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypes(t *testing.T) {
+	got := Types([]Detail{{"type": "sign"}, {"type": "payment_initiation"}, {"type": "sign"}})
+	if !reflect.DeepEqual(got, []string{"sign", "payment_initiation"}) {
+		t.Errorf("Types() = %v", got)
+	}
+}
@@ -168,6 +168,15 @@ func InvalidTarget() ErrorBuilder {
 	}
 }
 
+// UnsupportedTokenType returns a compliant `unsupported_token_type` error.
+// https://www.rfc-editor.org/rfc/rfc7009#section-2.2.1
+func UnsupportedTokenType() ErrorBuilder {
+	return &defaultErrorBuilder{
+		err:              "unsupported_token_type",
+		errorDescription: "The authorization server does not support the revocation of the presented token type.",
+	}
+}
+
 // InsufficientUserAuthentication returns a compliant `invalid_target` error.
 // https://datatracker.ietf.org/doc/html/rfc9470#name-authentication-requirements
 func InsufficientUserAuthentication() ErrorBuilder {
@@ -176,3 +185,30 @@ func InsufficientUserAuthentication() ErrorBuilder {
 		errorDescription: "The authentication event associated with the access token presented with the request does not meet the authentication requirements of the protected resource.",
 	}
 }
+
+// ExpiredToken returns a compliant `expired_token` error.
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#token_error_response
+func ExpiredToken() ErrorBuilder {
+	return &defaultErrorBuilder{
+		err:              "expired_token",
+		errorDescription: "The 'auth_req_id' has expired, the client will need to make a new authentication request.",
+	}
+}
+
+// InvalidBindingMessage returns a compliant `invalid_binding_message` error.
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#auth_error_response
+func InvalidBindingMessage() ErrorBuilder {
+	return &defaultErrorBuilder{
+		err:              "invalid_binding_message",
+		errorDescription: "The binding message is invalid or unacceptable for use in the context of the given request.",
+	}
+}
+
+// InvalidAuthorizationDetails returns a compliant `invalid_authorization_details` error.
+// https://www.rfc-editor.org/rfc/rfc9396#section-5
+func InvalidAuthorizationDetails() ErrorBuilder {
+	return &defaultErrorBuilder{
+		err:              "invalid_authorization_details",
+		errorDescription: "The authorization details are unknown, malformed or not allowed for the client.",
+	}
+}
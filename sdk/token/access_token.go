@@ -26,22 +26,27 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/rar"
 	"zntr.io/solid/sdk/types"
 )
 
 // -----------------------------------------------------------------------------
 
 // AccessToken instantiate an access token generator.
-func AccessToken(serializer Serializer) Generator {
+func AccessToken(serializer Serializer, opts ...GeneratorOption) Generator {
+	dopts := buildGeneratorOptions(tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN, opts)
+
 	return &accessTokenGenerator{
-		serializer: serializer,
+		serializer:  serializer,
+		maxLifetime: dopts.maxLifetime,
 	}
 }
 
 // -----------------------------------------------------------------------------
 
 type accessTokenGenerator struct {
-	serializer Serializer
+	serializer  Serializer
+	maxLifetime time.Duration
 }
 
 func (c *accessTokenGenerator) Generate(ctx context.Context, t *tokenv1.Token) (string, error) {
@@ -64,28 +69,40 @@ func (c *accessTokenGenerator) Generate(ctx context.Context, t *tokenv1.Token) (
 		return "", fmt.Errorf("unable to generate claims, invalid meta: %w", err)
 	}
 
+	// Decode authorization details
+	details, err := rar.Parse(t.Metadata.AuthorizationDetails)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate claims, invalid authorization details: %w", err)
+	}
+
 	// Prepare claims
 	claims := struct {
-		Iss      string                     `json:"iss,omitempty" cbor:"1,keyasint,omitempty"`
-		Sub      string                     `json:"sub,omitempty" cbor:"2,keyasint,omitempty"`
-		Aud      string                     `json:"aud,omitempty" cbor:"3,keyasint,omitempty"`
-		Exp      uint64                     `json:"exp,omitempty" cbor:"4,keyasint,omitempty"`
-		Nbf      uint64                     `json:"nbf,omitempty" cbor:"5,keyasint,omitempty"`
-		Iat      uint64                     `json:"iat,omitempty" cbor:"6,keyasint,omitempty"`
-		JTI      string                     `json:"jti,omitempty" cbor:"7,keyasint,omitempty"`
-		ClientID string                     `json:"client_id,omitempty" cbor:"100,keyasint,omitempty"`
-		Scope    string                     `json:"scope,omitempty" cbor:"101,keyasint,omitempty"`
-		Cnf      *tokenv1.TokenConfirmation `json:"cnf,omitempty" cbor:"102,keyasint,omitempty"`
+		Iss                  string                     `json:"iss,omitempty" cbor:"1,keyasint,omitempty"`
+		Sub                  string                     `json:"sub,omitempty" cbor:"2,keyasint,omitempty"`
+		Aud                  string                     `json:"aud,omitempty" cbor:"3,keyasint,omitempty"`
+		Exp                  uint64                     `json:"exp,omitempty" cbor:"4,keyasint,omitempty"`
+		Nbf                  uint64                     `json:"nbf,omitempty" cbor:"5,keyasint,omitempty"`
+		Iat                  uint64                     `json:"iat,omitempty" cbor:"6,keyasint,omitempty"`
+		JTI                  string                     `json:"jti,omitempty" cbor:"7,keyasint,omitempty"`
+		ClientID             string                     `json:"client_id,omitempty" cbor:"100,keyasint,omitempty"`
+		Scope                string                     `json:"scope,omitempty" cbor:"101,keyasint,omitempty"`
+		Cnf                  *tokenv1.TokenConfirmation `json:"cnf,omitempty" cbor:"102,keyasint,omitempty"`
+		Act                  *ActorClaims               `json:"act,omitempty" cbor:"107,keyasint,omitempty"`
+		AuthorizationDetails []rar.Detail               `json:"authorization_details,omitempty" cbor:"108,keyasint,omitempty"`
+		Status               *StatusClaims              `json:"status,omitempty" cbor:"65535,keyasint,omitempty"`
 	}{
-		Iss:      t.Metadata.Issuer,
-		Sub:      t.Metadata.Subject,
-		Aud:      t.Metadata.Audience,
-		Exp:      t.Metadata.ExpiresAt,
-		Nbf:      t.Metadata.NotBefore,
-		Iat:      t.Metadata.IssuedAt,
-		JTI:      t.TokenId,
-		ClientID: t.Metadata.ClientId,
-		Scope:    t.Metadata.Scope,
+		Iss:                  t.Metadata.Issuer,
+		Sub:                  t.Metadata.Subject,
+		Aud:                  t.Metadata.Audience,
+		Exp:                  t.Metadata.ExpiresAt,
+		Nbf:                  t.Metadata.NotBefore,
+		Iat:                  t.Metadata.IssuedAt,
+		JTI:                  t.TokenId,
+		ClientID:             t.Metadata.ClientId,
+		Scope:                t.Metadata.Scope,
+		Act:                  ActorChain(t.Actor),
+		AuthorizationDetails: details,
+		Status:               StatusClaim(t.StatusList),
 	}
 
 	// If token has a confirmation
@@ -113,7 +130,7 @@ func (c *accessTokenGenerator) validateMeta(meta *tokenv1.TokenMeta) error {
 	}
 
 	now := uint64(time.Now().Unix())
-	maxExpiration := uint64(time.Unix(int64(meta.IssuedAt), 0).Add(2 * time.Hour).Unix())
+	maxExpiration := uint64(time.Unix(int64(meta.IssuedAt), 0).Add(c.maxLifetime).Unix())
 
 	// Validate syntaxically
 	if err := validation.ValidateStruct(meta,
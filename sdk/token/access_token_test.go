@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
@@ -36,6 +37,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 	}
 	tests := []struct {
 		name    string
+		opts    []token.GeneratorOption
 		args    args
 		prepare func(*tokenmock.MockSerializer)
 		want    string
@@ -73,6 +75,53 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "lifetime above ceiling",
+			args: args{
+				t: &tokenv1.Token{
+					TokenId: "123456789",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://localhost:8080",
+						Audience:  "azertyuiop",
+						ClientId:  "789456",
+						Subject:   "test",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3 * 3600,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "lifetime within policy ceiling",
+			opts: []token.GeneratorOption{
+				token.WithLifetimePolicy(token.DefaultLifetimePolicy(token.WithLifetimeCeilings(token.Lifetimes{
+					AccessToken: 4 * time.Hour,
+				}))),
+			},
+			args: args{
+				t: &tokenv1.Token{
+					TokenId: "123456789",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://localhost:8080",
+						Audience:  "azertyuiop",
+						ClientId:  "789456",
+						Subject:   "test",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3 * 3600,
+					},
+				},
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(jsonClaims)
+			},
+			wantErr: false,
+			want:    `{"iss":"http://localhost:8080","sub":"test","aud":"azertyuiop","exp":10800,"nbf":2,"iat":1,"jti":"123456789","client_id":"789456","scope":"openid"}`,
+		},
 		{
 			name: "signer error",
 			args: args{
@@ -95,6 +144,26 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid authorization details",
+			args: args{
+				t: &tokenv1.Token{
+					TokenId: "123456789",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://localhost:8080",
+						Audience:             "azertyuiop",
+						ClientId:             "789456",
+						Subject:              "test",
+						Scope:                "openid",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            3601,
+						AuthorizationDetails: `{"type":"payment_initiation"}`,
+					},
+				},
+			},
+			wantErr: true,
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "valid",
@@ -145,6 +214,89 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 			wantErr: false,
 			want:    "fake-token",
 		},
+		{
+			name: "valid with actor chain",
+			args: args{
+				t: &tokenv1.Token{
+					TokenId: "123456789",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://localhost:8080",
+						Audience:  "azertyuiop",
+						ClientId:  "789456",
+						Subject:   "test",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					Actor: []*tokenv1.Actor{
+						{
+							Subject:  "admin",
+							ClientId: "789456",
+							Act: &tokenv1.Actor{
+								Subject: "support",
+							},
+						},
+					},
+				},
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(jsonClaims)
+			},
+			wantErr: false,
+			want:    `{"iss":"http://localhost:8080","sub":"test","aud":"azertyuiop","exp":3601,"nbf":2,"iat":1,"jti":"123456789","client_id":"789456","scope":"openid","act":{"sub":"admin","client_id":"789456","act":{"sub":"support"}}}`,
+		},
+		{
+			name: "valid with status list",
+			args: args{
+				t: &tokenv1.Token{
+					TokenId: "123456789",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://localhost:8080",
+						Audience:  "azertyuiop",
+						ClientId:  "789456",
+						Subject:   "test",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					StatusList: &tokenv1.TokenStatusReference{
+						Idx: 0,
+						Uri: "http://localhost:8080/token/status",
+					},
+				},
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(jsonClaims)
+			},
+			wantErr: false,
+			want:    `{"iss":"http://localhost:8080","sub":"test","aud":"azertyuiop","exp":3601,"nbf":2,"iat":1,"jti":"123456789","client_id":"789456","scope":"openid","status":{"status_list":{"idx":0,"uri":"http://localhost:8080/token/status"}}}`,
+		},
+		{
+			name: "valid with authorization details",
+			args: args{
+				t: &tokenv1.Token{
+					TokenId: "123456789",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://localhost:8080",
+						Audience:             "azertyuiop",
+						ClientId:             "789456",
+						Subject:              "test",
+						Scope:                "openid",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            3601,
+						AuthorizationDetails: `[{"type":"payment_initiation","actions":["initiate"]}]`,
+					},
+				},
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(jsonClaims)
+			},
+			wantErr: false,
+			want:    `{"iss":"http://localhost:8080","sub":"test","aud":"azertyuiop","exp":3601,"nbf":2,"iat":1,"jti":"123456789","client_id":"789456","scope":"openid","authorization_details":[{"actions":["initiate"],"type":"payment_initiation"}]}`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -159,7 +311,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				tt.prepare(serializer)
 			}
 
-			c := token.AccessToken(serializer)
+			c := token.AccessToken(serializer, tt.opts...)
 			got, err := c.Generate(tt.args.ctx, tt.args.t)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("accessTokenGenerator.Generate() error = %v, wantErr %v", err, tt.wantErr)
@@ -0,0 +1,72 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+)
+
+// ActorClaims describes the actor claim set used to express delegation.
+// https://www.rfc-editor.org/rfc/rfc8693.html#name-act-actor-claim
+type ActorClaims struct {
+	Iss      string       `json:"iss,omitempty" cbor:"1,keyasint,omitempty"`
+	Sub      string       `json:"sub,omitempty" cbor:"2,keyasint,omitempty"`
+	ClientID string       `json:"client_id,omitempty" cbor:"100,keyasint,omitempty"`
+	Act      *ActorClaims `json:"act,omitempty" cbor:"107,keyasint,omitempty"`
+}
+
+// ActorChain returns the nested actor claims of the given token actors. The
+// current actor is the outermost one, the least recent actor is the most
+// deeply nested one.
+func ActorChain(actors []*tokenv1.Actor) *ActorClaims {
+	// Check arguments
+	if len(actors) == 0 {
+		return nil
+	}
+
+	return actorClaims(actors[0])
+}
+
+// Actor returns the token actor described by the claims.
+func (c *ActorClaims) Actor() *tokenv1.Actor {
+	if c == nil {
+		return nil
+	}
+
+	return &tokenv1.Actor{
+		Issuer:   c.Iss,
+		Subject:  c.Sub,
+		ClientId: c.ClientID,
+		Act:      c.Act.Actor(),
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func actorClaims(a *tokenv1.Actor) *ActorClaims {
+	if a == nil {
+		return nil
+	}
+
+	return &ActorClaims{
+		Iss:      a.Issuer,
+		Sub:      a.Subject,
+		ClientID: a.ClientId,
+		Act:      actorClaims(a.Act),
+	}
+}
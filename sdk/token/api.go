@@ -20,6 +20,7 @@ package token
 import (
 	"context"
 
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 )
 
@@ -30,6 +31,10 @@ const (
 	TypeAccessToken = "at"
 	// TypeRefreshToken describes RefreshToken header type.
 	TypeRefreshToken = "rt"
+	// TypeIDToken describes IDToken header type, relying parties expect the
+	// generic JWT type.
+	// https://openid.net/specs/openid-connect-core-1_0.html#IDToken
+	TypeIDToken = "JWT"
 	// TypeAuthzRequest describes Authorization Request header type.
 	TypeAuthzRequest = "oauth-authz-req"
 	// TypeAuthzResponseMode describes Authorization Response Mode header type.
@@ -42,6 +47,8 @@ const (
 	TypeTokenInstrospection = "token-introspection"
 	// TypeServerMetadata describes authorization server metdata response header type.
 	TypeServerMetadata = "oauth-authorization-server"
+	// TypeStatusList describes token status list header type.
+	TypeStatusList = "statuslist"
 )
 
 // -----------------------------------------------------------------------------
@@ -53,6 +60,35 @@ type Generator interface {
 	Generate(ctx context.Context, t *tokenv1.Token) (string, error)
 }
 
+//go:generate mockgen -destination mock/id_token.gen.go -package mock zntr.io/solid/sdk/token IDTokenGenerator
+
+// IDTokenGenerator describes ID token claims generator contract.
+type IDTokenGenerator interface {
+	Generate(ctx context.Context, client *clientv1.Client, t *tokenv1.Token, at *tokenv1.Token) (string, error)
+}
+
+//go:generate mockgen -destination mock/introspection.gen.go -package mock zntr.io/solid/sdk/token IntrospectionGenerator
+
+// IntrospectionGenerator describes JWT-secured introspection response generator contract.
+type IntrospectionGenerator interface {
+	Generate(ctx context.Context, issuer string, client *clientv1.Client, t *tokenv1.Token) (string, error)
+}
+
+//go:generate mockgen -destination mock/status_list.gen.go -package mock zntr.io/solid/sdk/token StatusListGenerator
+
+// StatusListGenerator describes status list token generator contract.
+type StatusListGenerator interface {
+	URI(issuer string) string
+	Generate(ctx context.Context, issuer string, list *StatusList) (string, error)
+}
+
+//go:generate mockgen -destination mock/status_verifier.gen.go -package mock zntr.io/solid/sdk/token StatusVerifier
+
+// StatusVerifier describes referenced token status verifier contract.
+type StatusVerifier interface {
+	Verify(ctx context.Context, claims *StatusClaims) error
+}
+
 //go:generate mockgen -destination mock/serializer.gen.go -package mock zntr.io/solid/sdk/token Serializer
 
 // Serializer describes Token claims serializer contract.
@@ -61,11 +97,16 @@ type Serializer interface {
 	ContentType() string
 }
 
+//go:generate mockgen -destination mock/encrypter.gen.go -package mock zntr.io/solid/sdk/token Encrypter
+
 // Encrypter describes token encryption contract.
 type Encrypter interface {
 	Encrypt(ctx context.Context, contentType, token string, aad []byte) (string, error)
 }
 
+// EncrypterResolver returns the encrypter to use for the given client.
+type EncrypterResolver func(ctx context.Context, client *clientv1.Client) (Encrypter, error)
+
 //go:generate mockgen -destination mock/verifier.gen.go -package mock zntr.io/solid/sdk/token Verifier
 
 // Verifier describes Token verifier contract.
@@ -24,6 +24,10 @@ import (
 	"zntr.io/solid/sdk/token"
 )
 
+// idTokenType is the CWT ID token header type, the JWT one is only meaningful
+// for JOSE serializations.
+const idTokenType = "id"
+
 // AccessTokenSigner represents CWT Access Token signer.
 func AccessTokenSigner(alg *cose.Algorithm, keyProvider jwk.KeyProviderFunc) token.Serializer {
 	return &defaultSigner{
@@ -42,6 +46,15 @@ func RefreshTokenSigner(alg *cose.Algorithm, keyProvider jwk.KeyProviderFunc) to
 	}
 }
 
+// IDTokenSigner represents CWT ID Token signer.
+func IDTokenSigner(alg *cose.Algorithm, keyProvider jwk.KeyProviderFunc) token.Serializer {
+	return &defaultSigner{
+		tokenType:   idTokenType,
+		alg:         alg,
+		keyProvider: keyProvider,
+	}
+}
+
 // RequestSigner represents CWT Request Token signer.
 func RequestSigner(alg *cose.Algorithm, keyProvider jwk.KeyProviderFunc) token.Serializer {
 	return &defaultSigner{
@@ -86,3 +99,12 @@ func TokenIntrospection(alg *cose.Algorithm, keyProvider jwk.KeyProviderFunc) to
 		keyProvider: keyProvider,
 	}
 }
+
+// StatusListSigner represents CWT Status List Token signer.
+func StatusListSigner(alg *cose.Algorithm, keyProvider jwk.KeyProviderFunc) token.Serializer {
+	return &defaultSigner{
+		tokenType:   token.TypeStatusList,
+		alg:         alg,
+		keyProvider: keyProvider,
+	}
+}
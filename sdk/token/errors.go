@@ -22,3 +22,11 @@ import "errors"
 // ErrInvalidTokenSignature is raised when token is signed with a private key
 // where the public key is not known by the keyset.
 var ErrInvalidTokenSignature = errors.New("invalid token signature")
+
+// ErrTokenRevoked is raised when the token status list marks the token as
+// invalid.
+var ErrTokenRevoked = errors.New("token has been revoked")
+
+// ErrTokenSuspended is raised when the token status list marks the token as
+// suspended.
+var ErrTokenSuspended = errors.New("token has been suspended")
@@ -0,0 +1,188 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"crypto"
+	_ "crypto/sha256" // Register SHA-256 hash function
+	_ "crypto/sha512" // Register SHA-384/SHA-512 hash functions
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/pairwise"
+	"zntr.io/solid/sdk/types"
+)
+
+// -----------------------------------------------------------------------------
+
+// IDToken instantiate an ID token generator.
+//
+// The hash function is used to compute the at_hash claim and must match the
+// hash used by the serializer signature algorithm (SHA-256 for ES256/RS256,
+// SHA-384 for ES384, SHA-512 for EdDSA). The pairwise encoder is used to
+// compute the subject of clients registered with a pairwise subject type.
+func IDToken(serializer Serializer, hash crypto.Hash, subjects pairwise.Encoder, opts ...GeneratorOption) IDTokenGenerator {
+	dopts := buildGeneratorOptions(tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN, opts)
+
+	return &idTokenGenerator{
+		serializer:  serializer,
+		hash:        hash,
+		subjects:    subjects,
+		maxLifetime: dopts.maxLifetime,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type idTokenGenerator struct {
+	serializer  Serializer
+	hash        crypto.Hash
+	subjects    pairwise.Encoder
+	maxLifetime time.Duration
+}
+
+func (c *idTokenGenerator) Generate(ctx context.Context, client *clientv1.Client, t *tokenv1.Token, at *tokenv1.Token) (string, error) {
+	// Check arguments
+	if types.IsNil(c.serializer) {
+		return "", fmt.Errorf("unable to use nil serializer")
+	}
+	if client == nil {
+		return "", fmt.Errorf("unable to generate claims for nil client")
+	}
+	if t == nil {
+		return "", fmt.Errorf("unable to generate claims from nil token")
+	}
+	if t.TokenId == "" {
+		return "", fmt.Errorf("token id must not be blank")
+	}
+	if t.Metadata == nil {
+		return "", fmt.Errorf("token meta must not be nil")
+	}
+
+	// Validate meta informations
+	if err := c.validateMeta(t.Metadata); err != nil {
+		return "", fmt.Errorf("unable to generate claims, invalid meta: %w", err)
+	}
+
+	// Prepare claims
+	claims := struct {
+		Iss      string  `json:"iss,omitempty" cbor:"1,keyasint,omitempty"`
+		Sub      string  `json:"sub,omitempty" cbor:"2,keyasint,omitempty"`
+		Aud      string  `json:"aud,omitempty" cbor:"3,keyasint,omitempty"`
+		Exp      uint64  `json:"exp,omitempty" cbor:"4,keyasint,omitempty"`
+		Iat      uint64  `json:"iat,omitempty" cbor:"6,keyasint,omitempty"`
+		JTI      string  `json:"jti,omitempty" cbor:"7,keyasint,omitempty"`
+		Nonce    string  `json:"nonce,omitempty" cbor:"10,keyasint,omitempty"`
+		AuthTime *uint64 `json:"auth_time,omitempty" cbor:"103,keyasint,omitempty"`
+		Acr      string  `json:"acr,omitempty" cbor:"104,keyasint,omitempty"`
+		Azp      string  `json:"azp,omitempty" cbor:"105,keyasint,omitempty"`
+		AtHash   string  `json:"at_hash,omitempty" cbor:"106,keyasint,omitempty"`
+	}{
+		Iss:      t.Metadata.Issuer,
+		Sub:      t.Metadata.Subject,
+		Aud:      t.Metadata.Audience,
+		Exp:      t.Metadata.ExpiresAt,
+		Iat:      t.Metadata.IssuedAt,
+		JTI:      t.TokenId,
+		Nonce:    t.Metadata.GetNonce(),
+		AuthTime: t.Metadata.AuthTime,
+		Acr:      t.Metadata.GetAcr(),
+		Azp:      t.Metadata.ClientId,
+	}
+
+	// Compute pairwise subject
+	if client.SubjectType == oidc.SubjectTypePairwise {
+		if types.IsNil(c.subjects) {
+			return "", fmt.Errorf("unable to use nil pairwise encoder")
+		}
+
+		sub, err := c.subjects.Encode(client.SectorIdentifier, t.Metadata.Subject)
+		if err != nil {
+			return "", fmt.Errorf("unable to compute pairwise subject: %w", err)
+		}
+		claims.Sub = sub
+	}
+
+	// If an access token is issued alongside
+	if at != nil && at.Value != "" {
+		atHash, err := c.accessTokenHash(at.Value)
+		if err != nil {
+			return "", fmt.Errorf("unable to compute access token hash: %w", err)
+		}
+		claims.AtHash = atHash
+	}
+
+	// Sign the assertion
+	raw, err := c.serializer.Serialize(ctx, claims)
+	if err != nil {
+		return "", fmt.Errorf("unable to serialize id token: %w", err)
+	}
+
+	// No error
+	return raw, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (c *idTokenGenerator) accessTokenHash(value string) (string, error) {
+	// Check hash function
+	if !c.hash.Available() {
+		return "", fmt.Errorf("hash function is not available")
+	}
+
+	// Hash the access token value
+	h := c.hash.New()
+	h.Write([]byte(value))
+	sum := h.Sum(nil)
+
+	// Keep the left-most half
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2]), nil
+}
+
+func (c *idTokenGenerator) validateMeta(meta *tokenv1.TokenMeta) error {
+	// Check arguments
+	if meta == nil {
+		return fmt.Errorf("token meta must not be nil")
+	}
+
+	now := uint64(time.Now().Unix())
+	maxExpiration := uint64(time.Unix(int64(meta.IssuedAt), 0).Add(c.maxLifetime).Unix())
+
+	// Validate syntaxically
+	if err := validation.ValidateStruct(meta,
+		validation.Field(&meta.Audience, validation.Required, is.PrintableASCII),
+		validation.Field(&meta.Issuer, validation.Required, is.URL),
+		validation.Field(&meta.Subject, validation.Required, is.PrintableASCII),
+		validation.Field(&meta.ClientId, validation.Required, is.PrintableASCII),
+		validation.Field(&meta.IssuedAt, validation.Required, validation.Min(uint64(0)), validation.Max(now)),
+		validation.Field(&meta.ExpiresAt, validation.Required, validation.Min(meta.IssuedAt), validation.Max(maxExpiration)),
+	); err != nil {
+		return fmt.Errorf("unable to validate claims: %w", err)
+	}
+
+	// No error
+	return nil
+}
@@ -0,0 +1,234 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token_test
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/pairwise"
+	"zntr.io/solid/sdk/token"
+	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
+)
+
+// jsonClaims serializes claims as a JSON object to inspect generated claims.
+func jsonClaims(_ context.Context, claims any) (string, error) {
+	raw, err := json.Marshal(claims)
+	return string(raw), err
+}
+
+func Test_idTokenGenerator_Generate(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		client *clientv1.Client
+		t      *tokenv1.Token
+		at     *tokenv1.Token
+	}
+	validToken := func() *tokenv1.Token {
+		return &tokenv1.Token{
+			TokenId: "123456789",
+			Metadata: &tokenv1.TokenMeta{
+				Issuer:    "http://localhost:8080",
+				Audience:  "789456",
+				ClientId:  "789456",
+				Subject:   "test",
+				IssuedAt:  1,
+				ExpiresAt: 3601,
+				AuthTime:  types.UInt64Ref(1),
+				Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+				Nonce:     types.StringRef("n-0S6_WzA2Mj"),
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*tokenmock.MockSerializer)
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name: "nil token",
+			args: args{
+				client: &clientv1.Client{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "blank token id",
+			args: args{
+				client: &clientv1.Client{},
+				t:      &tokenv1.Token{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil meta",
+			args: args{
+				client: &clientv1.Client{},
+				t:      &tokenv1.Token{TokenId: "azerty"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid meta",
+			args: args{
+				client: &clientv1.Client{},
+				t: &tokenv1.Token{
+					TokenId:  "azerty",
+					Metadata: &tokenv1.TokenMeta{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "signer error",
+			args: args{
+				client: &clientv1.Client{},
+				t:      validToken(),
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				client: &clientv1.Client{},
+				t:      validToken(),
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(jsonClaims)
+			},
+			wantErr: false,
+			want: map[string]any{
+				"iss":       "http://localhost:8080",
+				"sub":       "test",
+				"aud":       "789456",
+				"azp":       "789456",
+				"exp":       float64(3601),
+				"iat":       float64(1),
+				"jti":       "123456789",
+				"nonce":     "n-0S6_WzA2Mj",
+				"auth_time": float64(1),
+				"acr":       "urn:mace:incommon:iap:silver",
+			},
+		},
+		{
+			name: "valid with access token",
+			args: args{
+				client: &clientv1.Client{},
+				t:      validToken(),
+				at: &tokenv1.Token{
+					Value: "jHkWEdUXMU1BwAsC4vtUsZwnNvTIxEl0z9K3vx5KF0Y",
+				},
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(jsonClaims)
+			},
+			wantErr: false,
+			want: map[string]any{
+				"iss":       "http://localhost:8080",
+				"sub":       "test",
+				"aud":       "789456",
+				"azp":       "789456",
+				"exp":       float64(3601),
+				"iat":       float64(1),
+				"jti":       "123456789",
+				"nonce":     "n-0S6_WzA2Mj",
+				"auth_time": float64(1),
+				"acr":       "urn:mace:incommon:iap:silver",
+				"at_hash":   "77QmUPtjPfzWtF2AnpK9RQ",
+			},
+		},
+		{
+			name: "valid with pairwise subject",
+			args: args{
+				client: &clientv1.Client{
+					SubjectType:      oidc.SubjectTypePairwise,
+					SectorIdentifier: "https://client.example.org",
+				},
+				t: validToken(),
+			},
+			prepare: func(s *tokenmock.MockSerializer) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(jsonClaims)
+			},
+			wantErr: false,
+			want: map[string]any{
+				"iss":       "http://localhost:8080",
+				"sub":       "35xgSRWjPLFkYjzr2-jTJEPM7e1J5rPiOJxfF5rvfqs",
+				"aud":       "789456",
+				"azp":       "789456",
+				"exp":       float64(3601),
+				"iat":       float64(1),
+				"jti":       "123456789",
+				"nonce":     "n-0S6_WzA2Mj",
+				"auth_time": float64(1),
+				"acr":       "urn:mace:incommon:iap:silver",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			serializer := tokenmock.NewMockSerializer(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(serializer)
+			}
+
+			c := token.IDToken(serializer, crypto.SHA256, pairwise.Hash([]byte("U|(vBPu45_Vkvv*Tr*8Y[^s?,$ka@bQziM5]9.+[{.n47]'zokA7-j8ypJ=W]WS")))
+			got, err := c.Generate(tt.args.ctx, tt.args.client, tt.args.t, tt.args.at)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("idTokenGenerator.Generate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want == nil {
+				return
+			}
+
+			var claims map[string]any
+			if err := json.Unmarshal([]byte(got), &claims); err != nil {
+				t.Fatalf("unable to decode generated claims: %v", err)
+			}
+			if diff := cmp.Diff(claims, tt.want); diff != "" {
+				t.Errorf("idTokenGenerator.Generate() = %s", diff)
+			}
+		})
+	}
+}
@@ -22,65 +22,178 @@ import (
 	"fmt"
 	"time"
 
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/rar"
 	"zntr.io/solid/sdk/types"
 )
 
-// -----------------------------------------------------------------------------
+// IntrospectionOption defines introspection response generator optional
+// parameters.
+type IntrospectionOption func(*introspectionGenerator)
+
+// WithIntrospectionSigner registers an additional signer for the given JWS
+// algorithm, used when requested by the client metadata.
+func WithIntrospectionSigner(alg string, signer Serializer) IntrospectionOption {
+	return func(g *introspectionGenerator) {
+		g.signers[alg] = signer
+	}
+}
 
-// Introspection instantiate an introspection assertion generator.
-func Introspection(signer Serializer) Generator {
-	return &introspectionAssertionGenerator{
-		signer: signer,
+// WithIntrospectionEncrypter sets the encrypter resolver used for clients
+// requiring encrypted introspection responses.
+func WithIntrospectionEncrypter(encrypters EncrypterResolver) IntrospectionOption {
+	return func(g *introspectionGenerator) {
+		g.encrypters = encrypters
 	}
 }
 
+// Introspection instantiates a JWT-secured introspection response generator.
+// The given signer is used for the default algorithm.
+// https://www.rfc-editor.org/rfc/rfc9701
+func Introspection(alg string, signer Serializer, opts ...IntrospectionOption) IntrospectionGenerator {
+	g := &introspectionGenerator{
+		defaultAlg: alg,
+		signers: map[string]Serializer{
+			alg: signer,
+		},
+	}
+	for _, o := range opts {
+		o(g)
+	}
+
+	return g
+}
+
 // -----------------------------------------------------------------------------
 
-type introspectionAssertionGenerator struct {
-	signer Serializer
+type introspectionGenerator struct {
+	defaultAlg string
+	signers    map[string]Serializer
+	encrypters EncrypterResolver
 }
 
-func (c *introspectionAssertionGenerator) Generate(ctx context.Context, t *tokenv1.Token) (string, error) {
+func (g *introspectionGenerator) Generate(ctx context.Context, issuer string, client *clientv1.Client, t *tokenv1.Token) (string, error) {
 	// Check arguments
-	if types.IsNil(c.signer) {
-		return "", fmt.Errorf("unable to use nil signer")
+	if issuer == "" {
+		return "", fmt.Errorf("issuer must not be blank")
+	}
+	if client == nil {
+		return "", fmt.Errorf("unable to generate a response for a nil client")
 	}
 	if t == nil {
 		return "", fmt.Errorf("unable to sign nil token")
 	}
-	if t.Metadata == nil {
-		return "", fmt.Errorf("token meta must not be nil")
-	}
 
-	// Prepare claims
-	active := (t.Status == tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE) && IsUsable(t)
-	tokenIntrospection := map[string]any{
-		"active": active,
+	// Resolve client signer
+	alg := client.IntrospectionSignedResponseAlg
+	if alg == "" {
+		alg = g.defaultAlg
 	}
-	if active {
-		tokenIntrospection["iss"] = t.Metadata.Issuer
-		tokenIntrospection["aud"] = t.Metadata.Audience
-		tokenIntrospection["iat"] = t.Metadata.IssuedAt
-		tokenIntrospection["exp"] = t.Metadata.ExpiresAt
-		tokenIntrospection["client_id"] = t.Metadata.ClientId
-		tokenIntrospection["scope"] = t.Metadata.Scope
-		tokenIntrospection["sub"] = t.Metadata.Subject
-		tokenIntrospection["jti"] = t.TokenId
+	signer, ok := g.signers[alg]
+	if !ok || types.IsNil(signer) {
+		return "", fmt.Errorf("introspection response signing algorithm '%s' is not supported", alg)
 	}
 
+	// Prepare claims
 	claims := map[string]any{
-		"iss":                 t.Metadata.Issuer,
+		"iss":                 issuer,
+		"aud":                 client.ClientId,
 		"iat":                 time.Now().Unix(),
-		"token_introspection": tokenIntrospection,
+		"token_introspection": introspectionClaims(t),
+	}
+
+	// Sign the response
+	raw, err := signer.Serialize(ctx, claims)
+	if err != nil {
+		return "", fmt.Errorf("unable to sign introspection response: %w", err)
+	}
+
+	// Check encryption requirement
+	if client.IntrospectionEncryptedResponseAlg == "" {
+		return raw, nil
+	}
+	if g.encrypters == nil {
+		return "", fmt.Errorf("introspection response encryption is not supported")
+	}
+
+	// Resolve client encrypter
+	encrypter, err := g.encrypters(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve client encrypter: %w", err)
+	}
+	if types.IsNil(encrypter) {
+		return "", fmt.Errorf("unable to encrypt with nil encrypter")
 	}
 
-	// Sign the assertion
-	raw, err := c.signer.Serialize(ctx, claims)
+	// Encrypt the signed response
+	encrypted, err := encrypter.Encrypt(ctx, signer.ContentType(), raw, nil)
 	if err != nil {
-		return "", fmt.Errorf("unable to sign client assertion: %w", err)
+		return "", fmt.Errorf("unable to encrypt introspection response: %w", err)
 	}
 
 	// No error
-	return raw, nil
+	return encrypted, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func introspectionClaims(t *tokenv1.Token) map[string]any {
+	active := (t.Status == tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE) && IsUsable(t)
+	claims := map[string]any{
+		"active": active,
+	}
+	if !active {
+		return claims
+	}
+
+	claims["iss"] = t.Metadata.Issuer
+	claims["aud"] = t.Metadata.Audience
+	claims["iat"] = t.Metadata.IssuedAt
+	claims["nbf"] = t.Metadata.NotBefore
+	claims["exp"] = t.Metadata.ExpiresAt
+	claims["jti"] = t.TokenId
+
+	// Add disclosed claims
+	if t.Metadata.ClientId != "" {
+		claims["client_id"] = t.Metadata.ClientId
+	}
+	if t.Metadata.Scope != "" {
+		claims["scope"] = t.Metadata.Scope
+	}
+	if t.Metadata.Subject != "" {
+		claims["sub"] = t.Metadata.Subject
+	}
+
+	// Add confirmation
+	if t.Confirmation != nil {
+		claims["token_type"] = "DPoP"
+		claims["cnf"] = map[string]any{
+			"jkt": t.Confirmation.Jkt,
+		}
+	} else {
+		claims["token_type"] = "Bearer"
+	}
+
+	// Add step-up authentication related claims
+	// https://datatracker.ietf.org/doc/html/rfc9470#name-oauth-20-token-introspectio
+	if t.Metadata.Acr != nil {
+		claims["acr"] = *t.Metadata.Acr
+	}
+	if t.Metadata.AuthTime != nil {
+		claims["auth_time"] = *t.Metadata.AuthTime
+	}
+
+	// Add delegation chain
+	if act := ActorChain(t.Actor); act != nil {
+		claims["act"] = act
+	}
+
+	// Add authorization details
+	// https://www.rfc-editor.org/rfc/rfc9396#section-9.2
+	if details, err := rar.Parse(t.Metadata.AuthorizationDetails); err == nil && len(details) > 0 {
+		claims["authorization_details"] = details
+	}
+
+	return claims
 }
@@ -25,20 +25,53 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/sdk/token"
 	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
 )
 
 func Test_introspectionGenerator_Generate(t *testing.T) {
 	type args struct {
-		ctx context.Context
-		t   *tokenv1.Token
+		ctx    context.Context
+		issuer string
+		client *clientv1.Client
+		t      *tokenv1.Token
 	}
+
+	activeToken := func() *tokenv1.Token {
+		return &tokenv1.Token{
+			TokenId:   "123456789",
+			TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+			Metadata: &tokenv1.TokenMeta{
+				Issuer:               "http://localhost:8080",
+				Audience:             "azertyuiop",
+				ClientId:             "789456",
+				Subject:              "test",
+				Scope:                "openid",
+				IssuedAt:             uint64(time.Now().Unix()) - 1,
+				NotBefore:            uint64(time.Now().Unix()) - 1,
+				ExpiresAt:            uint64(time.Now().Unix()) + 30,
+				Acr:                  types.StringRef("urn:solid:loa:2fa:any"),
+				AuthTime:             types.UInt64Ref(1),
+				AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+			},
+			Confirmation: &tokenv1.TokenConfirmation{
+				Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+			},
+			Actor: []*tokenv1.Actor{
+				{Subject: "admin@example.com"},
+			},
+		}
+	}
+
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*tokenmock.MockSerializer)
+		opts    func(*tokenmock.MockSerializer, *tokenmock.MockEncrypter) []token.IntrospectionOption
+		prepare func(*tokenmock.MockSerializer, *tokenmock.MockSerializer, *tokenmock.MockEncrypter)
 		want    string
 		wantErr bool
 	}{
@@ -47,49 +80,150 @@ func Test_introspectionGenerator_Generate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name:    "blank jti",
+			name: "blank issuer",
+			args: args{
+				client: &clientv1.Client{ClientId: "s6BhdRkqt3"},
+				t:      activeToken(),
+			},
 			wantErr: true,
 		},
 		{
-			name: "nil token id",
+			name: "nil client",
 			args: args{
-				t: &tokenv1.Token{},
+				issuer: "http://localhost:8080",
+				t:      activeToken(),
 			},
 			wantErr: true,
 		},
 		{
-			name: "nil meta",
+			name: "nil token",
 			args: args{
-				t: &tokenv1.Token{TokenId: "azerty"},
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{ClientId: "s6BhdRkqt3"},
 			},
 			wantErr: true,
 		},
 		{
-			name: "signer error",
+			name: "unsupported algorithm",
 			args: args{
-				t: &tokenv1.Token{
-					TokenId: "123456789",
-					Metadata: &tokenv1.TokenMeta{
-						Issuer:    "http://localhost:8080",
-						Audience:  "azertyuiop",
-						ClientId:  "789456",
-						Subject:   "test",
-						Scope:     "openid",
-						IssuedAt:  1,
-						NotBefore: 2,
-						ExpiresAt: 3601,
-					},
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{
+					ClientId:                       "s6BhdRkqt3",
+					IntrospectionSignedResponseAlg: "RS256",
 				},
+				t: activeToken(),
 			},
-			prepare: func(s *tokenmock.MockSerializer) {
+			wantErr: true,
+		},
+		{
+			name: "signer error",
+			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{ClientId: "s6BhdRkqt3"},
+				t:      activeToken(),
+			},
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) {
 				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
 			},
 			wantErr: true,
 		},
+		{
+			name: "encryption not supported",
+			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{
+					ClientId:                          "s6BhdRkqt3",
+					IntrospectionEncryptedResponseAlg: "ECDH-ES",
+					IntrospectionEncryptedResponseEnc: "A256GCM",
+				},
+				t: activeToken(),
+			},
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("fake-token", nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "encrypter resolution error",
+			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{
+					ClientId:                          "s6BhdRkqt3",
+					IntrospectionEncryptedResponseAlg: "ECDH-ES",
+					IntrospectionEncryptedResponseEnc: "A256GCM",
+				},
+				t: activeToken(),
+			},
+			opts: func(_ *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) []token.IntrospectionOption {
+				return []token.IntrospectionOption{
+					token.WithIntrospectionEncrypter(func(_ context.Context, _ *clientv1.Client) (token.Encrypter, error) {
+						return nil, fmt.Errorf("foo")
+					}),
+				}
+			},
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("fake-token", nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "encrypter error",
+			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{
+					ClientId:                          "s6BhdRkqt3",
+					IntrospectionEncryptedResponseAlg: "ECDH-ES",
+					IntrospectionEncryptedResponseEnc: "A256GCM",
+				},
+				t: activeToken(),
+			},
+			opts: func(_ *tokenmock.MockSerializer, e *tokenmock.MockEncrypter) []token.IntrospectionOption {
+				return []token.IntrospectionOption{
+					token.WithIntrospectionEncrypter(func(_ context.Context, _ *clientv1.Client) (token.Encrypter, error) {
+						return e, nil
+					}),
+				}
+			},
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, e *tokenmock.MockEncrypter) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("fake-token", nil)
+				s.EXPECT().ContentType().Return("token-introspection+jwt")
+				e.EXPECT().Encrypt(gomock.Any(), "token-introspection+jwt", "fake-token", gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
 		// ---------------------------------------------------------------------
+		{
+			name: "valid - unknown token",
+			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{ClientId: "s6BhdRkqt3"},
+				t: &tokenv1.Token{
+					Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
+				},
+			},
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, claims any) (string, error) {
+					c := claims.(map[string]any)
+					if c["aud"] != "s6BhdRkqt3" {
+						return "", fmt.Errorf("unexpected audience")
+					}
+					if _, ok := c["iat"]; !ok {
+						return "", fmt.Errorf("iat is missing")
+					}
+					if ti := c["token_introspection"].(map[string]any); len(ti) != 1 || ti["active"] != false {
+						return "", fmt.Errorf("inactive token must only expose active claim")
+					}
+					return "fake-token", nil
+				})
+			},
+			wantErr: false,
+			want:    "fake-token",
+		},
 		{
 			name: "valid - expired",
 			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{ClientId: "s6BhdRkqt3"},
 				t: &tokenv1.Token{
 					TokenId:   "123456789",
 					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
@@ -106,7 +240,7 @@ func Test_introspectionGenerator_Generate(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(s *tokenmock.MockSerializer) {
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) {
 				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("fake-token", nil)
 			},
 			wantErr: false,
@@ -115,27 +249,73 @@ func Test_introspectionGenerator_Generate(t *testing.T) {
 		{
 			name: "valid - active",
 			args: args{
-				t: &tokenv1.Token{
-					TokenId:   "123456789",
-					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
-					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
-					Metadata: &tokenv1.TokenMeta{
-						Issuer:    "http://localhost:8080",
-						Audience:  "azertyuiop",
-						ClientId:  "789456",
-						Subject:   "test",
-						Scope:     "openid",
-						IssuedAt:  uint64(time.Now().Unix()) - 1,
-						NotBefore: uint64(time.Now().Unix()) - 1,
-						ExpiresAt: uint64(time.Now().Unix()) + 30,
-					},
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{ClientId: "s6BhdRkqt3"},
+				t:      activeToken(),
+			},
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, claims any) (string, error) {
+					ti := claims.(map[string]any)["token_introspection"].(map[string]any)
+					for _, name := range []string{"active", "iss", "aud", "iat", "nbf", "exp", "jti", "client_id", "scope", "sub", "cnf", "acr", "auth_time", "act", "authorization_details"} {
+						if _, ok := ti[name]; !ok {
+							return "", fmt.Errorf("claim '%s' is missing", name)
+						}
+					}
+					if ti["token_type"] != "DPoP" {
+						return "", fmt.Errorf("unexpected token type")
+					}
+					return "fake-token", nil
+				})
+			},
+			wantErr: false,
+			want:    "fake-token",
+		},
+		{
+			name: "valid - client algorithm",
+			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{
+					ClientId:                       "s6BhdRkqt3",
+					IntrospectionSignedResponseAlg: "EdDSA",
 				},
+				t: activeToken(),
 			},
-			prepare: func(s *tokenmock.MockSerializer) {
+			opts: func(s *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) []token.IntrospectionOption {
+				return []token.IntrospectionOption{
+					token.WithIntrospectionSigner("EdDSA", s),
+				}
+			},
+			prepare: func(_ *tokenmock.MockSerializer, s *tokenmock.MockSerializer, _ *tokenmock.MockEncrypter) {
+				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("fake-eddsa-token", nil)
+			},
+			wantErr: false,
+			want:    "fake-eddsa-token",
+		},
+		{
+			name: "valid - encrypted",
+			args: args{
+				issuer: "http://localhost:8080",
+				client: &clientv1.Client{
+					ClientId:                          "s6BhdRkqt3",
+					IntrospectionEncryptedResponseAlg: "ECDH-ES",
+					IntrospectionEncryptedResponseEnc: "A256GCM",
+				},
+				t: activeToken(),
+			},
+			opts: func(_ *tokenmock.MockSerializer, e *tokenmock.MockEncrypter) []token.IntrospectionOption {
+				return []token.IntrospectionOption{
+					token.WithIntrospectionEncrypter(func(_ context.Context, _ *clientv1.Client) (token.Encrypter, error) {
+						return e, nil
+					}),
+				}
+			},
+			prepare: func(s *tokenmock.MockSerializer, _ *tokenmock.MockSerializer, e *tokenmock.MockEncrypter) {
 				s.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("fake-token", nil)
+				s.EXPECT().ContentType().Return("token-introspection+jwt")
+				e.EXPECT().Encrypt(gomock.Any(), "token-introspection+jwt", "fake-token", gomock.Any()).Return("fake-encrypted-token", nil)
 			},
 			wantErr: false,
-			want:    "fake-token",
+			want:    "fake-encrypted-token",
 		},
 	}
 	for _, tt := range tests {
@@ -145,14 +325,20 @@ func Test_introspectionGenerator_Generate(t *testing.T) {
 
 			// Arm mocks
 			serializer := tokenmock.NewMockSerializer(ctrl)
+			altSerializer := tokenmock.NewMockSerializer(ctrl)
+			encrypter := tokenmock.NewMockEncrypter(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(serializer)
+				tt.prepare(serializer, altSerializer, encrypter)
+			}
+			var opts []token.IntrospectionOption
+			if tt.opts != nil {
+				opts = tt.opts(altSerializer, encrypter)
 			}
 
-			c := token.Introspection(serializer)
-			got, err := c.Generate(tt.args.ctx, tt.args.t)
+			c := token.Introspection("ES384", serializer, opts...)
+			got, err := c.Generate(tt.args.ctx, tt.args.issuer, tt.args.client, tt.args.t)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("introspectionGenerator.Generate() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -44,6 +44,16 @@ func RefreshTokenSigner(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProvider
 	}
 }
 
+// IDTokenSigner represents JWT ID Token signer.
+func IDTokenSigner(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc) token.Serializer {
+	return &defaultSigner{
+		tokenType:   token.TypeIDToken,
+		alg:         alg,
+		keyProvider: keyProvider,
+		embedJWK:    false,
+	}
+}
+
 // RequestSigner represents JWT Request Token signer.
 func RequestSigner(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc) token.Serializer {
 	return &defaultSigner{
@@ -103,3 +113,13 @@ func ServerMetadata(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc
 		embedJWK:    false,
 	}
 }
+
+// StatusListSigner represents JWT Status List Token signer.
+func StatusListSigner(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc) token.Serializer {
+	return &defaultSigner{
+		tokenType:   token.TypeStatusList,
+		alg:         alg,
+		keyProvider: keyProvider,
+		embedJWK:    false,
+	}
+}
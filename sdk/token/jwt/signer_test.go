@@ -215,3 +215,31 @@ func Test_defaultSigner_Sign(t *testing.T) {
 		})
 	}
 }
+
+func TestIDTokenSigner_Type(t *testing.T) {
+	keys := func(ctx context.Context) (*jose.JSONWebKey, error) {
+		var privateKey jose.JSONWebKey
+
+		// Decode JWK
+		err := json.Unmarshal(jwkPrivateKey, &privateKey)
+
+		return &privateKey, err
+	}
+
+	raw, err := IDTokenSigner(jose.ES384, keys).Serialize(context.Background(), map[string]any{"sub": "test"})
+	if err != nil {
+		t.Fatalf("unable to sign id token: %v", err)
+	}
+
+	parsed, err := DefaultVerifier(nil, []jose.SignatureAlgorithm{jose.ES384}).Parse(raw)
+	if err != nil {
+		t.Fatalf("unable to parse id token: %v", err)
+	}
+	typ, err := parsed.Type()
+	if err != nil {
+		t.Fatalf("unable to retrieve id token type: %v", err)
+	}
+	if typ != "JWT" {
+		t.Errorf("IDTokenSigner() typ = %v, want JWT", typ)
+	}
+}
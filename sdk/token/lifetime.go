@@ -0,0 +1,244 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+)
+
+// Lifetimes describes token lifetimes by token type.
+type Lifetimes struct {
+	AccessToken  time.Duration
+	RefreshToken time.Duration
+	IDToken      time.Duration
+}
+
+// Get returns the lifetime of the given token type.
+func (l Lifetimes) Get(tokenType tokenv1.TokenType) time.Duration {
+	switch tokenType {
+	case tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN:
+		return l.AccessToken
+	case tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN:
+		return l.RefreshToken
+	case tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN:
+		return l.IDToken
+	default:
+	}
+
+	return 0
+}
+
+var (
+	// DefaultLifetimes defines token lifetimes used when neither the grant,
+	// the client nor the resource define one.
+	DefaultLifetimes = Lifetimes{
+		AccessToken:  1 * time.Hour,
+		RefreshToken: 7 * 24 * time.Hour,
+		IDToken:      1 * time.Hour,
+	}
+
+	// DefaultLifetimeCeilings defines server-wide maximum token lifetimes.
+	DefaultLifetimeCeilings = Lifetimes{
+		AccessToken:  2 * time.Hour,
+		RefreshToken: 14 * 24 * time.Hour,
+		IDToken:      24 * time.Hour,
+	}
+)
+
+// GeneratorOption defines token generator optional parameters.
+type GeneratorOption func(*generatorOptions)
+
+type generatorOptions struct {
+	tokenType   tokenv1.TokenType
+	maxLifetime time.Duration
+}
+
+// WithMaxLifetime overrides the token lifetime ceiling enforced by the
+// generator.
+func WithMaxLifetime(d time.Duration) GeneratorOption {
+	return func(o *generatorOptions) {
+		o.maxLifetime = d
+	}
+}
+
+// WithLifetimePolicy derives the token lifetime ceiling enforced by the
+// generator from the given policy, so that the generator accepts every
+// lifetime resolved by the policy.
+func WithLifetimePolicy(policy LifetimePolicy) GeneratorOption {
+	return func(o *generatorOptions) {
+		if ceiling := policy.Ceiling(o.tokenType); ceiling > 0 {
+			o.maxLifetime = ceiling
+		}
+	}
+}
+
+func buildGeneratorOptions(tokenType tokenv1.TokenType, opts []GeneratorOption) *generatorOptions {
+	dopts := &generatorOptions{
+		tokenType:   tokenType,
+		maxLifetime: DefaultLifetimeCeilings.Get(tokenType),
+	}
+	for _, o := range opts {
+		o(dopts)
+	}
+
+	return dopts
+}
+
+// -----------------------------------------------------------------------------
+
+//go:generate mockgen -destination mock/lifetime_policy.gen.go -package mock zntr.io/solid/sdk/token LifetimePolicy
+
+// LifetimePolicy describes token lifetime resolution contract.
+type LifetimePolicy interface {
+	// Lifetime returns the lifetime of a token of the given type, issued to
+	// the client for the resource (optional) through the given grant type.
+	Lifetime(ctx context.Context, tokenType tokenv1.TokenType, grantType string, client *clientv1.Client, resource *resourcev1.Resource) (time.Duration, error)
+	// Ceiling returns the maximum lifetime of a token of the given type.
+	Ceiling(tokenType tokenv1.TokenType) time.Duration
+}
+
+// LifetimePolicyOption defines lifetime policy optional parameters.
+type LifetimePolicyOption func(*lifetimePolicy)
+
+// WithLifetimeCeilings overrides the server-wide token lifetime ceilings.
+func WithLifetimeCeilings(ceilings Lifetimes) LifetimePolicyOption {
+	return func(p *lifetimePolicy) {
+		p.ceilings = ceilings
+	}
+}
+
+// WithDefaultLifetimes overrides the default token lifetimes.
+func WithDefaultLifetimes(defaults Lifetimes) LifetimePolicyOption {
+	return func(p *lifetimePolicy) {
+		p.defaults = defaults
+	}
+}
+
+// WithGrantLifetimes overrides the token lifetimes for the given grant type.
+// Zero values fall back to default lifetimes.
+func WithGrantLifetimes(grantType string, lifetimes Lifetimes) LifetimePolicyOption {
+	return func(p *lifetimePolicy) {
+		p.grants[grantType] = lifetimes
+	}
+}
+
+// DefaultLifetimePolicy returns a lifetime policy resolving token lifetimes
+// from the client and the resource settings, falling back to grant type and
+// default lifetimes. When both the client and the resource define a lifetime
+// the shortest one is used, a grant specific lifetime caps them. The resolved
+// lifetime never exceeds the ceilings.
+func DefaultLifetimePolicy(opts ...LifetimePolicyOption) LifetimePolicy {
+	p := &lifetimePolicy{
+		defaults: DefaultLifetimes,
+		ceilings: DefaultLifetimeCeilings,
+		grants: map[string]Lifetimes{
+			oidc.GrantTypeTokenExchange: {
+				AccessToken: 1 * time.Minute,
+			},
+		},
+	}
+
+	// Apply options
+	for _, o := range opts {
+		o(p)
+	}
+
+	return p
+}
+
+// -----------------------------------------------------------------------------
+
+type lifetimePolicy struct {
+	defaults Lifetimes
+	ceilings Lifetimes
+	grants   map[string]Lifetimes
+}
+
+func (p *lifetimePolicy) Lifetime(_ context.Context, tokenType tokenv1.TokenType, grantType string, client *clientv1.Client, resource *resourcev1.Resource) (time.Duration, error) {
+	// Check arguments
+	if client == nil {
+		return 0, fmt.Errorf("unable to resolve token lifetime for nil client")
+	}
+
+	// Retrieve ceiling
+	ceiling := p.ceilings.Get(tokenType)
+	if ceiling <= 0 {
+		return 0, fmt.Errorf("token type '%s' has no lifetime ceiling", tokenType)
+	}
+
+	// Resolve from grant type
+	grantLifetime := p.grants[grantType].Get(tokenType)
+	lifetime := grantLifetime
+	if lifetime <= 0 {
+		lifetime = p.defaults.Get(tokenType)
+	}
+
+	// Client and resource settings take precedence
+	var overrides []uint64
+	switch tokenType {
+	case tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN:
+		overrides = append(overrides, client.GetAccessTokenLifetime(), resource.GetAccessTokenLifetime())
+	case tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN:
+		overrides = append(overrides, client.GetRefreshTokenLifetime(), resource.GetRefreshTokenLifetime())
+	case tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN:
+		overrides = append(overrides, client.GetIdTokenLifetime())
+	default:
+	}
+
+	// Keep the shortest override
+	var override time.Duration
+	for _, o := range overrides {
+		if o == 0 {
+			continue
+		}
+		d := ceiling
+		if o < uint64(ceiling/time.Second) {
+			d = time.Duration(o) * time.Second
+		}
+		if override == 0 || d < override {
+			override = d
+		}
+	}
+	if override > 0 {
+		lifetime = override
+
+		// Overrides can't extend a grant specific lifetime
+		if grantLifetime > 0 && grantLifetime < override {
+			lifetime = grantLifetime
+		}
+	}
+
+	// Enforce ceiling
+	if lifetime <= 0 || lifetime > ceiling {
+		lifetime = ceiling
+	}
+
+	// No error
+	return lifetime, nil
+}
+
+func (p *lifetimePolicy) Ceiling(tokenType tokenv1.TokenType) time.Duration {
+	return p.ceilings.Get(tokenType)
+}
@@ -0,0 +1,211 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/token"
+	"zntr.io/solid/sdk/types"
+)
+
+func Test_lifetimePolicy_Lifetime(t *testing.T) {
+	type args struct {
+		tokenType tokenv1.TokenType
+		grantType string
+		client    *clientv1.Client
+		resource  *resourcev1.Resource
+	}
+	tests := []struct {
+		name    string
+		opts    []token.LifetimePolicyOption
+		args    args
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "nil client",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown token type",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_UNSPECIFIED,
+				client:    &clientv1.Client{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "default - access_token",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeAuthorizationCode,
+				client:    &clientv1.Client{},
+			},
+			want: 1 * time.Hour,
+		},
+		{
+			name: "default - refresh_token",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+				grantType: oidc.GrantTypeAuthorizationCode,
+				client:    &clientv1.Client{},
+			},
+			want: 7 * 24 * time.Hour,
+		},
+		{
+			name: "default - token exchange",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeTokenExchange,
+				client:    &clientv1.Client{},
+			},
+			want: 1 * time.Minute,
+		},
+		{
+			name: "grant override",
+			opts: []token.LifetimePolicyOption{
+				token.WithGrantLifetimes(oidc.GrantTypeClientCredentials, token.Lifetimes{
+					AccessToken: 5 * time.Minute,
+				}),
+			},
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeClientCredentials,
+				client:    &clientv1.Client{},
+			},
+			want: 5 * time.Minute,
+		},
+		{
+			name: "client override",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeAuthorizationCode,
+				client: &clientv1.Client{
+					AccessTokenLifetime: types.UInt64Ref(7200),
+				},
+			},
+			want: 2 * time.Hour,
+		},
+		{
+			name: "client override capped by grant lifetime",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeTokenExchange,
+				client: &clientv1.Client{
+					AccessTokenLifetime: types.UInt64Ref(600),
+				},
+			},
+			want: 1 * time.Minute,
+		},
+		{
+			name: "client override shorter than grant lifetime",
+			opts: []token.LifetimePolicyOption{
+				token.WithGrantLifetimes(oidc.GrantTypeClientCredentials, token.Lifetimes{
+					AccessToken: 5 * time.Minute,
+				}),
+			},
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeClientCredentials,
+				client: &clientv1.Client{
+					AccessTokenLifetime: types.UInt64Ref(60),
+				},
+			},
+			want: 1 * time.Minute,
+		},
+		{
+			name: "resource shorter than client",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeAuthorizationCode,
+				client: &clientv1.Client{
+					AccessTokenLifetime: types.UInt64Ref(600),
+				},
+				resource: &resourcev1.Resource{
+					AccessTokenLifetime: types.UInt64Ref(300),
+				},
+			},
+			want: 5 * time.Minute,
+		},
+		{
+			name: "resource ignored for id_token",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+				grantType: oidc.GrantTypeAuthorizationCode,
+				client: &clientv1.Client{
+					IdTokenLifetime: types.UInt64Ref(600),
+				},
+				resource: &resourcev1.Resource{
+					AccessTokenLifetime: types.UInt64Ref(300),
+				},
+			},
+			want: 10 * time.Minute,
+		},
+		{
+			name: "client above ceiling",
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				grantType: oidc.GrantTypeAuthorizationCode,
+				client: &clientv1.Client{
+					AccessTokenLifetime: types.UInt64Ref(365 * 24 * 3600),
+				},
+			},
+			want: 2 * time.Hour,
+		},
+		{
+			name: "default above ceiling",
+			opts: []token.LifetimePolicyOption{
+				token.WithLifetimeCeilings(token.Lifetimes{
+					RefreshToken: 24 * time.Hour,
+				}),
+			},
+			args: args{
+				tokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+				grantType: oidc.GrantTypeRefreshToken,
+				client:    &clientv1.Client{},
+			},
+			want: 24 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Prepare policy
+			underTest := token.DefaultLifetimePolicy(tt.opts...)
+
+			// Do the query
+			got, err := underTest.Lifetime(context.Background(), tt.args.tokenType, tt.args.grantType, tt.args.client, tt.args.resource)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("lifetimePolicy.Lifetime() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("lifetimePolicy.Lifetime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
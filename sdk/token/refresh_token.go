@@ -32,16 +32,20 @@ import (
 // -----------------------------------------------------------------------------
 
 // RefreshToken instantiate an refresh token generator.
-func RefreshToken(signer Serializer) Generator {
+func RefreshToken(signer Serializer, opts ...GeneratorOption) Generator {
+	dopts := buildGeneratorOptions(tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN, opts)
+
 	return &refreshTokenGenerator{
-		signer: signer,
+		signer:      signer,
+		maxLifetime: dopts.maxLifetime,
 	}
 }
 
 // -----------------------------------------------------------------------------
 
 type refreshTokenGenerator struct {
-	signer Serializer
+	signer      Serializer
+	maxLifetime time.Duration
 }
 
 func (c *refreshTokenGenerator) Generate(ctx context.Context, t *tokenv1.Token) (string, error) {
@@ -107,7 +111,7 @@ func (c *refreshTokenGenerator) validateMeta(meta *tokenv1.TokenMeta) error {
 	}
 
 	now := uint64(time.Now().Unix())
-	maxExpiration := uint64(time.Unix(int64(meta.IssuedAt), 0).Add(14 * 24 * time.Hour).Unix())
+	maxExpiration := uint64(time.Unix(int64(meta.IssuedAt), 0).Add(c.maxLifetime).Unix())
 
 	// Validate syntaxically
 	if err := validation.ValidateStruct(meta,
@@ -0,0 +1,476 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/types"
+)
+
+// Token status values.
+// https://datatracker.ietf.org/doc/draft-ietf-oauth-status-list/
+const (
+	// StatusValid describes a valid token.
+	StatusValid uint8 = 0x00
+	// StatusInvalid describes a revoked token.
+	StatusInvalid uint8 = 0x01
+	// StatusSuspended describes a temporarily suspended token.
+	StatusSuspended uint8 = 0x02
+)
+
+const (
+	// DefaultStatusListPath is the status list location relative to the issuer.
+	DefaultStatusListPath = "/token/status"
+	// DefaultStatusListTTL is the default status list token lifetime.
+	DefaultStatusListTTL = 5 * time.Minute
+
+	// maxStatusListSize limits the decompressed status list size.
+	maxStatusListSize = 16 * 1024 * 1024
+)
+
+// -----------------------------------------------------------------------------
+
+// StatusList holds token statuses, each status is encoded on the same number
+// of bits and the status of the token index 0 is stored in the least
+// significant bits of the first byte.
+type StatusList struct {
+	bits uint8
+	data []byte
+}
+
+// NewStatusList allocates a status list able to hold size statuses of the
+// given bit size.
+func NewStatusList(bits uint8, size uint64) (*StatusList, error) {
+	// Check arguments
+	if !isValidStatusBits(bits) {
+		return nil, fmt.Errorf("status bit size must be 1, 2, 4 or 8, got %d", bits)
+	}
+
+	// No error
+	return &StatusList{
+		bits: bits,
+		data: make([]byte, (size*uint64(bits)+7)/8),
+	}, nil
+}
+
+// DecompressStatusList decodes a compressed status list.
+func DecompressStatusList(bits uint8, lst []byte) (*StatusList, error) {
+	// Check arguments
+	if !isValidStatusBits(bits) {
+		return nil, fmt.Errorf("status bit size must be 1, 2, 4 or 8, got %d", bits)
+	}
+
+	// Prepare decompressor
+	r, err := zlib.NewReader(bytes.NewReader(lst))
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize status list decompressor: %w", err)
+	}
+	defer r.Close()
+
+	// Decompress with a size limit
+	data, err := io.ReadAll(io.LimitReader(r, maxStatusListSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress status list: %w", err)
+	}
+	if len(data) > maxStatusListSize {
+		return nil, errors.New("status list exceeds the maximum allowed size")
+	}
+
+	// No error
+	return &StatusList{
+		bits: bits,
+		data: data,
+	}, nil
+}
+
+// Bits returns the number of bits used per status.
+func (l *StatusList) Bits() uint8 {
+	return l.bits
+}
+
+// Len returns the number of statuses held by the list.
+func (l *StatusList) Len() uint64 {
+	return uint64(len(l.data)) * 8 / uint64(l.bits)
+}
+
+// Set assigns the status of the given index.
+func (l *StatusList) Set(idx uint64, status uint8) error {
+	// Check arguments
+	if idx >= l.Len() {
+		return fmt.Errorf("status index %d is out of range", idx)
+	}
+	mask := uint8(1<<l.bits - 1)
+	if status&^mask != 0 {
+		return fmt.Errorf("status %d can't be encoded on %d bits", status, l.bits)
+	}
+
+	// Replace status bits
+	pos, shift := l.position(idx)
+	l.data[pos] = l.data[pos]&^(mask<<shift) | status<<shift
+
+	// No error
+	return nil
+}
+
+// Get returns the status of the given index.
+func (l *StatusList) Get(idx uint64) (uint8, error) {
+	// Check arguments
+	if idx >= l.Len() {
+		return 0, fmt.Errorf("status index %d is out of range", idx)
+	}
+
+	// Extract status bits
+	pos, shift := l.position(idx)
+	mask := uint8(1<<l.bits - 1)
+
+	// No error
+	return (l.data[pos] >> shift) & mask, nil
+}
+
+// Compress returns the DEFLATE compressed status list with ZLIB format.
+func (l *StatusList) Compress() ([]byte, error) {
+	var buf bytes.Buffer
+
+	// Prepare compressor
+	w, err := zlib.NewWriterLevel(&buf, zlib.BestCompression)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize status list compressor: %w", err)
+	}
+	if _, err := w.Write(l.data); err != nil {
+		return nil, fmt.Errorf("unable to compress status list: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("unable to finalize status list compression: %w", err)
+	}
+
+	// No error
+	return buf.Bytes(), nil
+}
+
+func (l *StatusList) position(idx uint64) (uint64, uint8) {
+	offset := idx * uint64(l.bits)
+	return offset / 8, uint8(offset % 8)
+}
+
+func isValidStatusBits(bits uint8) bool {
+	switch bits {
+	case 1, 2, 4, 8:
+		return true
+	default:
+		return false
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// StatusListReference describes the status list entry of a referenced token.
+type StatusListReference struct {
+	Index uint64 `json:"idx" cbor:"idx"`
+	URI   string `json:"uri" cbor:"uri"`
+}
+
+// StatusClaims describes the status claim of a referenced token.
+type StatusClaims struct {
+	StatusList *StatusListReference `json:"status_list,omitempty" cbor:"status_list,omitempty"`
+}
+
+// StatusClaim converts the given token status reference to status claims.
+func StatusClaim(ref *tokenv1.TokenStatusReference) *StatusClaims {
+	if ref == nil {
+		return nil
+	}
+
+	return &StatusClaims{
+		StatusList: &StatusListReference{
+			Index: ref.Idx,
+			URI:   ref.Uri,
+		},
+	}
+}
+
+// StatusListTokenClaims describes status list token claims.
+type StatusListTokenClaims struct {
+	Subject    string           `json:"sub" cbor:"2,keyasint"`
+	IssuedAt   uint64           `json:"iat" cbor:"6,keyasint"`
+	ExpiresAt  uint64           `json:"exp,omitempty" cbor:"4,keyasint,omitempty"`
+	TTL        uint64           `json:"ttl,omitempty" cbor:"65534,keyasint,omitempty"`
+	StatusList StatusListClaims `json:"status_list" cbor:"65533,keyasint"`
+}
+
+// StatusListClaims describes the status list claim of a status list token.
+type StatusListClaims struct {
+	Bits uint8           `json:"bits" cbor:"bits"`
+	List CompressedBytes `json:"lst" cbor:"lst"`
+}
+
+// CompressedBytes holds a compressed status list, encoded as base64url in JSON
+// and as a byte string in CBOR.
+type CompressedBytes []byte
+
+// MarshalJSON encodes the bytes as an unpadded base64url string.
+func (b CompressedBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(b))
+}
+
+// UnmarshalJSON decodes an unpadded base64url string.
+func (b *CompressedBytes) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("unable to decode status list: %w", err)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return fmt.Errorf("unable to decode status list: %w", err)
+	}
+	*b = decoded
+
+	// No error
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+
+// StatusListOption defines status list token generator optional parameters.
+type StatusListOption func(*statusListGenerator)
+
+// WithStatusListURI sets the function building the status list URI of an
+// issuer.
+func WithStatusListURI(uri func(issuer string) string) StatusListOption {
+	return func(g *statusListGenerator) {
+		g.uri = uri
+	}
+}
+
+// WithStatusListTTL sets the status list token lifetime, also published as the
+// recommended cache duration.
+func WithStatusListTTL(ttl time.Duration) StatusListOption {
+	return func(g *statusListGenerator) {
+		g.ttl = ttl
+	}
+}
+
+// StatusListToken instantiates a status list token generator.
+// https://datatracker.ietf.org/doc/draft-ietf-oauth-status-list/
+func StatusListToken(serializer Serializer, opts ...StatusListOption) StatusListGenerator {
+	g := &statusListGenerator{
+		serializer: serializer,
+		ttl:        DefaultStatusListTTL,
+		uri: func(issuer string) string {
+			return strings.TrimSuffix(issuer, "/") + DefaultStatusListPath
+		},
+	}
+	for _, o := range opts {
+		o(g)
+	}
+
+	return g
+}
+
+type statusListGenerator struct {
+	serializer Serializer
+	ttl        time.Duration
+	uri        func(issuer string) string
+}
+
+func (g *statusListGenerator) URI(issuer string) string {
+	return g.uri(issuer)
+}
+
+func (g *statusListGenerator) Generate(ctx context.Context, issuer string, list *StatusList) (string, error) {
+	// Check arguments
+	if types.IsNil(g.serializer) {
+		return "", errors.New("unable to use nil serializer")
+	}
+	if issuer == "" {
+		return "", errors.New("issuer must not be blank")
+	}
+	if list == nil {
+		return "", errors.New("unable to sign nil status list")
+	}
+
+	// Compress the list
+	lst, err := list.Compress()
+	if err != nil {
+		return "", fmt.Errorf("unable to encode status list: %w", err)
+	}
+
+	// Prepare claims
+	now := time.Now()
+	claims := &StatusListTokenClaims{
+		Subject:   g.uri(issuer),
+		IssuedAt:  uint64(now.Unix()),
+		ExpiresAt: uint64(now.Add(g.ttl).Unix()),
+		TTL:       uint64(g.ttl.Seconds()),
+		StatusList: StatusListClaims{
+			Bits: list.Bits(),
+			List: lst,
+		},
+	}
+
+	// Sign the status list
+	raw, err := g.serializer.Serialize(ctx, claims)
+	if err != nil {
+		return "", fmt.Errorf("unable to serialize status list token: %w", err)
+	}
+
+	// No error
+	return raw, nil
+}
+
+// -----------------------------------------------------------------------------
+
+// StatusListFetcher retrieves the status list published at the given URI.
+type StatusListFetcher func(ctx context.Context, uri string) (*StatusList, error)
+
+// ParseStatusList verifies the given status list token and decodes its status
+// list. The token subject must match the URI it has been retrieved from.
+func ParseStatusList(ctx context.Context, verifier Verifier, uri, raw string) (*StatusList, error) {
+	// Check arguments
+	if types.IsNil(verifier) {
+		return nil, errors.New("unable to use nil verifier")
+	}
+
+	// Verify and extract claims
+	var claims StatusListTokenClaims
+	if err := verifier.Claims(ctx, raw, &claims); err != nil {
+		return nil, fmt.Errorf("unable to verify status list token: %w", err)
+	}
+
+	// Validate claims
+	if claims.Subject != uri {
+		return nil, fmt.Errorf("status list token subject '%s' doesn't match '%s'", claims.Subject, uri)
+	}
+	if claims.ExpiresAt > 0 && claims.ExpiresAt <= uint64(time.Now().Unix()) {
+		return nil, errors.New("status list token is expired")
+	}
+
+	// No error
+	return DecompressStatusList(claims.StatusList.Bits, claims.StatusList.List)
+}
+
+// StatusListVerifier instantiates a token status verifier, fetched status
+// lists are cached for the given duration.
+func StatusListVerifier(fetcher StatusListFetcher, cacheTTL time.Duration) StatusVerifier {
+	return &statusListVerifier{
+		fetcher:  fetcher,
+		cacheTTL: cacheTTL,
+		cache:    map[string]*cachedStatusList{},
+	}
+}
+
+type cachedStatusList struct {
+	list      *StatusList
+	expiresAt time.Time
+}
+
+type statusListVerifier struct {
+	sync.Mutex
+	fetcher  StatusListFetcher
+	cacheTTL time.Duration
+	cache    map[string]*cachedStatusList
+}
+
+func (v *statusListVerifier) Verify(ctx context.Context, claims *StatusClaims) error {
+	// Check arguments
+	if v.fetcher == nil {
+		return errors.New("unable to use nil status list fetcher")
+	}
+	if claims == nil || claims.StatusList == nil {
+		return errors.New("token doesn't reference a status list")
+	}
+	if claims.StatusList.URI == "" {
+		return errors.New("status list uri must not be blank")
+	}
+
+	// Resolve the referenced list
+	list, err := v.statusList(ctx, claims.StatusList.URI, claims.StatusList.Index)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve status list '%s': %w", claims.StatusList.URI, err)
+	}
+
+	// Check token status
+	status, err := list.Get(claims.StatusList.Index)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve token status: %w", err)
+	}
+	switch status {
+	case StatusValid:
+	case StatusInvalid:
+		return ErrTokenRevoked
+	case StatusSuspended:
+		return ErrTokenSuspended
+	default:
+		return fmt.Errorf("unsupported token status %d", status)
+	}
+
+	// No error
+	return nil
+}
+
+func (v *statusListVerifier) statusList(ctx context.Context, uri string, idx uint64) (*StatusList, error) {
+	// Check cache, a list too short for the index is stale as indexes are
+	// allocated after the list has been fetched.
+	if list := v.cached(uri); list != nil && idx < list.Len() {
+		return list, nil
+	}
+
+	// Fetch the list without holding the lock
+	list, err := v.fetcher(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return nil, errors.New("fetcher returned a nil status list")
+	}
+
+	// Update cache
+	v.Lock()
+	v.cache[uri] = &cachedStatusList{
+		list:      list,
+		expiresAt: time.Now().Add(v.cacheTTL),
+	}
+	v.Unlock()
+
+	// No error
+	return list, nil
+}
+
+func (v *statusListVerifier) cached(uri string) *StatusList {
+	v.Lock()
+	defer v.Unlock()
+
+	entry, ok := v.cache[uri]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return nil
+	}
+
+	return entry.list
+}
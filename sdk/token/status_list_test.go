@@ -0,0 +1,340 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"zntr.io/solid/sdk/token"
+	tokenmock "zntr.io/solid/sdk/token/mock"
+)
+
+func TestStatusList(t *testing.T) {
+	t.Run("invalid bits", func(t *testing.T) {
+		_, err := token.NewStatusList(3, 8)
+		require.Error(t, err)
+	})
+
+	t.Run("set and get", func(t *testing.T) {
+		for _, bits := range []uint8{1, 2, 4, 8} {
+			list, err := token.NewStatusList(bits, 10)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, list.Len(), uint64(10))
+
+			require.NoError(t, list.Set(3, token.StatusInvalid))
+			require.NoError(t, list.Set(4, token.StatusInvalid))
+			require.NoError(t, list.Set(4, token.StatusValid))
+
+			status, err := list.Get(3)
+			require.NoError(t, err)
+			require.Equal(t, token.StatusInvalid, status)
+			status, err = list.Get(4)
+			require.NoError(t, err)
+			require.Equal(t, token.StatusValid, status)
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		list, err := token.NewStatusList(1, 8)
+		require.NoError(t, err)
+		require.Error(t, list.Set(8, token.StatusInvalid))
+		_, err = list.Get(8)
+		require.Error(t, err)
+	})
+
+	t.Run("status too large", func(t *testing.T) {
+		list, err := token.NewStatusList(1, 8)
+		require.NoError(t, err)
+		require.Error(t, list.Set(0, token.StatusSuspended))
+	})
+
+	t.Run("decompress specification example", func(t *testing.T) {
+		lst, err := base64.RawURLEncoding.DecodeString("eNrbuRgAAhcBXQ")
+		require.NoError(t, err)
+
+		list, err := token.DecompressStatusList(1, lst)
+		require.NoError(t, err)
+		require.Equal(t, uint64(16), list.Len())
+
+		want := []uint8{1, 0, 0, 1, 1, 1, 0, 1, 1, 1, 0, 0, 0, 1, 0, 1}
+		for idx, expected := range want {
+			status, err := list.Get(uint64(idx))
+			require.NoError(t, err)
+			require.Equal(t, expected, status, "index %d", idx)
+		}
+	})
+
+	t.Run("compress round trip", func(t *testing.T) {
+		list, err := token.NewStatusList(2, 1000)
+		require.NoError(t, err)
+		require.NoError(t, list.Set(999, token.StatusSuspended))
+
+		lst, err := list.Compress()
+		require.NoError(t, err)
+
+		out, err := token.DecompressStatusList(2, lst)
+		require.NoError(t, err)
+		status, err := out.Get(999)
+		require.NoError(t, err)
+		require.Equal(t, token.StatusSuspended, status)
+	})
+
+	t.Run("decompress invalid", func(t *testing.T) {
+		_, err := token.DecompressStatusList(1, []byte("invalid"))
+		require.Error(t, err)
+	})
+}
+
+func Test_statusListGenerator_Generate(t *testing.T) {
+	list, err := token.NewStatusList(1, 16)
+	require.NoError(t, err)
+	require.NoError(t, list.Set(5, token.StatusInvalid))
+
+	t.Run("nil serializer", func(t *testing.T) {
+		_, err := token.StatusListToken(nil).Generate(context.Background(), "http://localhost:8080", list)
+		require.Error(t, err)
+	})
+
+	t.Run("blank issuer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := token.StatusListToken(tokenmock.NewMockSerializer(ctrl)).Generate(context.Background(), "", list)
+		require.Error(t, err)
+	})
+
+	t.Run("nil list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := token.StatusListToken(tokenmock.NewMockSerializer(ctrl)).Generate(context.Background(), "http://localhost:8080", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("serializer error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		serializer := tokenmock.NewMockSerializer(ctrl)
+		serializer.EXPECT().Serialize(gomock.Any(), gomock.Any()).Return("", errors.New("test"))
+
+		_, err := token.StatusListToken(serializer).Generate(context.Background(), "http://localhost:8080", list)
+		require.Error(t, err)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		serializer := tokenmock.NewMockSerializer(ctrl)
+		serializer.EXPECT().Serialize(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, claims any) (string, error) {
+			c, ok := claims.(*token.StatusListTokenClaims)
+			require.True(t, ok)
+			require.Equal(t, "http://localhost:8080/statuslists/1", c.Subject)
+			require.Equal(t, uint64(60), c.TTL)
+			require.Equal(t, c.IssuedAt+60, c.ExpiresAt)
+
+			// Check JSON encoding
+			raw, err := json.Marshal(c.StatusList)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"bits":1,"lst":"`+base64.RawURLEncoding.EncodeToString(c.StatusList.List)+`"}`, string(raw))
+
+			// Check list content
+			out, err := token.DecompressStatusList(c.StatusList.Bits, c.StatusList.List)
+			require.NoError(t, err)
+			status, err := out.Get(5)
+			require.NoError(t, err)
+			require.Equal(t, token.StatusInvalid, status)
+
+			return "eyJ...", nil
+		})
+
+		underTest := token.StatusListToken(serializer,
+			token.WithStatusListTTL(time.Minute),
+			token.WithStatusListURI(func(issuer string) string {
+				return issuer + "/statuslists/1"
+			}),
+		)
+		require.Equal(t, "http://localhost:8080/statuslists/1", underTest.URI("http://localhost:8080"))
+
+		got, err := underTest.Generate(context.Background(), "http://localhost:8080", list)
+		require.NoError(t, err)
+		require.Equal(t, "eyJ...", got)
+	})
+
+	t.Run("default uri", func(t *testing.T) {
+		require.Equal(t, "http://localhost:8080/token/status", token.StatusListToken(nil).URI("http://localhost:8080/"))
+	})
+}
+
+func TestParseStatusList(t *testing.T) {
+	lst, err := base64.RawURLEncoding.DecodeString("eNrbuRgAAhcBXQ")
+	require.NoError(t, err)
+
+	prepare := func(verifier *tokenmock.MockVerifier, claims *token.StatusListTokenClaims, err error) {
+		verifier.EXPECT().Claims(gomock.Any(), "eyJ...", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, out any) error {
+			if err != nil {
+				return err
+			}
+			*(out.(*token.StatusListTokenClaims)) = *claims
+			return nil
+		})
+	}
+
+	t.Run("nil verifier", func(t *testing.T) {
+		_, err := token.ParseStatusList(context.Background(), nil, "http://localhost:8080/token/status", "eyJ...")
+		require.Error(t, err)
+	})
+
+	t.Run("verifier error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		verifier := tokenmock.NewMockVerifier(ctrl)
+		prepare(verifier, nil, errors.New("test"))
+
+		_, err := token.ParseStatusList(context.Background(), verifier, "http://localhost:8080/token/status", "eyJ...")
+		require.Error(t, err)
+	})
+
+	t.Run("subject mismatch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		verifier := tokenmock.NewMockVerifier(ctrl)
+		prepare(verifier, &token.StatusListTokenClaims{
+			Subject:    "http://localhost:8081/token/status",
+			StatusList: token.StatusListClaims{Bits: 1, List: lst},
+		}, nil)
+
+		_, err := token.ParseStatusList(context.Background(), verifier, "http://localhost:8080/token/status", "eyJ...")
+		require.Error(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		verifier := tokenmock.NewMockVerifier(ctrl)
+		prepare(verifier, &token.StatusListTokenClaims{
+			Subject:    "http://localhost:8080/token/status",
+			ExpiresAt:  uint64(time.Now().Add(-time.Minute).Unix()),
+			StatusList: token.StatusListClaims{Bits: 1, List: lst},
+		}, nil)
+
+		_, err := token.ParseStatusList(context.Background(), verifier, "http://localhost:8080/token/status", "eyJ...")
+		require.Error(t, err)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		verifier := tokenmock.NewMockVerifier(ctrl)
+		prepare(verifier, &token.StatusListTokenClaims{
+			Subject:    "http://localhost:8080/token/status",
+			ExpiresAt:  uint64(time.Now().Add(time.Minute).Unix()),
+			StatusList: token.StatusListClaims{Bits: 1, List: lst},
+		}, nil)
+
+		list, err := token.ParseStatusList(context.Background(), verifier, "http://localhost:8080/token/status", "eyJ...")
+		require.NoError(t, err)
+		require.Equal(t, uint64(16), list.Len())
+	})
+}
+
+func Test_statusListVerifier_Verify(t *testing.T) {
+	list, err := token.NewStatusList(2, 8)
+	require.NoError(t, err)
+	require.NoError(t, list.Set(1, token.StatusInvalid))
+	require.NoError(t, list.Set(2, token.StatusSuspended))
+	require.NoError(t, list.Set(3, 0x03))
+
+	const uri = "http://localhost:8080/token/status"
+	claims := func(idx uint64) *token.StatusClaims {
+		return &token.StatusClaims{
+			StatusList: &token.StatusListReference{Index: idx, URI: uri},
+		}
+	}
+
+	fetches := 0
+	underTest := token.StatusListVerifier(func(_ context.Context, u string) (*token.StatusList, error) {
+		fetches++
+		if u != uri {
+			return nil, errors.New("unknown status list")
+		}
+		return list, nil
+	}, time.Minute)
+
+	ctx := context.Background()
+	require.Error(t, underTest.Verify(ctx, nil))
+	require.Error(t, underTest.Verify(ctx, &token.StatusClaims{}))
+	require.Error(t, underTest.Verify(ctx, &token.StatusClaims{StatusList: &token.StatusListReference{}}))
+	require.Error(t, underTest.Verify(ctx, &token.StatusClaims{StatusList: &token.StatusListReference{URI: "http://localhost:8081/token/status"}}))
+	require.NoError(t, underTest.Verify(ctx, claims(0)))
+	require.ErrorIs(t, underTest.Verify(ctx, claims(1)), token.ErrTokenRevoked)
+	require.ErrorIs(t, underTest.Verify(ctx, claims(2)), token.ErrTokenSuspended)
+	require.Error(t, underTest.Verify(ctx, claims(3)))
+	require.Equal(t, 2, fetches)
+
+	// Known list is fetched once, unknown list fetch errors are not cached.
+	// An index beyond the cached list triggers a refetch.
+	require.Error(t, underTest.Verify(ctx, claims(100)))
+	require.Equal(t, 3, fetches)
+
+	require.Error(t, token.StatusListVerifier(nil, time.Minute).Verify(ctx, claims(0)))
+}
+
+func Test_statusListVerifier_Verify_StaleCache(t *testing.T) {
+	const uri = "http://localhost:8080/token/status"
+	claims := func(idx uint64) *token.StatusClaims {
+		return &token.StatusClaims{
+			StatusList: &token.StatusListReference{Index: idx, URI: uri},
+		}
+	}
+
+	// The published list grows with issued tokens
+	var size uint64 = 8
+	fetches := 0
+	underTest := token.StatusListVerifier(func(_ context.Context, _ string) (*token.StatusList, error) {
+		fetches++
+		return token.NewStatusList(1, size)
+	}, time.Hour)
+
+	ctx := context.Background()
+	require.NoError(t, underTest.Verify(ctx, claims(0)))
+	require.Equal(t, 1, fetches)
+
+	// A token issued after the fetch is verified against a fresh list
+	size = 16
+	require.NoError(t, underTest.Verify(ctx, claims(10)))
+	require.Equal(t, 2, fetches)
+
+	// The fresh list is cached
+	require.NoError(t, underTest.Verify(ctx, claims(0)))
+	require.NoError(t, underTest.Verify(ctx, claims(15)))
+	require.Equal(t, 2, fetches)
+}
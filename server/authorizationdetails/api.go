@@ -0,0 +1,62 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package authorizationdetails enforces the authorization details a client can
+// obtain.
+//
+// Each authorization details type is checked by the validator registered for
+// it, unknown types are rejected.
+// https://www.rfc-editor.org/rfc/rfc9396#section-5
+package authorizationdetails
+
+import (
+	"context"
+	"errors"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	"zntr.io/solid/sdk/rar"
+)
+
+// ErrInvalidAuthorizationDetails is returned when the authorization details
+// are unknown, malformed or not allowed for the client.
+var ErrInvalidAuthorizationDetails = errors.New("invalid authorization details")
+
+//go:generate mockgen -destination mock/registry.gen.go -package mock zntr.io/solid/server/authorizationdetails Registry
+
+// Registry describes the authorization details validator registry contract.
+type Registry interface {
+	// Types returns the supported authorization details types.
+	Types() []string
+	// Validate checks each authorization details object against the client
+	// allowed types and the validator registered for its type.
+	Validate(ctx context.Context, client *clientv1.Client, details []rar.Detail) error
+}
+
+// Validator validates an authorization details object of a given type. It
+// must return an error wrapping ErrInvalidAuthorizationDetails to reject the
+// object, other errors are considered as server errors.
+type Validator interface {
+	Validate(ctx context.Context, client *clientv1.Client, detail rar.Detail) error
+}
+
+// ValidatorFunc adapts a function as a Validator.
+type ValidatorFunc func(ctx context.Context, client *clientv1.Client, detail rar.Detail) error
+
+// Validate calls f(ctx, client, detail).
+func (f ValidatorFunc) Validate(ctx context.Context, client *clientv1.Client, detail rar.Detail) error {
+	return f(ctx, client, detail)
+}
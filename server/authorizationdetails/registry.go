@@ -0,0 +1,97 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package authorizationdetails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	"zntr.io/solid/sdk/rar"
+	"zntr.io/solid/sdk/types"
+)
+
+// StaticRegistry returns an authorization details registry built from the
+// given validators indexed by type.
+func StaticRegistry(validators map[string]Validator) (Registry, error) {
+	r := &staticRegistry{
+		validators: map[string]Validator{},
+	}
+
+	for name, v := range validators {
+		// Check settings
+		switch {
+		case name == "":
+			return nil, errors.New("authorization details type must not be blank")
+		case types.IsNil(v):
+			return nil, fmt.Errorf("authorization details type '%s' must have a validator", name)
+		default:
+		}
+
+		// Register validator
+		r.validators[name] = v
+		r.types = append(r.types, name)
+	}
+
+	// Stable metadata output
+	sort.Strings(r.types)
+
+	// No error
+	return r, nil
+}
+
+// -----------------------------------------------------------------------------
+
+type staticRegistry struct {
+	validators map[string]Validator
+	types      []string
+}
+
+func (r *staticRegistry) Types() []string {
+	return append([]string(nil), r.types...)
+}
+
+func (r *staticRegistry) Validate(ctx context.Context, client *clientv1.Client, details []rar.Detail) error {
+	// Check arguments
+	if client == nil {
+		return errors.New("unable to validate authorization details for a nil client")
+	}
+
+	for _, detail := range details {
+		// Check registration
+		v, ok := r.validators[detail.Type()]
+		if !ok {
+			return fmt.Errorf("%w: type '%s' is not supported", ErrInvalidAuthorizationDetails, detail.Type())
+		}
+
+		// Check client allowed types
+		if len(client.AuthorizationDetailsTypes) > 0 && !types.StringArray(client.AuthorizationDetailsTypes).Contains(detail.Type()) {
+			return fmt.Errorf("%w: type '%s' is not allowed for client '%s'", ErrInvalidAuthorizationDetails, detail.Type(), client.ClientId)
+		}
+
+		// Delegate to type validator
+		if err := v.Validate(ctx, client, detail); err != nil {
+			return fmt.Errorf("unable to validate '%s' authorization details: %w", detail.Type(), err)
+		}
+	}
+
+	// No error
+	return nil
+}
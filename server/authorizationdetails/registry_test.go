@@ -0,0 +1,164 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package authorizationdetails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	"zntr.io/solid/sdk/rar"
+)
+
+func TestStaticRegistry(t *testing.T) {
+	accept := ValidatorFunc(func(_ context.Context, _ *clientv1.Client, _ rar.Detail) error {
+		return nil
+	})
+
+	tests := []struct {
+		name       string
+		validators map[string]Validator
+		wantTypes  []string
+		wantErr    bool
+	}{
+		{
+			name:       "empty",
+			validators: nil,
+			wantTypes:  nil,
+		},
+		{
+			name:       "blank type",
+			validators: map[string]Validator{"": accept},
+			wantErr:    true,
+		},
+		{
+			name:       "nil validator",
+			validators: map[string]Validator{"payment_initiation": nil},
+			wantErr:    true,
+		},
+		{
+			name:       "valid",
+			validators: map[string]Validator{"payment_initiation": accept, "account_information": accept},
+			wantTypes:  []string{"account_information", "payment_initiation"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StaticRegistry(tt.validators)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StaticRegistry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if types := got.Types(); !reflect.DeepEqual(types, tt.wantTypes) {
+				t.Errorf("Types() = %v, want %v", types, tt.wantTypes)
+			}
+		})
+	}
+}
+
+func Test_staticRegistry_Validate(t *testing.T) {
+	registry, err := StaticRegistry(map[string]Validator{
+		"payment_initiation": ValidatorFunc(func(_ context.Context, _ *clientv1.Client, detail rar.Detail) error {
+			if _, ok := detail["instructedAmount"]; !ok {
+				return fmt.Errorf("%w: instructedAmount is mandatory", ErrInvalidAuthorizationDetails)
+			}
+			return nil
+		}),
+		"sign": ValidatorFunc(func(_ context.Context, _ *clientv1.Client, _ rar.Detail) error {
+			return errors.New("signing service unavailable")
+		}),
+		"account_information": ValidatorFunc(func(_ context.Context, _ *clientv1.Client, _ rar.Detail) error {
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error occurs, got %v", err)
+	}
+
+	payment := rar.Detail{"type": "payment_initiation", "instructedAmount": map[string]any{"currency": "EUR", "amount": "123.50"}}
+
+	tests := []struct {
+		name        string
+		client      *clientv1.Client
+		details     []rar.Detail
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name:    "nil client",
+			client:  nil,
+			details: []rar.Detail{payment},
+			wantErr: true,
+		},
+		{
+			name:    "nothing requested",
+			client:  &clientv1.Client{ClientId: "s6BhdRkqt3"},
+			details: nil,
+		},
+		{
+			name:        "unknown type",
+			client:      &clientv1.Client{ClientId: "s6BhdRkqt3"},
+			details:     []rar.Detail{{"type": "unknown"}},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "type not allowed for client",
+			client:      &clientv1.Client{ClientId: "s6BhdRkqt3", AuthorizationDetailsTypes: []string{"account_information"}},
+			details:     []rar.Detail{payment},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "rejected by validator",
+			client:      &clientv1.Client{ClientId: "s6BhdRkqt3"},
+			details:     []rar.Detail{{"type": "payment_initiation"}},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:    "validator error",
+			client:  &clientv1.Client{ClientId: "s6BhdRkqt3"},
+			details: []rar.Detail{{"type": "sign"}},
+			wantErr: true,
+		},
+		{
+			name:    "valid",
+			client:  &clientv1.Client{ClientId: "s6BhdRkqt3", AuthorizationDetailsTypes: []string{"payment_initiation", "account_information"}},
+			details: []rar.Detail{payment, {"type": "account_information"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registry.Validate(context.Background(), tt.client, tt.details)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got := errors.Is(err, ErrInvalidAuthorizationDetails); got != tt.wantInvalid {
+				t.Errorf("Validate() invalid = %v, want %v", got, tt.wantInvalid)
+			}
+		})
+	}
+}
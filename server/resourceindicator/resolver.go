@@ -0,0 +1,101 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package resourceindicator resolves the resources targeted by a request.
+//
+// The "audience" parameter and the "resource" parameters share the same
+// meaning: both reference a resource registered in the resource registry by
+// its identifier, and the issued token audience is always the resource
+// identifier.
+// https://www.rfc-editor.org/rfc/rfc8707
+package resourceindicator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+)
+
+// ErrInvalidTarget is returned when a requested resource is malformed or not
+// registered.
+var ErrInvalidTarget = errors.New("invalid target")
+
+// Resolve validates the given audience and resource indicators against the
+// resource registry and returns the deduplicated resource identifiers.
+func Resolve(ctx context.Context, resources storage.ResourceReader, audience string, indicators []string) ([]string, error) {
+	// Nothing requested
+	if audience == "" && len(indicators) == 0 {
+		return nil, nil
+	}
+
+	// Check arguments
+	if types.IsNil(resources) {
+		return nil, errors.New("resource registry is not configured")
+	}
+
+	// Check indicators syntax
+	for _, indicator := range indicators {
+		u, err := url.Parse(indicator)
+		if err != nil {
+			return nil, fmt.Errorf("%w: resource '%s' is not a valid uri: %v", ErrInvalidTarget, indicator, err)
+		}
+		if !u.IsAbs() || u.Fragment != "" {
+			return nil, fmt.Errorf("%w: resource '%s' must be an absolute uri without fragment", ErrInvalidTarget, indicator)
+		}
+	}
+
+	// Audience is a logical resource identifier
+	targets := indicators
+	if audience != "" {
+		targets = append([]string{audience}, indicators...)
+	}
+
+	// Resolve from registry
+	var ids types.StringArray
+	for _, target := range targets {
+		r, err := resources.GetByURI(ctx, target)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, fmt.Errorf("%w: resource '%s' is not registered", ErrInvalidTarget, target)
+			}
+			return nil, fmt.Errorf("unable to retrieve resource '%s': %w", target, err)
+		}
+
+		ids.AddIfNotContains(r.Urn)
+	}
+
+	// No error
+	return ids, nil
+}
+
+// Granted returns the resource identifiers granted by an authorization
+// request.
+func Granted(audience string, indicators []string) []string {
+	var granted types.StringArray
+	if audience != "" {
+		granted.AddIfNotContains(audience)
+	}
+	for _, indicator := range indicators {
+		granted.AddIfNotContains(indicator)
+	}
+
+	return granted
+}
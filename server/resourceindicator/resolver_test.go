@@ -0,0 +1,142 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceindicator
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	"zntr.io/solid/server/storage"
+	storagemock "zntr.io/solid/server/storage/mock"
+)
+
+func TestResolve(t *testing.T) {
+	type args struct {
+		audience   string
+		indicators []string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		prepare       func(*storagemock.MockResourceReader)
+		want          []string
+		wantErr       bool
+		invalidTarget bool
+	}{
+		{
+			name: "nothing requested",
+			args: args{},
+		},
+		{
+			name: "relative resource",
+			args: args{
+				indicators: []string{"/api"},
+			},
+			wantErr:       true,
+			invalidTarget: true,
+		},
+		{
+			name: "resource with fragment",
+			args: args{
+				indicators: []string{"https://backend.example.com/api#v1"},
+			},
+			wantErr:       true,
+			invalidTarget: true,
+		},
+		{
+			name: "unknown audience",
+			args: args{
+				audience: "urn:example:unknown",
+			},
+			prepare: func(resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:unknown").Return(nil, storage.ErrNotFound)
+			},
+			wantErr:       true,
+			invalidTarget: true,
+		},
+		{
+			name: "registry error",
+			args: args{
+				indicators: []string{"urn:example:backend-api"},
+			},
+			prepare: func(resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(nil, errors.New("test"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				audience:   "urn:example:cooperation-context",
+				indicators: []string{"urn:example:backend-api", "urn:example:cooperation-context"},
+			},
+			prepare: func(resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:cooperation-context").Return(&resourcev1.Resource{Urn: "urn:example:cooperation-context"}, nil).Times(2)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{Urn: "urn:example:backend-api"}, nil)
+			},
+			want: []string{"urn:example:cooperation-context", "urn:example:backend-api"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			resources := storagemock.NewMockResourceReader(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(resources)
+			}
+
+			got, err := Resolve(context.Background(), resources, tt.args.audience, tt.args.indicators)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Resolve() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrInvalidTarget) != tt.invalidTarget {
+				t.Errorf("Resolve() error = %v, invalidTarget %v", err, tt.invalidTarget)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolve_NilRegistry(t *testing.T) {
+	if _, err := Resolve(context.Background(), nil, "urn:example:backend-api", nil); err == nil || errors.Is(err, ErrInvalidTarget) {
+		t.Errorf("Resolve() error = %v, want a server error", err)
+	}
+}
+
+func TestGranted(t *testing.T) {
+	got := Granted("urn:example:cooperation-context", []string{"urn:example:backend-api", "urn:example:cooperation-context"})
+	want := []string{"urn:example:cooperation-context", "urn:example:backend-api"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Granted() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,128 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package scopepolicy enforces the scopes a client can obtain.
+//
+// A requested scope must be allowed for the client and registered in the
+// scope registry, and a scope derived from a previous grant can only be
+// narrowed.
+// https://www.rfc-editor.org/rfc/rfc6749#section-3.3
+package scopepolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+)
+
+// ErrInvalidScope is returned when a requested scope is not allowed, not
+// registered or exceeds the granted scope.
+var ErrInvalidScope = errors.New("invalid scope")
+
+// Authorize validates each requested scope against the client allowed scopes
+// and the scope registry, when configured, and returns the normalized scope.
+func Authorize(ctx context.Context, scopes storage.ScopeReader, client *clientv1.Client, requested string) (string, error) {
+	// Check arguments
+	if client == nil {
+		return "", errors.New("unable to authorize scope for a nil client")
+	}
+
+	// Reject the whole request on the first invalid scope
+	var authorized types.StringArray
+	for _, name := range strings.Fields(requested) {
+		if err := check(ctx, scopes, client, name); err != nil {
+			return "", err
+		}
+		authorized.AddIfNotContains(name)
+	}
+
+	// No error
+	return strings.Join(authorized, " "), nil
+}
+
+// Intersect returns the given scope restricted to the scopes allowed for the
+// client and registered in the scope registry, when configured.
+func Intersect(ctx context.Context, scopes storage.ScopeReader, client *clientv1.Client, scope string) (string, error) {
+	// Check arguments
+	if client == nil {
+		return "", errors.New("unable to intersect scope for a nil client")
+	}
+
+	// Silently drop invalid scopes
+	var kept types.StringArray
+	for _, name := range strings.Fields(scope) {
+		if err := check(ctx, scopes, client, name); err != nil {
+			if errors.Is(err, ErrInvalidScope) {
+				continue
+			}
+			return "", err
+		}
+		kept.AddIfNotContains(name)
+	}
+
+	// No error
+	return strings.Join(kept, " "), nil
+}
+
+// Downscope returns the requested scope when it is a subset of the granted
+// scope, or the granted scope when nothing is requested.
+func Downscope(granted string, requested *string) (string, error) {
+	// Nothing requested
+	if requested == nil {
+		return granted, nil
+	}
+
+	// Requested scope must not exceed the granted one
+	var narrowed types.StringArray
+	for _, name := range strings.Fields(*requested) {
+		if !types.StringArray(strings.Fields(granted)).Contains(name) {
+			return "", fmt.Errorf("%w: scope '%s' has not been granted", ErrInvalidScope, name)
+		}
+		narrowed.AddIfNotContains(name)
+	}
+
+	// No error
+	return strings.Join(narrowed, " "), nil
+}
+
+// -----------------------------------------------------------------------------
+
+func check(ctx context.Context, scopes storage.ScopeReader, client *clientv1.Client, name string) error {
+	// Check client allowed scopes
+	if len(client.Scopes) > 0 && !types.StringArray(client.Scopes).Contains(name) {
+		return fmt.Errorf("%w: scope '%s' is not allowed for client '%s'", ErrInvalidScope, name, client.ClientId)
+	}
+
+	// Check scope registry
+	if types.IsNil(scopes) {
+		return nil
+	}
+	if _, err := scopes.GetByName(ctx, name); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("%w: scope '%s' is not registered", ErrInvalidScope, name)
+		}
+		return fmt.Errorf("unable to retrieve scope '%s': %w", name, err)
+	}
+
+	// No error
+	return nil
+}
@@ -0,0 +1,216 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package scopepolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	scopev1 "zntr.io/solid/api/oidc/scope/v1"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+	storagemock "zntr.io/solid/server/storage/mock"
+)
+
+func TestAuthorize(t *testing.T) {
+	type args struct {
+		client    *clientv1.Client
+		requested string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		prepare      func(*storagemock.MockScopeReader)
+		want         string
+		wantErr      bool
+		invalidScope bool
+	}{
+		{
+			name: "nil client",
+			args: args{
+				requested: "openid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "nothing requested",
+			args: args{
+				client: &clientv1.Client{},
+			},
+		},
+		{
+			name: "not allowed for client",
+			args: args{
+				client: &clientv1.Client{
+					ClientId: "s6BhdRkqt3",
+					Scopes:   []string{"openid"},
+				},
+				requested: "openid admin",
+			},
+			prepare: func(scopes *storagemock.MockScopeReader) {
+				scopes.EXPECT().GetByName(gomock.Any(), "openid").Return(&scopev1.Scope{Name: "openid"}, nil)
+			},
+			wantErr:      true,
+			invalidScope: true,
+		},
+		{
+			name: "not registered",
+			args: args{
+				client:    &clientv1.Client{},
+				requested: "admin",
+			},
+			prepare: func(scopes *storagemock.MockScopeReader) {
+				scopes.EXPECT().GetByName(gomock.Any(), "admin").Return(nil, storage.ErrNotFound)
+			},
+			wantErr:      true,
+			invalidScope: true,
+		},
+		{
+			name: "registry error",
+			args: args{
+				client:    &clientv1.Client{},
+				requested: "openid",
+			},
+			prepare: func(scopes *storagemock.MockScopeReader) {
+				scopes.EXPECT().GetByName(gomock.Any(), "openid").Return(nil, errors.New("test"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				client: &clientv1.Client{
+					Scopes: []string{"openid", "profile"},
+				},
+				requested: " openid  profile openid",
+			},
+			prepare: func(scopes *storagemock.MockScopeReader) {
+				scopes.EXPECT().GetByName(gomock.Any(), "openid").Return(&scopev1.Scope{Name: "openid"}, nil).Times(2)
+				scopes.EXPECT().GetByName(gomock.Any(), "profile").Return(&scopev1.Scope{Name: "profile"}, nil)
+			},
+			want: "openid profile",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			scopes := storagemock.NewMockScopeReader(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(scopes)
+			}
+
+			got, err := Authorize(context.Background(), scopes, tt.args.client, tt.args.requested)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Authorize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrInvalidScope) != tt.invalidScope {
+				t.Errorf("Authorize() error = %v, invalidScope %v", err, tt.invalidScope)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Authorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorize_NilRegistry(t *testing.T) {
+	got, err := Authorize(context.Background(), nil, &clientv1.Client{}, "openid admin")
+	if err != nil {
+		t.Errorf("Authorize() error = %v, want nil", err)
+		return
+	}
+	if got != "openid admin" {
+		t.Errorf("Authorize() = %v, want %v", got, "openid admin")
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scopes := storagemock.NewMockScopeReader(ctrl)
+	scopes.EXPECT().GetByName(gomock.Any(), "openid").Return(&scopev1.Scope{Name: "openid"}, nil)
+	scopes.EXPECT().GetByName(gomock.Any(), "legacy").Return(nil, storage.ErrNotFound)
+
+	got, err := Intersect(context.Background(), scopes, &clientv1.Client{
+		Scopes: []string{"openid", "legacy"},
+	}, "openid admin legacy")
+	if err != nil {
+		t.Errorf("Intersect() error = %v, want nil", err)
+		return
+	}
+	if got != "openid" {
+		t.Errorf("Intersect() = %v, want %v", got, "openid")
+	}
+}
+
+func TestDownscope(t *testing.T) {
+	tests := []struct {
+		name      string
+		granted   string
+		requested *string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:    "nothing requested",
+			granted: "openid profile",
+			want:    "openid profile",
+		},
+		{
+			name:      "exceeds granted",
+			granted:   "openid",
+			requested: types.StringRef("openid admin"),
+			wantErr:   true,
+		},
+		{
+			name:      "subset",
+			granted:   "openid profile email",
+			requested: types.StringRef("email openid"),
+			want:      "email openid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Downscope(tt.granted, tt.requested)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Downscope() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, ErrInvalidScope) {
+				t.Errorf("Downscope() error = %v, want invalid scope", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Downscope() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -40,6 +40,16 @@ type Token interface {
 	Introspect(ctx context.Context, req *tokenv1.IntrospectRequest) (*tokenv1.IntrospectResponse, error)
 	// Revoke given token.
 	Revoke(ctx context.Context, req *tokenv1.RevokeRequest) (*tokenv1.RevokeResponse, error)
+	// RevokeBySubject revokes all tokens issued to a subject.
+	RevokeBySubject(ctx context.Context, req *tokenv1.RevokeBySubjectRequest) (*tokenv1.RevokeBySubjectResponse, error)
+	// RevokeByClient revokes all tokens issued to a client.
+	RevokeByClient(ctx context.Context, req *tokenv1.RevokeByClientRequest) (*tokenv1.RevokeByClientResponse, error)
+	// RevokeByGrant revokes all tokens issued from the same grant.
+	RevokeByGrant(ctx context.Context, req *tokenv1.RevokeByGrantRequest) (*tokenv1.RevokeByGrantResponse, error)
+	// ResolvePhantom resolves a phantom token to its signed representation.
+	ResolvePhantom(ctx context.Context, req *tokenv1.PhantomTokenRequest) (*tokenv1.PhantomTokenResponse, error)
+	// StatusList publishes the signed token status list of an issuer.
+	StatusList(ctx context.Context, req *tokenv1.StatusListRequest) (*tokenv1.StatusListResponse, error)
 }
 
 // Device authorization service contract.
@@ -49,3 +59,11 @@ type Device interface {
 	// Validate user code
 	Validate(ctx context.Context, req *flowv1.DeviceCodeValidationRequest) (*flowv1.DeviceCodeValidationResponse, error)
 }
+
+// Backchannel authentication service contract.
+type Backchannel interface {
+	// Authenticate process backchannel authentication request.
+	Authenticate(ctx context.Context, req *flowv1.BackchannelAuthenticationRequest) (*flowv1.BackchannelAuthenticationResponse, error)
+	// Validate records the end-user decision for a pending authentication request.
+	Validate(ctx context.Context, req *flowv1.BackchannelAuthenticationValidationRequest) (*flowv1.BackchannelAuthenticationValidationResponse, error)
+}
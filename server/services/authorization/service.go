@@ -19,17 +19,23 @@ package authorization
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
 
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	corev1 "zntr.io/solid/api/oidc/core/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
 	sessionv1 "zntr.io/solid/api/oidc/session/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/generator"
+	"zntr.io/solid/sdk/rar"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/authorizationdetails"
+	"zntr.io/solid/server/resourceindicator"
+	"zntr.io/solid/server/scopepolicy"
 	"zntr.io/solid/server/services"
 	"zntr.io/solid/server/storage"
 )
@@ -46,17 +52,26 @@ type service struct {
 	authorizationCodeSessions storage.AuthorizationCodeSessionWriter
 	codeGenerator             generator.AuthorizationCode
 	requestURIGenerator       generator.RequestURI
+	resources                 storage.ResourceReader
+	scopes                    storage.ScopeReader
+	authorizationDetails      authorizationdetails.Registry
 }
 
 // New build and returns an authorization service implementation.
-func New(clients storage.ClientReader, authorizationRequests storage.AuthorizationRequest, authorizationCodeSessions storage.AuthorizationCodeSessionWriter, codeGenerator generator.AuthorizationCode, requestURIGenerator generator.RequestURI) services.Authorization {
-	return &service{
+func New(clients storage.ClientReader, authorizationRequests storage.AuthorizationRequest, authorizationCodeSessions storage.AuthorizationCodeSessionWriter, codeGenerator generator.AuthorizationCode, requestURIGenerator generator.RequestURI, resources storage.ResourceReader, opts ...Option) services.Authorization {
+	s := &service{
 		clients:                   clients,
 		authorizationRequests:     authorizationRequests,
 		authorizationCodeSessions: authorizationCodeSessions,
 		codeGenerator:             codeGenerator,
 		requestURIGenerator:       requestURIGenerator,
+		resources:                 resources,
 	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
 }
 
 // -----------------------------------------------------------------------------
@@ -96,8 +111,8 @@ func (s *service) Authorize(ctx context.Context, req *flowv1.AuthorizeRequest) (
 			return res, fmt.Errorf("request_uri is syntaxically invalid '%s'", *req.Request.RequestUri)
 		}
 
-		// Check if request uri exists in storage
-		ar, err := s.authorizationRequests.Get(ctx, req.Issuer, *req.Request.RequestUri)
+		// Retrieve and burn the request in a single operation
+		ar, err := s.authorizationRequests.Consume(ctx, req.Issuer, *req.Request.RequestUri)
 		if err != nil {
 			if err != storage.ErrNotFound {
 				res.Error = rfcerrors.ServerError().Build()
@@ -107,16 +122,6 @@ func (s *service) Authorize(ctx context.Context, req *flowv1.AuthorizeRequest) (
 			return res, fmt.Errorf("unable to retrieve request by uri: %w", err)
 		}
 
-		// Burn after read
-		if err := s.authorizationRequests.Delete(ctx, req.Issuer, *req.Request.RequestUri); err != nil {
-			if err != storage.ErrNotFound {
-				res.Error = rfcerrors.ServerError().Build()
-			} else {
-				res.Error = rfcerrors.InvalidRequest().Build()
-			}
-			return res, fmt.Errorf("unable to retrieve request by uri: %w", err)
-		}
-
 		// Override request
 		req.Request = ar
 	}
@@ -137,9 +142,11 @@ func (s *service) Authorize(ctx context.Context, req *flowv1.AuthorizeRequest) (
 
 	// Create an authorization session
 	expiresIn, err := s.authorizationCodeSessions.Register(ctx, req.Issuer, code, &sessionv1.AuthorizationCodeSession{
-		Issuer:  req.Issuer,
-		Subject: req.Subject,
-		Request: req.Request,
+		Issuer:   req.Issuer,
+		Subject:  req.Subject,
+		Request:  req.Request,
+		AuthTime: req.AuthTime,
+		Acr:      req.Acr,
 	})
 	if err != nil {
 		res.Error = rfcerrors.ServerError().State(req.Request.State).Build()
@@ -321,8 +328,13 @@ func (s *service) validate(ctx context.Context, req *flowv1.AuthorizationRequest
 		req.Scope = strings.Join(scopes, " ")
 	}
 
+	// Check client capabilities
+	if publicErr, err := s.validateClientCapabilities(ctx, req); err != nil {
+		return publicErr, err
+	}
+
 	// No error
-	return s.validateClientCapabilities(ctx, req)
+	return s.validateResources(ctx, req)
 }
 
 func (s *service) validateClientCapabilities(ctx context.Context, req *flowv1.AuthorizationRequest) (*corev1.Error, error) {
@@ -358,6 +370,69 @@ func (s *service) validateClientCapabilities(ctx context.Context, req *flowv1.Au
 		}
 	}
 
+	// Validate requested scopes
+	req.Scope, err = scopepolicy.Authorize(ctx, s.scopes, client, req.Scope)
+	if err != nil {
+		if !errors.Is(err, scopepolicy.ErrInvalidScope) {
+			return rfcerrors.ServerError().State(req.State).Build(), fmt.Errorf("unable to authorize requested scope: %w", err)
+		}
+
+		return rfcerrors.InvalidScope().State(req.State).Build(), fmt.Errorf("unable to authorize requested scope: %w", err)
+	}
+
+	// Validate requested authorization details
+	if publicErr, err := s.validateAuthorizationDetails(ctx, client, req); err != nil {
+		return publicErr, err
+	}
+
+	// No error
+	return nil, nil
+}
+
+func (s *service) validateAuthorizationDetails(ctx context.Context, client *clientv1.Client, req *flowv1.AuthorizationRequest) (*corev1.Error, error) {
+	// Decode authorization details
+	details, err := rar.Parse(req.AuthorizationDetails)
+	if err != nil {
+		return rfcerrors.InvalidAuthorizationDetails().State(req.State).Build(), fmt.Errorf("unable to decode authorization details: %w", err)
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	// Check registry
+	if s.authorizationDetails == nil {
+		return rfcerrors.InvalidAuthorizationDetails().State(req.State).Build(), fmt.Errorf("authorization details are not supported")
+	}
+
+	// Validate each authorization details object
+	if err := s.authorizationDetails.Validate(ctx, client, details); err != nil {
+		if !errors.Is(err, authorizationdetails.ErrInvalidAuthorizationDetails) {
+			return rfcerrors.ServerError().State(req.State).Build(), fmt.Errorf("unable to validate authorization details: %w", err)
+		}
+
+		return rfcerrors.InvalidAuthorizationDetails().State(req.State).Build(), fmt.Errorf("unable to validate authorization details: %w", err)
+	}
+
+	// Normalize authorization details
+	req.AuthorizationDetails, err = rar.Encode(details)
+	if err != nil {
+		return rfcerrors.ServerError().State(req.State).Build(), fmt.Errorf("unable to encode authorization details: %w", err)
+	}
+
+	// No error
+	return nil, nil
+}
+
+func (s *service) validateResources(ctx context.Context, req *flowv1.AuthorizationRequest) (*corev1.Error, error) {
+	// Resolve targeted resources
+	if _, err := resourceindicator.Resolve(ctx, s.resources, req.Audience, req.Resource); err != nil {
+		if !errors.Is(err, resourceindicator.ErrInvalidTarget) {
+			return rfcerrors.ServerError().State(req.State).Build(), fmt.Errorf("unable to resolve requested resources: %w", err)
+		}
+
+		return rfcerrors.InvalidTarget().State(req.State).Build(), fmt.Errorf("unable to resolve requested resources: %w", err)
+	}
+
 	// No error
 	return nil, nil
 }
@@ -19,6 +19,7 @@ package authorization
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -31,9 +32,12 @@ import (
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	corev1 "zntr.io/solid/api/oidc/core/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/authorizationdetails"
+	authorizationdetailsmock "zntr.io/solid/server/authorizationdetails/mock"
 	"zntr.io/solid/server/storage"
 	storagemock "zntr.io/solid/server/storage/mock"
 )
@@ -48,7 +52,7 @@ func Test_service_validate(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockClientReader)
+		prepare func(*storagemock.MockClientReader, *storagemock.MockResourceReader)
 		want    *corev1.Error
 		wantErr bool
 	}{
@@ -363,7 +367,7 @@ func Test_service_validate(t *testing.T) {
 					CodeChallengeMethod: "S256",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
@@ -385,7 +389,7 @@ func Test_service_validate(t *testing.T) {
 					CodeChallengeMethod: "S256",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -407,7 +411,7 @@ func Test_service_validate(t *testing.T) {
 					CodeChallengeMethod: "S256",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes: []string{"client_credentials"},
 				}, nil)
@@ -431,7 +435,7 @@ func Test_service_validate(t *testing.T) {
 					CodeChallengeMethod: "S256",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"id_token"},
@@ -456,7 +460,7 @@ func Test_service_validate(t *testing.T) {
 					CodeChallengeMethod: "S256",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -483,7 +487,7 @@ func Test_service_validate(t *testing.T) {
 					ResponseMode:        types.StringRef(oidc.ResponseModeQueryJWT),
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -494,6 +498,175 @@ func Test_service_validate(t *testing.T) {
 			wantErr: true,
 			want:    rfcerrors.InvalidRequest().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
 		},
+		{
+			name: "client scope not allowed",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+					Scopes:        []string{"openid", "profile"},
+				}, nil)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidScope().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "unknown audience",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              types.StringRef(oidc.PromptConsent),
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidTarget().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "resource registry error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              types.StringRef(oidc.PromptConsent),
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want:    rfcerrors.ServerError().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "resource with fragment",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              types.StringRef(oidc.PromptConsent),
+					Resource:            []string{"https://backend.example.com/api#fragment"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				}, nil)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidTarget().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "relative resource",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              types.StringRef(oidc.PromptConsent),
+					Resource:            []string{"/api"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				}, nil)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidTarget().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "unknown resource",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              types.StringRef(oidc.PromptConsent),
+					Resource:            []string{"urn:example:unknown"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:unknown").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidTarget().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "valid : application uri",
@@ -512,7 +685,8 @@ func Test_service_validate(t *testing.T) {
 					Prompt:              types.StringRef(oidc.PromptConsent),
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -539,12 +713,43 @@ func Test_service_validate(t *testing.T) {
 					Prompt:              types.StringRef(oidc.PromptConsent),
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader) {
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				}, nil)
+			},
+			wantErr: false,
+			want:    nil,
+		},
+		{
+			name: "valid : resource",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              types.StringRef(oidc.PromptConsent),
+					Resource:            []string{"urn:example:backend-api"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, resources *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
 					RedirectUris:  []string{"https://client.example.org/cb"},
 				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{Urn: "urn:example:backend-api"}, nil)
 			},
 			wantErr: false,
 			want:    nil,
@@ -559,16 +764,18 @@ func Test_service_validate(t *testing.T) {
 			authorizationRequests := storagemock.NewMockAuthorizationRequest(ctrl)
 			clients := storagemock.NewMockClientReader(ctrl)
 			sessions := storagemock.NewMockAuthorizationCodeSessionWriter(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(clients)
+				tt.prepare(clients, resources)
 			}
 
 			s := &service{
 				clients:                   clients,
 				authorizationRequests:     authorizationRequests,
 				authorizationCodeSessions: sessions,
+				resources:                 resources,
 			}
 			got, err := s.validate(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -608,3 +815,129 @@ func Test_service_validate_Fuzz(t *testing.T) {
 		s.validate(context.Background(), &req)
 	}
 }
+
+func Test_service_validateAuthorizationDetails(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *flowv1.AuthorizationRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		noRegistry  bool
+		prepare     func(*authorizationdetailsmock.MockRegistry)
+		want        *corev1.Error
+		wantDetails string
+		wantErr     bool
+	}{
+		{
+			name: "no authorization details",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{},
+			},
+			noRegistry: true,
+			wantErr:    false,
+		},
+		{
+			name: "malformed authorization details",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					State:                "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					AuthorizationDetails: `{"type":"payment_initiation"}`,
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidAuthorizationDetails().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "unsupported authorization details",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					State:                "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+				},
+			},
+			noRegistry: true,
+			wantErr:    true,
+			want:       rfcerrors.InvalidAuthorizationDetails().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "registry error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					State:                "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+				},
+			},
+			prepare: func(registry *authorizationdetailsmock.MockRegistry) {
+				registry.EXPECT().Validate(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("test"))
+			},
+			wantErr: true,
+			want:    rfcerrors.ServerError().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "rejected authorization details",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					State:                "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+				},
+			},
+			prepare: func(registry *authorizationdetailsmock.MockRegistry) {
+				registry.EXPECT().Validate(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("missing amount: %w", authorizationdetails.ErrInvalidAuthorizationDetails))
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidAuthorizationDetails().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.AuthorizationRequest{
+					State:                "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					AuthorizationDetails: ` [ {"type": "payment_initiation", "locations": ["https://example.com/payments"]} ] `,
+				},
+			},
+			prepare: func(registry *authorizationdetailsmock.MockRegistry) {
+				registry.EXPECT().Validate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr:     false,
+			wantDetails: `[{"locations":["https://example.com/payments"],"type":"payment_initiation"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			registry := authorizationdetailsmock.NewMockRegistry(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(registry)
+			}
+
+			s := &service{}
+			if !tt.noRegistry {
+				s.authorizationDetails = registry
+			}
+			got, err := s.validateAuthorizationDetails(tt.args.ctx, &clientv1.Client{}, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.validateAuthorizationDetails() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.validateAuthorizationDetails() res =%s", diff)
+			}
+			if !tt.wantErr && tt.args.req.AuthorizationDetails != tt.wantDetails {
+				t.Errorf("service.validateAuthorizationDetails() details = %q, want %q", tt.args.req.AuthorizationDetails, tt.wantDetails)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
 	sessionv1 "zntr.io/solid/api/oidc/session/v1"
 	"zntr.io/solid/oidc"
 	generatormock "zntr.io/solid/sdk/generator/mock"
@@ -45,7 +46,7 @@ func Test_service_Authorize(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockAuthorizationRequest, *storagemock.MockClientReader, *storagemock.MockAuthorizationCodeSessionWriter, *generatormock.MockAuthorizationCode, *generatormock.MockRequestURI)
+		prepare func(*storagemock.MockAuthorizationRequest, *storagemock.MockClientReader, *storagemock.MockAuthorizationCodeSessionWriter, *generatormock.MockAuthorizationCode, *generatormock.MockRequestURI, *storagemock.MockResourceReader)
 		want    *flowv1.AuthorizeResponse
 		wantErr bool
 	}{
@@ -119,7 +120,7 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(mar *storagemock.MockAuthorizationRequest, mcr *storagemock.MockClientReader, macsw *storagemock.MockAuthorizationCodeSessionWriter, mac *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(mar *storagemock.MockAuthorizationRequest, mcr *storagemock.MockClientReader, macsw *storagemock.MockAuthorizationCodeSessionWriter, mac *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, _ *storagemock.MockResourceReader) {
 				mru.EXPECT().Validate(gomock.Any(), "https://honest.as.example", "123-456-789").Return(fmt.Errorf("test"))
 			},
 			wantErr: true,
@@ -139,9 +140,9 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, _ *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, _ *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, _ *storagemock.MockResourceReader) {
 				mru.EXPECT().Validate(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil)
-				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil, storage.ErrNotFound)
+				ar.EXPECT().Consume(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
 			want: &flowv1.AuthorizeResponse{
@@ -160,53 +161,9 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, _ *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, _ *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, _ *storagemock.MockResourceReader) {
 				mru.EXPECT().Validate(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil)
-				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil, fmt.Errorf("foo"))
-			},
-			wantErr: true,
-			want: &flowv1.AuthorizeResponse{
-				Error: rfcerrors.ServerError().Build(),
-			},
-		},
-		{
-			name: "with request_uri exist with not found error during deletion",
-			args: args{
-				ctx: context.Background(),
-				req: &flowv1.AuthorizeRequest{
-					Issuer:  "https://honest.as.example",
-					Subject: "foo",
-					Request: &flowv1.AuthorizationRequest{
-						RequestUri: types.StringRef("urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"),
-					},
-				},
-			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, _ *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
-				mru.EXPECT().Validate(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil)
-				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(&flowv1.AuthorizationRequest{}, nil)
-				ar.EXPECT().Delete(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(storage.ErrNotFound)
-			},
-			wantErr: true,
-			want: &flowv1.AuthorizeResponse{
-				Error: rfcerrors.InvalidRequest().Build(),
-			},
-		},
-		{
-			name: "with request_uri exist but deletion error",
-			args: args{
-				ctx: context.Background(),
-				req: &flowv1.AuthorizeRequest{
-					Issuer:  "https://honest.as.example",
-					Subject: "foo",
-					Request: &flowv1.AuthorizationRequest{
-						RequestUri: types.StringRef("urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"),
-					},
-				},
-			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, _ *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
-				mru.EXPECT().Validate(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil)
-				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(&flowv1.AuthorizationRequest{}, nil)
-				ar.EXPECT().Delete(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(fmt.Errorf("foo"))
+				ar.EXPECT().Consume(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
 			want: &flowv1.AuthorizeResponse{
@@ -233,7 +190,8 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -266,7 +224,8 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -301,7 +260,8 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -357,7 +317,8 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -404,9 +365,9 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, _ *storagemock.MockResourceReader) {
 				mru.EXPECT().Validate(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil)
-				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(&flowv1.AuthorizationRequest{
+				ar.EXPECT().Consume(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(&flowv1.AuthorizationRequest{
 					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 					ResponseType:        "code",
 					Scope:               "openid profile email offline_access",
@@ -417,7 +378,6 @@ func Test_service_Authorize(t *testing.T) {
 					CodeChallengeMethod: "S256",
 					Prompt:              types.StringRef(oidc.PromptConsent),
 				}, nil)
-				ar.EXPECT().Delete(gomock.Any(), "https://honest.as.example", "urn:solid:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA").Return(nil)
 			},
 			wantErr: true,
 			want: &flowv1.AuthorizeResponse{
@@ -437,9 +397,10 @@ func Test_service_Authorize(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter, codes *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				mru.EXPECT().Validate(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(nil)
-				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(&flowv1.AuthorizationRequest{
+				ar.EXPECT().Consume(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(&flowv1.AuthorizationRequest{
 					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 					ResponseType:        "code",
 					Scope:               "openid profile email offline_access",
@@ -451,7 +412,6 @@ func Test_service_Authorize(t *testing.T) {
 					CodeChallengeMethod: "S256",
 					Prompt:              types.StringRef(oidc.PromptConsent),
 				}, nil)
-				ar.EXPECT().Delete(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes: []string{"code"},
@@ -498,14 +458,15 @@ func Test_service_Authorize(t *testing.T) {
 			authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSessionWriter(ctrl)
 			codeGenerator := generatormock.NewMockAuthorizationCode(ctrl)
 			requestURIGenerator := generatormock.NewMockRequestURI(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(authorizationRequests, clients, authorizationCodeSessions, codeGenerator, requestURIGenerator)
+				tt.prepare(authorizationRequests, clients, authorizationCodeSessions, codeGenerator, requestURIGenerator, resources)
 			}
 
 			// Prepare service
-			underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestURIGenerator)
+			underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestURIGenerator, resources)
 
 			// Do the request
 			got, err := underTest.Authorize(tt.args.ctx, tt.args.req)
@@ -528,19 +489,19 @@ func Test_service_Authorize_Fuzz(t *testing.T) {
 	authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSessionWriter(ctrl)
 	codeGenerator := generatormock.NewMockAuthorizationCode(ctrl)
 	requestURIGenerator := generatormock.NewMockRequestURI(ctrl)
+	resources := storagemock.NewMockResourceReader(ctrl)
 
 	requestURIGenerator.EXPECT().Validate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	authorizationRequests.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx context.Context, isser, requestURI string) (*flowv1.AuthorizationRequest, error) {
+	authorizationRequests.EXPECT().Consume(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx context.Context, isser, requestURI string) (*flowv1.AuthorizationRequest, error) {
 		f := fuzz.New()
 		var ar flowv1.AuthorizationRequest
 		f.Fuzz(&ar)
 
 		return &ar, nil
 	}).AnyTimes()
-	authorizationRequests.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Prepare service
-	underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestURIGenerator)
+	underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestURIGenerator, resources)
 
 	// Making sure the function never panics
 	for i := 0; i < 1000; i++ {
@@ -563,7 +524,7 @@ func Test_service_Register(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockAuthorizationRequest, *storagemock.MockClientReader, *storagemock.MockAuthorizationCodeSessionWriter, *generatormock.MockAuthorizationCode, *generatormock.MockRequestURI)
+		prepare func(*storagemock.MockAuthorizationRequest, *storagemock.MockClientReader, *storagemock.MockAuthorizationCodeSessionWriter, *generatormock.MockAuthorizationCode, *generatormock.MockRequestURI, *storagemock.MockResourceReader)
 		want    *flowv1.RegistrationResponse
 		wantErr bool
 	}{
@@ -685,7 +646,7 @@ func Test_service_Register(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(_ *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI) {
+			prepare: func(_ *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{"client_credentials"},
@@ -719,7 +680,8 @@ func Test_service_Register(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, _ *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:      "s6BhdRkqt3",
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
@@ -755,7 +717,8 @@ func Test_service_Register(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:      "s6BhdRkqt3",
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
@@ -792,7 +755,8 @@ func Test_service_Register(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:      "s6BhdRkqt3",
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
@@ -841,7 +805,8 @@ func Test_service_Register(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI) {
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter, _ *generatormock.MockAuthorizationCode, mru *generatormock.MockRequestURI, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(&resourcev1.Resource{Urn: "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"}, nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:      "s6BhdRkqt3",
 					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
@@ -882,14 +847,15 @@ func Test_service_Register(t *testing.T) {
 			authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSessionWriter(ctrl)
 			codeGenerator := generatormock.NewMockAuthorizationCode(ctrl)
 			requestUriGenerator := generatormock.NewMockRequestURI(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(authorizationRequests, clients, authorizationCodeSessions, codeGenerator, requestUriGenerator)
+				tt.prepare(authorizationRequests, clients, authorizationCodeSessions, codeGenerator, requestUriGenerator, resources)
 			}
 
 			// Prepare service
-			underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestUriGenerator)
+			underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestUriGenerator, resources)
 
 			// Do the request
 			got, err := underTest.Register(tt.args.ctx, tt.args.req)
@@ -912,9 +878,10 @@ func Test_service_Register_Fuzz(t *testing.T) {
 	authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSessionWriter(ctrl)
 	codeGenerator := generatormock.NewMockAuthorizationCode(ctrl)
 	requestURIGenerator := generatormock.NewMockRequestURI(ctrl)
+	resources := storagemock.NewMockResourceReader(ctrl)
 
 	// Prepare service
-	underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestURIGenerator)
+	underTest := New(clients, authorizationRequests, authorizationCodeSessions, codeGenerator, requestURIGenerator, resources)
 
 	// Making sure the function never panics
 	for i := 0; i < 1000; i++ {
@@ -0,0 +1,33 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backchannel
+
+import (
+	"zntr.io/solid/server/storage"
+)
+
+// Option is used to enable optional backchannel authentication service
+// features.
+type Option func(*service)
+
+// WithScopes sets the scope registry used to validate requested scopes.
+func WithScopes(scopes storage.ScopeReader) Option {
+	return func(s *service) {
+		s.scopes = scopes
+	}
+}
@@ -0,0 +1,331 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backchannel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/generator"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
+	"zntr.io/solid/server/scopepolicy"
+	"zntr.io/solid/server/services"
+	"zntr.io/solid/server/storage"
+)
+
+const (
+	// DefaultExpiry defines the authentication request lifetime when the
+	// client doesn't request one.
+	DefaultExpiry = 2 * time.Minute
+	// MaxExpiry defines the maximum authentication request lifetime a client
+	// can request.
+	MaxExpiry = 10 * time.Minute
+	// DefaultInterval defines the minimum polling interval in seconds.
+	DefaultInterval = 5
+	// MaxBindingMessageLen defines the maximum binding message length in
+	// characters, it must remain displayable on an authentication device.
+	MaxBindingMessageLen = 64
+)
+
+type service struct {
+	sessions   storage.BackchannelAuthenticationSession
+	authReqIDs generator.AuthenticationRequestID
+	resources  storage.ResourceReader
+	scopes     storage.ScopeReader
+}
+
+// New build and returns a backchannel authentication service implementation.
+func New(sessions storage.BackchannelAuthenticationSession, authReqIDs generator.AuthenticationRequestID, resources storage.ResourceReader, opts ...Option) services.Backchannel {
+	s := &service{
+		sessions:   sessions,
+		authReqIDs: authReqIDs,
+		resources:  resources,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+var timeFunc = time.Now
+
+// -----------------------------------------------------------------------------
+
+//nolint:gocyclo // to refactor
+func (s *service) Authenticate(ctx context.Context, req *flowv1.BackchannelAuthenticationRequest) (*flowv1.BackchannelAuthenticationResponse, error) {
+	res := &flowv1.BackchannelAuthenticationResponse{}
+
+	// Check req nullity
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Check issuer
+	if req.Issuer == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process empty issuer")
+	}
+
+	// Check client
+	client := req.Client
+	if client == nil {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("unable to process with nil client")
+	}
+
+	// Validate client capabilities
+	if !types.StringArray(client.GrantTypes).Contains(oidc.GrantTypeCIBA) {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeCIBA)
+	}
+
+	// Validate token delivery mode
+	switch client.BackchannelTokenDeliveryMode {
+	case "", oidc.BackchannelTokenDeliveryModePoll:
+	case oidc.BackchannelTokenDeliveryModePing:
+		if client.BackchannelClientNotificationEndpoint == "" {
+			res.Error = rfcerrors.UnauthorizedClient().Build()
+			return res, fmt.Errorf("client '%s' has no notification endpoint registered", client.ClientId)
+		}
+		if req.GetClientNotificationToken() == "" {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("client_notification_token must not be empty for ping mode")
+		}
+	default:
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("unsupported token delivery mode '%s'", client.BackchannelTokenDeliveryMode)
+	}
+
+	// Validate requested scopes
+	scope, err := scopepolicy.Authorize(ctx, s.scopes, client, req.Scope)
+	if err != nil {
+		if !errors.Is(err, scopepolicy.ErrInvalidScope) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidScope().Build()
+		}
+		return res, fmt.Errorf("unable to authorize requested scope: %w", err)
+	}
+	if !types.StringArray(strings.Fields(scope)).Contains(oidc.ScopeOpenID) {
+		res.Error = rfcerrors.InvalidScope().Build()
+		return res, fmt.Errorf("scope must contain '%s'", oidc.ScopeOpenID)
+	}
+	req.Scope = scope
+
+	// Check login hint
+	if req.LoginHint == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("login_hint must not be empty")
+	}
+
+	// Check binding message
+	if req.BindingMessage != nil {
+		if err := validateBindingMessage(*req.BindingMessage); err != nil {
+			res.Error = rfcerrors.InvalidBindingMessage().Build()
+			return res, fmt.Errorf("unable to validate binding_message: %w", err)
+		}
+	}
+
+	// Compute request lifetime
+	expiry := DefaultExpiry
+	if req.RequestedExpiry != nil {
+		if *req.RequestedExpiry == 0 {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("requested_expiry must be a positive integer")
+		}
+		if requested := time.Duration(*req.RequestedExpiry) * time.Second; requested < MaxExpiry {
+			expiry = requested
+		} else {
+			expiry = MaxExpiry
+		}
+	}
+
+	// Resolve targeted resources
+	if _, err := resourceindicator.Resolve(ctx, s.resources, req.GetAudience(), req.Resource); err != nil {
+		if !errors.Is(err, resourceindicator.ErrInvalidTarget) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidTarget().Build()
+		}
+		return res, fmt.Errorf("unable to resolve requested resources: %w", err)
+	}
+
+	// Generate authentication request identifier
+	authReqID, err := s.authReqIDs.Generate(ctx, req.Issuer)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to generate authentication request identifier: %w", err)
+	}
+
+	// Prepare session
+	session := &sessionv1.BackchannelAuthenticationSession{
+		Issuer:    req.Issuer,
+		Client:    client,
+		Request:   req,
+		Status:    sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING,
+		Scope:     types.StringRef(scope),
+		Audience:  req.Audience,
+		ExpiresAt: uint64(timeFunc().Add(expiry).Unix()),
+		Interval:  DefaultInterval,
+	}
+
+	// Store authentication request
+	if err := s.sessions.Register(ctx, req.Issuer, authReqID, session); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to create authentication request: %w", err)
+	}
+
+	// Assign authentication request identifier
+	res.AuthReqId = authReqID
+	// Set expiration
+	res.ExpiresIn = uint64(expiry.Seconds())
+	// Polling interval
+	res.Interval = DefaultInterval
+	// Assign issuer
+	res.Issuer = req.Issuer
+
+	// No error
+	return res, nil
+}
+
+func (s *service) Validate(ctx context.Context, req *flowv1.BackchannelAuthenticationValidationRequest) (*flowv1.BackchannelAuthenticationValidationResponse, error) {
+	res := &flowv1.BackchannelAuthenticationValidationResponse{}
+
+	// Check req nullity
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Check issuer
+	if req.Issuer == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process empty issuer")
+	}
+
+	// Check authentication request identifier
+	if req.AuthReqId == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process blank auth_req_id")
+	}
+
+	// Check subject
+	if req.Subject == "" && !req.Denied {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process blank subject")
+	}
+
+	// Resolve session
+	session, err := s.sessions.Get(ctx, req.Issuer, req.AuthReqId)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidRequest().Build()
+		}
+		return res, fmt.Errorf("unable to retrieve authentication request: %w", err)
+	}
+
+	// Check session
+	if session == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("retrieved nil session for '%s'", req.AuthReqId)
+	}
+	if session.Request == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("session has nil request for '%s'", req.AuthReqId)
+	}
+	if session.Client == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("session has nil client for '%s'", req.AuthReqId)
+	}
+
+	// Check expiration
+	if session.ExpiresAt < uint64(timeFunc().Unix()) {
+		res.Error = rfcerrors.ExpiredToken().Build()
+		return res, fmt.Errorf("auth_req_id '%s' is expired", req.AuthReqId)
+	}
+
+	// Check if it is still pending
+	if session.Status != sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("auth_req_id '%s' is already processed", req.AuthReqId)
+	}
+
+	// Update session
+	if req.Denied {
+		session.Status = sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_DENIED
+	} else {
+		session.Subject = types.StringRef(req.Subject)
+		session.AuthTime = req.AuthTime
+		session.Acr = req.Acr
+		session.Status = sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED
+	}
+
+	// Update ephemeral storage
+	if err := s.sessions.Update(ctx, req.Issuer, req.AuthReqId, session); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("auth_req_id '%s' could not be updated: %w", req.AuthReqId, err)
+	}
+
+	// Prepare client notification
+	if session.Client.BackchannelTokenDeliveryMode == oidc.BackchannelTokenDeliveryModePing {
+		res.Notification = &flowv1.BackchannelNotification{
+			Endpoint:                session.Client.BackchannelClientNotificationEndpoint,
+			ClientNotificationToken: session.Request.GetClientNotificationToken(),
+			AuthReqId:               req.AuthReqId,
+		}
+	}
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func validateBindingMessage(msg string) error {
+	// Check length
+	if msg == "" {
+		return errors.New("binding message must not be empty")
+	}
+	if utf8.RuneCountInString(msg) > MaxBindingMessageLen {
+		return fmt.Errorf("binding message must not exceed %d characters", MaxBindingMessageLen)
+	}
+
+	// Check content
+	for _, r := range msg {
+		if !unicode.IsPrint(r) {
+			return errors.New("binding message must contain only printable characters")
+		}
+	}
+
+	// No error
+	return nil
+}
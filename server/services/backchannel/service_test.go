@@ -0,0 +1,758 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backchannel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	corev1 "zntr.io/solid/api/oidc/core/v1"
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/oidc"
+	generatormock "zntr.io/solid/sdk/generator/mock"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+	storagemock "zntr.io/solid/server/storage/mock"
+)
+
+var cmpOpts = []cmp.Option{cmpopts.IgnoreUnexported(flowv1.BackchannelAuthenticationResponse{}), cmpopts.IgnoreUnexported(flowv1.BackchannelAuthenticationValidationResponse{}), cmpopts.IgnoreUnexported(flowv1.BackchannelNotification{}), cmpopts.IgnoreUnexported(corev1.Error{})}
+
+func Test_service_Authenticate(t *testing.T) {
+	defaultClient := func() *clientv1.Client {
+		return &clientv1.Client{
+			ClientId:   "s6BhdRkqt3",
+			GrantTypes: []string{oidc.GrantTypeCIBA},
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req *flowv1.BackchannelAuthenticationRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockBackchannelAuthenticationSession, *generatormock.MockAuthenticationRequestID, *storagemock.MockResourceReader)
+		want    *flowv1.BackchannelAuthenticationResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "nil client",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "client without ciba grant type",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId:   "s6BhdRkqt3",
+						GrantTypes: []string{oidc.GrantTypeAuthorizationCode},
+					},
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
+		{
+			name: "push delivery mode",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId:                     "s6BhdRkqt3",
+						GrantTypes:                   []string{oidc.GrantTypeCIBA},
+						BackchannelTokenDeliveryMode: oidc.BackchannelTokenDeliveryModePush,
+					},
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
+		{
+			name: "ping delivery mode without endpoint",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId:                     "s6BhdRkqt3",
+						GrantTypes:                   []string{oidc.GrantTypeCIBA},
+						BackchannelTokenDeliveryMode: oidc.BackchannelTokenDeliveryModePing,
+					},
+					ClientNotificationToken: types.StringRef("8d67dc78-7faa-4d41-aabd-67707b374255"),
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
+		{
+			name: "ping delivery mode without notification token",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId:                              "s6BhdRkqt3",
+						GrantTypes:                            []string{oidc.GrantTypeCIBA},
+						BackchannelTokenDeliveryMode:          oidc.BackchannelTokenDeliveryModePing,
+						BackchannelClientNotificationEndpoint: "https://client.example.com/cb",
+					},
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "scope not allowed",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId:   "s6BhdRkqt3",
+						GrantTypes: []string{oidc.GrantTypeCIBA},
+						Scopes:     []string{"openid"},
+					},
+					Scope:     "openid admin",
+					LoginHint: "user@example.com",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "scope without openid",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:    "https://honest.as.example.com",
+					Client:    defaultClient(),
+					Scope:     "admin",
+					LoginHint: "user@example.com",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "missing login hint",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: defaultClient(),
+					Scope:  "openid",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "binding message too long",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:         "https://honest.as.example.com",
+					Client:         defaultClient(),
+					Scope:          "openid",
+					LoginHint:      "user@example.com",
+					BindingMessage: types.StringRef(strings.Repeat("W", MaxBindingMessageLen+1)),
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidBindingMessage().Build(),
+			},
+		},
+		{
+			name: "binding message with control characters",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:         "https://honest.as.example.com",
+					Client:         defaultClient(),
+					Scope:          "openid",
+					LoginHint:      "user@example.com",
+					BindingMessage: types.StringRef("W4SCT\n"),
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidBindingMessage().Build(),
+			},
+		},
+		{
+			name: "zero requested expiry",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:          "https://honest.as.example.com",
+					Client:          defaultClient(),
+					Scope:           "openid",
+					LoginHint:       "user@example.com",
+					RequestedExpiry: types.UInt64Ref(0),
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid resource",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:    "https://honest.as.example.com",
+					Client:    defaultClient(),
+					Scope:     "openid",
+					LoginHint: "user@example.com",
+					Resource:  []string{"urn:example:unknown"},
+				},
+			},
+			prepare: func(_ *storagemock.MockBackchannelAuthenticationSession, _ *generatormock.MockAuthenticationRequestID, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:unknown").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		{
+			name: "generator error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:    "https://honest.as.example.com",
+					Client:    defaultClient(),
+					Scope:     "openid",
+					LoginHint: "user@example.com",
+				},
+			},
+			prepare: func(_ *storagemock.MockBackchannelAuthenticationSession, ids *generatormock.MockAuthenticationRequestID, _ *storagemock.MockResourceReader) {
+				ids.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "session storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:    "https://honest.as.example.com",
+					Client:    defaultClient(),
+					Scope:     "openid",
+					LoginHint: "user@example.com",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, ids *generatormock.MockAuthenticationRequestID, _ *storagemock.MockResourceReader) {
+				ids.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("1c266114-a1be-4252-8ad1-04986c5b9ac1", nil)
+				sessions.EXPECT().Register(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:    "https://honest.as.example.com",
+					Client:    defaultClient(),
+					Scope:     "openid admin",
+					LoginHint: "user@example.com",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, ids *generatormock.MockAuthenticationRequestID, _ *storagemock.MockResourceReader) {
+				ids.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("1c266114-a1be-4252-8ad1-04986c5b9ac1", nil)
+				sessions.EXPECT().Register(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.BackchannelAuthenticationSession) error {
+					if s.Status != sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING {
+						return fmt.Errorf("unexpected session status %v", s.Status)
+					}
+					if s.ExpiresAt != uint64(time.Unix(1700000000, 0).Add(DefaultExpiry).Unix()) {
+						return fmt.Errorf("unexpected session expiration %d", s.ExpiresAt)
+					}
+					return nil
+				})
+			},
+			wantErr: false,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Issuer:    "https://honest.as.example.com",
+				AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+				ExpiresIn: 120,
+				Interval:  5,
+			},
+		},
+		{
+			name: "valid with requested expiry",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:          "https://honest.as.example.com",
+					Client:          defaultClient(),
+					Scope:           "openid",
+					LoginHint:       "user@example.com",
+					BindingMessage:  types.StringRef("W4SCT"),
+					RequestedExpiry: types.UInt64Ref(60),
+					Audience:        types.StringRef("urn:example:cooperation-context"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, ids *generatormock.MockAuthenticationRequestID, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:cooperation-context").Return(&resourcev1.Resource{Urn: "urn:example:cooperation-context"}, nil)
+				ids.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("1c266114-a1be-4252-8ad1-04986c5b9ac1", nil)
+				sessions.EXPECT().Register(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Issuer:    "https://honest.as.example.com",
+				AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+				ExpiresIn: 60,
+				Interval:  5,
+			},
+		},
+		{
+			name: "valid with clamped requested expiry",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer:          "https://honest.as.example.com",
+					Client:          defaultClient(),
+					Scope:           "openid",
+					LoginHint:       "user@example.com",
+					RequestedExpiry: types.UInt64Ref(86400),
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, ids *generatormock.MockAuthenticationRequestID, _ *storagemock.MockResourceReader) {
+				ids.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("1c266114-a1be-4252-8ad1-04986c5b9ac1", nil)
+				sessions.EXPECT().Register(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Issuer:    "https://honest.as.example.com",
+				AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+				ExpiresIn: 600,
+				Interval:  5,
+			},
+		},
+		{
+			name: "valid ping mode",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId:                              "s6BhdRkqt3",
+						GrantTypes:                            []string{oidc.GrantTypeCIBA},
+						BackchannelTokenDeliveryMode:          oidc.BackchannelTokenDeliveryModePing,
+						BackchannelClientNotificationEndpoint: "https://client.example.com/cb",
+					},
+					ClientNotificationToken: types.StringRef("8d67dc78-7faa-4d41-aabd-67707b374255"),
+					Scope:                   "openid",
+					LoginHint:               "user@example.com",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, ids *generatormock.MockAuthenticationRequestID, _ *storagemock.MockResourceReader) {
+				ids.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("1c266114-a1be-4252-8ad1-04986c5b9ac1", nil)
+				sessions.EXPECT().Register(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.BackchannelAuthenticationResponse{
+				Issuer:    "https://honest.as.example.com",
+				AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+				ExpiresIn: 120,
+				Interval:  5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Freeze time
+			timeFunc = func() time.Time { return time.Unix(1700000000, 0) }
+			defer func() { timeFunc = time.Now }()
+
+			// Arm mocks
+			sessions := storagemock.NewMockBackchannelAuthenticationSession(ctrl)
+			authReqIDs := generatormock.NewMockAuthenticationRequestID(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(sessions, authReqIDs, resources)
+			}
+
+			// Prepare service
+			underTest := New(sessions, authReqIDs, resources)
+
+			// Do the request
+			got, err := underTest.Authenticate(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.Authenticate() res =%s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_Validate(t *testing.T) {
+	pendingSession := func(client *clientv1.Client) *sessionv1.BackchannelAuthenticationSession {
+		return &sessionv1.BackchannelAuthenticationSession{
+			Issuer: "https://honest.as.example.com",
+			Client: client,
+			Request: &flowv1.BackchannelAuthenticationRequest{
+				ClientNotificationToken: types.StringRef("8d67dc78-7faa-4d41-aabd-67707b374255"),
+			},
+			Status:    sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING,
+			ExpiresAt: 1700000120,
+			Interval:  5,
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req *flowv1.BackchannelAuthenticationValidationRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockBackchannelAuthenticationSession)
+		want    *flowv1.BackchannelAuthenticationValidationResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty auth_req_id",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer: "https://honest.as.example.com",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty subject",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "session not found",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Subject:   "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "session storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Subject:   "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "expired session",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Subject:   "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				s := pendingSession(&clientv1.Client{ClientId: "s6BhdRkqt3"})
+				s.ExpiresAt = 1699999999
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.ExpiredToken().Build(),
+			},
+		},
+		{
+			name: "already authorized",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Subject:   "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				s := pendingSession(&clientv1.Client{ClientId: "s6BhdRkqt3"})
+				s.Status = sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "update error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Subject:   "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(pendingSession(&clientv1.Client{ClientId: "s6BhdRkqt3"}), nil)
+				sessions.EXPECT().Update(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid poll mode",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Subject:   "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(pendingSession(&clientv1.Client{ClientId: "s6BhdRkqt3"}), nil)
+				sessions.EXPECT().Update(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.BackchannelAuthenticationSession) error {
+					if s.Status != sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED {
+						return fmt.Errorf("unexpected session status %v", s.Status)
+					}
+					if s.GetSubject() != "user1" {
+						return fmt.Errorf("unexpected session subject %q", s.GetSubject())
+					}
+					return nil
+				})
+			},
+			wantErr: false,
+			want:    &flowv1.BackchannelAuthenticationValidationResponse{},
+		},
+		{
+			name: "valid denied",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Denied:    true,
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(pendingSession(&clientv1.Client{ClientId: "s6BhdRkqt3"}), nil)
+				sessions.EXPECT().Update(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.BackchannelAuthenticationSession) error {
+					if s.Status != sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_DENIED {
+						return fmt.Errorf("unexpected session status %v", s.Status)
+					}
+					return nil
+				})
+			},
+			wantErr: false,
+			want:    &flowv1.BackchannelAuthenticationValidationResponse{},
+		},
+		{
+			name: "valid ping mode",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.BackchannelAuthenticationValidationRequest{
+					Issuer:    "https://honest.as.example.com",
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+					Subject:   "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession) {
+				sessions.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(pendingSession(&clientv1.Client{
+					ClientId:                              "s6BhdRkqt3",
+					BackchannelTokenDeliveryMode:          oidc.BackchannelTokenDeliveryModePing,
+					BackchannelClientNotificationEndpoint: "https://client.example.com/cb",
+				}), nil)
+				sessions.EXPECT().Update(gomock.Any(), "https://honest.as.example.com", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.BackchannelAuthenticationValidationResponse{
+				Notification: &flowv1.BackchannelNotification{
+					Endpoint:                "https://client.example.com/cb",
+					ClientNotificationToken: "8d67dc78-7faa-4d41-aabd-67707b374255",
+					AuthReqId:               "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Freeze time
+			timeFunc = func() time.Time { return time.Unix(1700000000, 0) }
+			defer func() { timeFunc = time.Now }()
+
+			// Arm mocks
+			sessions := storagemock.NewMockBackchannelAuthenticationSession(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(sessions)
+			}
+
+			// Prepare service
+			underTest := New(sessions, nil, nil)
+
+			// Do the request
+			got, err := underTest.Validate(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.Validate() res =%s", diff)
+			}
+		})
+	}
+}
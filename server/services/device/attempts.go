@@ -0,0 +1,162 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// UserCodeAttemptWindow defines the user code attempt counting window.
+	UserCodeAttemptWindow = 15 * time.Minute
+	// MaxSubjectUserCodeFailures defines the failed user code attempts allowed
+	// to a subject within the window before lockout. Failures are only
+	// forgiven once the subject recorded a decision.
+	MaxSubjectUserCodeFailures = 10
+	// MaxIssuerUserCodeFailures defines the failed user code attempts allowed
+	// for all subjects of an issuer within the window before throttling.
+	MaxIssuerUserCodeFailures = 1000
+	// IssuerUserCodeBackoff defines the delay in seconds enforced after the
+	// last failure of a throttled issuer.
+	IssuerUserCodeBackoff = 1
+	// MaxUserCodeBackoff defines the maximum delay in seconds enforced between
+	// two attempts of a subject.
+	MaxUserCodeBackoff = 60
+
+	issuerAttemptKey = "issuer"
+)
+
+var (
+	errUserCodeLocked  = errors.New("user code validation is locked")
+	errUserCodeBackoff = errors.New("user code validation retried too early")
+)
+
+// checkAttempts ensures that the subject is not locked, and that both the
+// subject and a throttled issuer waited their backoff delay since their last
+// failure.
+func (s *service) checkAttempts(ctx context.Context, issuer, subject string) error {
+	now := uint64(timeFunc().Unix())
+
+	// Check issuer counter, a global lockout would let anyone deny the
+	// service to all subjects.
+	failures, lastFailedAt, err := s.userCodeAttempts.Get(ctx, issuer, issuerAttemptKey)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve issuer attempts: %w", err)
+	}
+	if failures >= MaxIssuerUserCodeFailures && now < lastFailedAt+IssuerUserCodeBackoff {
+		return errUserCodeBackoff
+	}
+
+	// Check subject counter
+	failures, lastFailedAt, err = s.userCodeAttempts.Get(ctx, issuer, subjectAttemptKey(subject))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve subject attempts: %w", err)
+	}
+	if failures >= MaxSubjectUserCodeFailures {
+		return errUserCodeLocked
+	}
+	if now < lastFailedAt+userCodeBackoff(failures) {
+		return errUserCodeBackoff
+	}
+
+	// No error
+	return nil
+}
+
+// reserveAttempt counts an in-flight user code attempt of the subject before
+// resolving the code. The limit is enforced on the failures and the attempts
+// in flight so that concurrent attempts passing checkAttempts can't exceed it.
+// The reservation must be released once the code is resolved.
+func (s *service) reserveAttempt(ctx context.Context, issuer, subject string) error {
+	expiresAt := uint64(timeFunc().Add(UserCodeAttemptWindow).Unix())
+
+	// Increment in-flight counter
+	inflight, err := s.userCodeAttempts.Fail(ctx, issuer, reservedAttemptKey(subject), expiresAt)
+	if err != nil {
+		return fmt.Errorf("unable to reserve subject attempt: %w", err)
+	}
+
+	// Retrieve failures after the reservation, a failed attempt is recorded
+	// before its reservation is released.
+	failures, _, err := s.userCodeAttempts.Get(ctx, issuer, subjectAttemptKey(subject))
+	if err != nil {
+		//nolint:errcheck // the reservation expires with the attempt window
+		s.releaseAttempt(ctx, issuer, subject)
+		return fmt.Errorf("unable to retrieve subject attempts: %w", err)
+	}
+	if failures+inflight > MaxSubjectUserCodeFailures {
+		//nolint:errcheck // the reservation expires with the attempt window
+		s.releaseAttempt(ctx, issuer, subject)
+		return errUserCodeLocked
+	}
+
+	// No error
+	return nil
+}
+
+// releaseAttempt releases the in-flight attempt reservation of the subject.
+func (s *service) releaseAttempt(ctx context.Context, issuer, subject string) error {
+	if err := s.userCodeAttempts.Release(ctx, issuer, reservedAttemptKey(subject)); err != nil {
+		return fmt.Errorf("unable to release subject attempt: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+// failAttempt records a failed user code attempt for the subject and the
+// issuer.
+func (s *service) failAttempt(ctx context.Context, issuer, subject string) error {
+	expiresAt := uint64(timeFunc().Add(UserCodeAttemptWindow).Unix())
+
+	// Increment counters
+	if _, err := s.userCodeAttempts.Fail(ctx, issuer, subjectAttemptKey(subject), expiresAt); err != nil {
+		return fmt.Errorf("unable to record subject attempt: %w", err)
+	}
+	if _, err := s.userCodeAttempts.Fail(ctx, issuer, issuerAttemptKey, expiresAt); err != nil {
+		return fmt.Errorf("unable to record issuer attempt: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+
+func subjectAttemptKey(subject string) string {
+	return fmt.Sprintf("subject:%s", subject)
+}
+
+func reservedAttemptKey(subject string) string {
+	return fmt.Sprintf("reserved:%s", subject)
+}
+
+// userCodeBackoff returns the delay in seconds doubled on each failure.
+func userCodeBackoff(failures uint64) uint64 {
+	switch {
+	case failures == 0:
+		return 0
+	case failures > 6:
+		return MaxUserCodeBackoff
+	default:
+		return 1 << (failures - 1)
+	}
+}
@@ -0,0 +1,42 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package device
+
+import "testing"
+
+func Test_userCodeBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures uint64
+		want     uint64
+	}{
+		{name: "no failure", failures: 0, want: 0},
+		{name: "first failure", failures: 1, want: 1},
+		{name: "second failure", failures: 2, want: 2},
+		{name: "sixth failure", failures: 6, want: 32},
+		{name: "capped", failures: 7, want: MaxUserCodeBackoff},
+		{name: "capped without overflow", failures: 1 << 20, want: MaxUserCodeBackoff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userCodeBackoff(tt.failures); got != tt.want {
+				t.Errorf("userCodeBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
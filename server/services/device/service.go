@@ -19,15 +19,21 @@ package device
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
+	"github.com/dchest/uniuri"
+
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
 	sessionv1 "zntr.io/solid/api/oidc/session/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/generator"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
+	"zntr.io/solid/server/scopepolicy"
 	"zntr.io/solid/server/services"
 	"zntr.io/solid/server/storage"
 )
@@ -37,18 +43,41 @@ type service struct {
 	deviceCodeSessions storage.DeviceCodeSession
 	deviceCodes        generator.DeviceCode
 	userCodes          generator.DeviceUserCode
+	userCodeAttempts   storage.DeviceUserCodeAttempt
+	resources          storage.ResourceReader
+	scopes             storage.ScopeReader
+	verificationURI    string
 }
 
 // New build and returns an authorization service implementation.
-func New(clients storage.ClientReader, deviceCodeSessions storage.DeviceCodeSession, deviceCodes generator.DeviceCode, userCodes generator.DeviceUserCode) services.Device {
-	return &service{
+//
+// verificationURI is the end-user verification endpoint returned to the
+// device, the user code is embedded in its query to build the complete
+// verification uri.
+func New(clients storage.ClientReader, deviceCodeSessions storage.DeviceCodeSession, deviceCodes generator.DeviceCode, userCodes generator.DeviceUserCode, userCodeAttempts storage.DeviceUserCodeAttempt, resources storage.ResourceReader, verificationURI string, opts ...Option) services.Device {
+	s := &service{
 		clients:            clients,
 		deviceCodeSessions: deviceCodeSessions,
 		deviceCodes:        deviceCodes,
 		userCodes:          userCodes,
+		userCodeAttempts:   userCodeAttempts,
+		resources:          resources,
+		verificationURI:    verificationURI,
+	}
+	for _, o := range opts {
+		o(s)
 	}
+
+	return s
 }
 
+const (
+	// DefaultInterval defines the minimum polling interval in seconds.
+	DefaultInterval = 5
+
+	confirmationCodeLen = 16
+)
+
 var timeFunc = time.Now
 
 // -----------------------------------------------------------------------------
@@ -91,6 +120,41 @@ func (s *service) Authorize(ctx context.Context, req *flowv1.DeviceAuthorization
 		return res, fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeDeviceCode)
 	}
 
+	// Resolve targeted resources
+	audience := ""
+	if req.Audience != nil {
+		audience = *req.Audience
+	}
+	if _, err := resourceindicator.Resolve(ctx, s.resources, audience, req.Resource); err != nil {
+		if !errors.Is(err, resourceindicator.ErrInvalidTarget) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidTarget().Build()
+		}
+		return res, fmt.Errorf("unable to resolve requested resources: %w", err)
+	}
+
+	// Validate requested scopes
+	if req.Scope != nil {
+		scope, err := scopepolicy.Authorize(ctx, s.scopes, client, *req.Scope)
+		if err != nil {
+			if !errors.Is(err, scopepolicy.ErrInvalidScope) {
+				res.Error = rfcerrors.ServerError().Build()
+			} else {
+				res.Error = rfcerrors.InvalidScope().Build()
+			}
+			return res, fmt.Errorf("unable to authorize requested scope: %w", err)
+		}
+		req.Scope = types.StringRef(scope)
+	}
+
+	// Check verification endpoint
+	verificationURI, err := url.Parse(s.verificationURI)
+	if err != nil || !verificationURI.IsAbs() {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("verification uri '%s' must be a valid absolute uri", s.verificationURI)
+	}
+
 	// Generate device code
 	deviceCode, err := s.deviceCodes.Generate(ctx, req.Issuer)
 	if err != nil {
@@ -98,7 +162,7 @@ func (s *service) Authorize(ctx context.Context, req *flowv1.DeviceAuthorization
 		return res, fmt.Errorf("unable to generate device code: %w", err)
 	}
 
-	// Generate device code
+	// Generate user code
 	userCode, err := s.userCodes.Generate(ctx, req.Issuer)
 	if err != nil {
 		res.Error = rfcerrors.ServerError().Build()
@@ -113,6 +177,7 @@ func (s *service) Authorize(ctx context.Context, req *flowv1.DeviceAuthorization
 		Scope:      req.Scope,
 		Audience:   req.Audience,
 		DeviceCode: deviceCode,
+		Interval:   DefaultInterval,
 	}
 
 	// Store device code request
@@ -126,10 +191,13 @@ func (s *service) Authorize(ctx context.Context, req *flowv1.DeviceAuthorization
 	res.DeviceCode = deviceCode
 	// Assign user code
 	res.UserCode = userCode
+	// Assign verification uris
+	res.VerificationUri = s.verificationURI
+	res.VerificationUriComplete = types.StringRef(verificationURIComplete(verificationURI, userCode))
 	// Set expiration
 	res.ExpiresIn = expiresIn
 	// Polling interval
-	res.Interval = 5
+	res.Interval = session.Interval
 	// Assign issuer
 	res.Issuer = req.Issuer
 
@@ -164,14 +232,66 @@ func (s *service) Validate(ctx context.Context, req *flowv1.DeviceCodeValidation
 		return res, fmt.Errorf("unable to process blank subject")
 	}
 
+	// Check brute-force protection
+	if types.IsNil(s.userCodes) || types.IsNil(s.userCodeAttempts) {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("user code brute-force protection is not configured")
+	}
+	if err := s.checkAttempts(ctx, req.Issuer, req.Subject); err != nil {
+		switch {
+		case errors.Is(err, errUserCodeLocked):
+			res.Error = rfcerrors.AccessDenied().Build()
+		case errors.Is(err, errUserCodeBackoff):
+			res.Error = rfcerrors.Slowdown().Build()
+		default:
+			res.Error = rfcerrors.ServerError().Build()
+		}
+		return res, fmt.Errorf("user code validation is throttled for '%s': %w", req.Subject, err)
+	}
+
+	// Normalize user code, malformed inputs are typos, not guesses
+	userCode, err := s.userCodes.Normalize(ctx, req.Issuer, req.UserCode)
+	if err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to normalize user_code: %w", err)
+	}
+	req.UserCode = userCode
+
+	// Reserve the attempt while resolving the user code
+	if err := s.reserveAttempt(ctx, req.Issuer, req.Subject); err != nil {
+		if errors.Is(err, errUserCodeLocked) {
+			res.Error = rfcerrors.AccessDenied().Build()
+		} else {
+			res.Error = rfcerrors.ServerError().Build()
+		}
+		return res, fmt.Errorf("user code validation is throttled for '%s': %w", req.Subject, err)
+	}
+
 	// Resolve device code
 	session, err := s.deviceCodeSessions.GetByUserCode(ctx, req.Issuer, req.UserCode)
+
+	// Record the failed attempt before releasing the reservation
+	if errors.Is(err, storage.ErrNotFound) {
+		if errAttempt := s.failAttempt(ctx, req.Issuer, req.Subject); errAttempt != nil {
+			//nolint:errcheck // the reservation expires with the attempt window
+			s.releaseAttempt(ctx, req.Issuer, req.Subject)
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to record failed user code attempt: %w", errAttempt)
+		}
+	}
+	if errRelease := s.releaseAttempt(ctx, req.Issuer, req.Subject); errRelease != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to release user code attempt: %w", errRelease)
+	}
+
+	// Check resolution
 	if err != nil {
 		if err != storage.ErrNotFound {
 			res.Error = rfcerrors.ServerError().Build()
-		} else {
-			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("session is invalid")
 		}
+
+		res.Error = rfcerrors.InvalidRequest().Build()
 		return res, fmt.Errorf("session is invalid")
 	}
 
@@ -195,15 +315,86 @@ func (s *service) Validate(ctx context.Context, req *flowv1.DeviceCodeValidation
 		return res, fmt.Errorf("user_code '%s' is expired", req.UserCode)
 	}
 
-	// Check if it validated
-	if session.Status != sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING {
+	// Display the request details before any decision
+	if req.ConfirmationCode == nil {
+		return s.confirm(ctx, req, session)
+	}
+
+	// Record the end-user decision
+	return s.decide(ctx, req, session)
+}
+
+// -----------------------------------------------------------------------------
+
+// confirm binds the session to the end-user and issues the confirmation code
+// required to record the decision.
+func (s *service) confirm(ctx context.Context, req *flowv1.DeviceCodeValidationRequest, session *sessionv1.DeviceCodeSession) (*flowv1.DeviceCodeValidationResponse, error) {
+	res := &flowv1.DeviceCodeValidationResponse{}
+
+	switch session.Status {
+	case sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING:
+		// Generate confirmation code
+		session.ConfirmationCode = uniuri.NewLen(confirmationCodeLen)
+		session.Subject = types.StringRef(req.Subject)
+		session.Status = sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING
+
+		// Update ephemeral storage
+		if err := s.deviceCodeSessions.Update(ctx, req.Issuer, session.DeviceCode, session); err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("user_code '%s' could not be updated: %w", req.UserCode, err)
+		}
+	case sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING:
+		// Only the bound end-user can display the confirmation again
+		if session.GetSubject() != req.Subject {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("user_code '%s' is bound to another subject", req.UserCode)
+		}
+	default:
 		res.Error = rfcerrors.InvalidRequest().Build()
-		return res, fmt.Errorf("user_code '%s' is already authorized", req.UserCode)
+		return res, fmt.Errorf("user_code '%s' is already processed", req.UserCode)
+	}
+
+	// Describe the request to the end-user
+	res.ConfirmationCode = types.StringRef(session.ConfirmationCode)
+	res.ClientId = session.Client.ClientId
+	res.ClientName = session.Client.ClientName
+	res.Scope = session.Scope
+	res.Audience = session.Audience
+	res.Resource = session.Request.Resource
+
+	// No error
+	return res, nil
+}
+
+// decide records the end-user decision.
+func (s *service) decide(ctx context.Context, req *flowv1.DeviceCodeValidationRequest, session *sessionv1.DeviceCodeSession) (*flowv1.DeviceCodeValidationResponse, error) {
+	res := &flowv1.DeviceCodeValidationResponse{}
+
+	// Check if it is waiting for confirmation
+	if session.Status != sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("user_code '%s' is not waiting for confirmation", req.UserCode)
+	}
+
+	// Check confirmation binding
+	if session.GetSubject() != req.Subject {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("user_code '%s' is bound to another subject", req.UserCode)
+	}
+	if session.ConfirmationCode == "" || !types.SecureCompareString(req.GetConfirmationCode(), session.ConfirmationCode) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("invalid confirmation code for user_code '%s'", req.UserCode)
 	}
 
 	// Update session
-	session.Subject = types.StringRef(req.Subject)
-	session.Status = sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED
+	session.ConfirmationCode = ""
+	if req.Denied {
+		session.Status = sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_DENIED
+	} else {
+		session.AuthTime = req.AuthTime
+		session.Acr = req.Acr
+		session.Status = sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED
+	}
 
 	// Update ephemeral storage
 	if err := s.deviceCodeSessions.Validate(ctx, req.Issuer, req.UserCode, session); err != nil {
@@ -217,6 +408,24 @@ func (s *service) Validate(ctx context.Context, req *flowv1.DeviceCodeValidation
 		return res, fmt.Errorf("user authorization '%s' could not be deleted: %v", req.UserCode, err)
 	}
 
+	// Reset subject attempts once the decision is recorded
+	if err := s.userCodeAttempts.Reset(ctx, req.Issuer, subjectAttemptKey(req.Subject)); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to reset user code attempts: %w", err)
+	}
+
 	// No error
 	return res, nil
 }
+
+// -----------------------------------------------------------------------------
+
+// verificationURIComplete embeds the user code in the verification uri.
+func verificationURIComplete(verificationURI *url.URL, userCode string) string {
+	u := *verificationURI
+	q := u.Query()
+	q.Set("user_code", userCode)
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
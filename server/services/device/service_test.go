@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -30,15 +31,19 @@ import (
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	corev1 "zntr.io/solid/api/oidc/core/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
 	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/generator"
 	generatormock "zntr.io/solid/sdk/generator/mock"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/storage"
+	"zntr.io/solid/server/storage/inmemory"
 	storagemock "zntr.io/solid/server/storage/mock"
 )
 
-var cmpOpts = []cmp.Option{cmpopts.IgnoreUnexported(wrappers.StringValue{}), cmpopts.IgnoreUnexported(flowv1.DeviceAuthorizationRequest{}), cmpopts.IgnoreUnexported(flowv1.DeviceAuthorizationResponse{}), cmpopts.IgnoreUnexported(corev1.Error{})}
+var cmpOpts = []cmp.Option{cmpopts.IgnoreUnexported(wrappers.StringValue{}), cmpopts.IgnoreUnexported(flowv1.DeviceAuthorizationRequest{}), cmpopts.IgnoreUnexported(flowv1.DeviceAuthorizationResponse{}), cmpopts.IgnoreUnexported(flowv1.DeviceCodeValidationResponse{}), cmpopts.IgnoreUnexported(corev1.Error{})}
 
 func Test_service_Device(t *testing.T) {
 	type args struct {
@@ -48,9 +53,11 @@ func Test_service_Device(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockClientReader, *storagemock.MockDeviceCodeSession, *generatormock.MockDeviceCode, *generatormock.MockDeviceUserCode)
+		prepare func(*storagemock.MockClientReader, *storagemock.MockDeviceCodeSession, *generatormock.MockDeviceCode, *generatormock.MockDeviceUserCode, *storagemock.MockResourceReader)
 		want    *flowv1.DeviceAuthorizationResponse
 		wantErr bool
+		// Overrides the default verification uri
+		verificationURI string
 	}{
 		{
 			name: "nil request",
@@ -110,7 +117,7 @@ func Test_service_Device(t *testing.T) {
 					ClientId: "s6BhdRkqt3",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
@@ -127,7 +134,7 @@ func Test_service_Device(t *testing.T) {
 					ClientId: "s6BhdRkqt3",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -144,7 +151,7 @@ func Test_service_Device(t *testing.T) {
 					ClientId: "s6BhdRkqt3",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, nil)
 			},
 			wantErr: true,
@@ -161,7 +168,7 @@ func Test_service_Device(t *testing.T) {
 					ClientId: "s6BhdRkqt3",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{oidc.GrantTypeAuthorizationCode},
@@ -172,6 +179,114 @@ func Test_service_Device(t *testing.T) {
 				Error: rfcerrors.UnsupportedGrantType().Build(),
 			},
 		},
+		{
+			name: "unknown audience",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceAuthorizationRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+					Audience: types.StringRef("urn:example:unknown"),
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:unknown").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceAuthorizationResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		{
+			name: "invalid resource",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceAuthorizationRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+					Resource: []string{"backend-api"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceAuthorizationResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		{
+			name: "resource registry error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceAuthorizationRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+					Resource: []string{"urn:example:backend-api"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceAuthorizationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "scope not allowed for client",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceAuthorizationRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+					Scope:    types.StringRef("openid admin"),
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					Scopes:     []string{"openid"},
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceAuthorizationResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "invalid verification uri",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceAuthorizationRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+				},
+			},
+			verificationURI: "/device",
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceCode, _ *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceAuthorizationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
 		{
 			name: "device code session registration error",
 			args: args{
@@ -181,7 +296,7 @@ func Test_service_Device(t *testing.T) {
 					ClientId: "s6BhdRkqt3",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession, mdc *generatormock.MockDeviceCode, mduc *generatormock.MockDeviceUserCode) {
+			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession, mdc *generatormock.MockDeviceCode, mduc *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{oidc.GrantTypeDeviceCode},
@@ -196,6 +311,40 @@ func Test_service_Device(t *testing.T) {
 			},
 		},
 		// ---------------------------------------------------------------------
+		{
+			name: "valid - resources",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceAuthorizationRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+					Scope:    types.StringRef("openid admin"),
+					Audience: types.StringRef("urn:example:cooperation-context"),
+					Resource: []string{"urn:example:backend-api"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession, mdc *generatormock.MockDeviceCode, mduc *generatormock.MockDeviceUserCode, resources *storagemock.MockResourceReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:cooperation-context").Return(&resourcev1.Resource{Urn: "urn:example:cooperation-context"}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{Urn: "urn:example:backend-api"}, nil)
+				mdc.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", nil)
+				mduc.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com").Return("WDJB-MJHT", nil)
+				deviceCodes.EXPECT().Register(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT", gomock.Any()).Return(uint64(120), nil)
+			},
+			wantErr: false,
+			want: &flowv1.DeviceAuthorizationResponse{
+				Issuer:                  "https://honest.as.example.com",
+				DeviceCode:              "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+				UserCode:                "WDJB-MJHT",
+				ExpiresIn:               120,
+				Interval:                5,
+				VerificationUri:         "https://honest.as.example.com/device",
+				VerificationUriComplete: types.StringRef("https://honest.as.example.com/device?user_code=WDJB-MJHT"),
+			},
+		},
 		{
 			name: "valid",
 			args: args{
@@ -206,7 +355,7 @@ func Test_service_Device(t *testing.T) {
 					Scope:    types.StringRef("openid admin"),
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession, mdc *generatormock.MockDeviceCode, mduc *generatormock.MockDeviceUserCode) {
+			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession, mdc *generatormock.MockDeviceCode, mduc *generatormock.MockDeviceUserCode, _ *storagemock.MockResourceReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{oidc.GrantTypeDeviceCode},
@@ -217,11 +366,13 @@ func Test_service_Device(t *testing.T) {
 			},
 			wantErr: false,
 			want: &flowv1.DeviceAuthorizationResponse{
-				Issuer:     "https://honest.as.example.com",
-				DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
-				UserCode:   "WDJB-MJHT",
-				ExpiresIn:  120,
-				Interval:   5,
+				Issuer:                  "https://honest.as.example.com",
+				DeviceCode:              "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+				UserCode:                "WDJB-MJHT",
+				ExpiresIn:               120,
+				Interval:                5,
+				VerificationUri:         "https://honest.as.example.com/device",
+				VerificationUriComplete: types.StringRef("https://honest.as.example.com/device?user_code=WDJB-MJHT"),
 			},
 		},
 	}
@@ -235,14 +386,19 @@ func Test_service_Device(t *testing.T) {
 			deviceCodeSessions := storagemock.NewMockDeviceCodeSession(ctrl)
 			deviceCodes := generatormock.NewMockDeviceCode(ctrl)
 			userCodes := generatormock.NewMockDeviceUserCode(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(clients, deviceCodeSessions, deviceCodes, userCodes)
+				tt.prepare(clients, deviceCodeSessions, deviceCodes, userCodes, resources)
 			}
 
 			// Prepare service
-			underTest := New(clients, deviceCodeSessions, deviceCodes, userCodes)
+			verificationURI := "https://honest.as.example.com/device"
+			if tt.verificationURI != "" {
+				verificationURI = tt.verificationURI
+			}
+			underTest := New(clients, deviceCodeSessions, deviceCodes, userCodes, nil, resources, verificationURI)
 
 			// Do the request
 			got, err := underTest.Authorize(tt.args.ctx, tt.args.req)
@@ -256,3 +412,756 @@ func Test_service_Device(t *testing.T) {
 		})
 	}
 }
+
+func Test_service_Validate(t *testing.T) {
+	newSession := func(status sessionv1.DeviceCodeStatus) *sessionv1.DeviceCodeSession {
+		return &sessionv1.DeviceCodeSession{
+			Issuer: "https://honest.as.example.com",
+			Client: &clientv1.Client{
+				ClientId:   "s6BhdRkqt3",
+				ClientName: "Living room TV",
+			},
+			Request: &flowv1.DeviceAuthorizationRequest{
+				ClientId: "s6BhdRkqt3",
+				Resource: []string{"urn:example:backend-api"},
+			},
+			DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+			Scope:      types.StringRef("openid admin"),
+			Audience:   types.StringRef("urn:example:cooperation-context"),
+			ExpiresAt:  1700000120,
+			Status:     status,
+		}
+	}
+	confirmedSession := func() *sessionv1.DeviceCodeSession {
+		s := newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING)
+		s.Subject = types.StringRef("user1")
+		s.ConfirmationCode = "bQ6ZeYWkmEZXhUj3"
+		return s
+	}
+
+	allowAttempt := func(userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt, subject string) {
+		attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(0), uint64(0), nil)
+		attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:"+subject).Return(uint64(0), uint64(0), nil).Times(2)
+		userCodes.EXPECT().Normalize(gomock.Any(), "https://honest.as.example.com", gomock.Any()).Return("WDJB-MJHT", nil)
+		attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "reserved:"+subject, uint64(1700000900)).Return(uint64(1), nil)
+		attempts.EXPECT().Release(gomock.Any(), "https://honest.as.example.com", "reserved:"+subject).Return(nil)
+	}
+
+	type args struct {
+		ctx context.Context
+		req *flowv1.DeviceCodeValidationRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockDeviceCodeSession, *generatormock.MockDeviceUserCode, *storagemock.MockDeviceUserCodeAttempt)
+		want    *flowv1.DeviceCodeValidationResponse
+		wantErr bool
+		// Confirmation code is randomly generated
+		wantGeneratedCode bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{},
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty user code",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer: "https://honest.as.example.com",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty subject",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+				},
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "session not found",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(nil, storage.ErrNotFound)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "subject:user1", uint64(1700000900)).Return(uint64(1), nil)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "issuer", uint64(1700000900)).Return(uint64(1), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "session not found: attempt storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(nil, storage.ErrNotFound)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "subject:user1", uint64(1700000900)).Return(uint64(0), fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "session storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "malformed user code",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(_ *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(0), uint64(0), nil)
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(uint64(0), uint64(0), nil)
+				userCodes.EXPECT().Normalize(gomock.Any(), "https://honest.as.example.com", "WDJB").Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "issuer throttled",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(_ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(MaxIssuerUserCodeFailures), uint64(1700000000), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.Slowdown().Build(),
+			},
+		},
+		{
+			name: "issuer throttled: backoff elapsed",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(MaxIssuerUserCodeFailures), uint64(1699999999), nil)
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(uint64(0), uint64(0), nil).Times(2)
+				userCodes.EXPECT().Normalize(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return("WDJB-MJHT", nil)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "reserved:user1", uint64(1700000900)).Return(uint64(1), nil)
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(nil, storage.ErrNotFound)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "subject:user1", uint64(1700000900)).Return(uint64(1), nil)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "issuer", uint64(1700000900)).Return(uint64(MaxIssuerUserCodeFailures+1), nil)
+				attempts.EXPECT().Release(gomock.Any(), "https://honest.as.example.com", "reserved:user1").Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "subject locked",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(_ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(12), uint64(1699999000), nil)
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(uint64(MaxSubjectUserCodeFailures), uint64(1699999000), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.AccessDenied().Build(),
+			},
+		},
+		{
+			name: "subject backoff",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(_ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(4), uint64(1699999996), nil)
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(uint64(4), uint64(1699999996), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.Slowdown().Build(),
+			},
+		},
+		{
+			name: "attempt storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(_ *storagemock.MockDeviceCodeSession, _ *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(0), uint64(0), fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "subject locked by concurrent attempts",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(_ *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(0), uint64(0), nil)
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(uint64(MaxSubjectUserCodeFailures-1), uint64(1699999000), nil).Times(2)
+				userCodes.EXPECT().Normalize(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return("WDJB-MJHT", nil)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "reserved:user1", uint64(1700000900)).Return(uint64(2), nil)
+				attempts.EXPECT().Release(gomock.Any(), "https://honest.as.example.com", "reserved:user1").Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.AccessDenied().Build(),
+			},
+		},
+		{
+			name: "subject attempt storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(_ *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(0), uint64(0), nil)
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(uint64(0), uint64(0), nil)
+				userCodes.EXPECT().Normalize(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return("WDJB-MJHT", nil)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "reserved:user1", uint64(1700000900)).Return(uint64(0), fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "attempt release error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "issuer").Return(uint64(0), uint64(0), nil)
+				attempts.EXPECT().Get(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(uint64(0), uint64(0), nil).Times(2)
+				userCodes.EXPECT().Normalize(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return("WDJB-MJHT", nil)
+				attempts.EXPECT().Fail(gomock.Any(), "https://honest.as.example.com", "reserved:user1", uint64(1700000900)).Return(uint64(1), nil)
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(&sessionv1.DeviceCodeSession{}, nil)
+				attempts.EXPECT().Release(gomock.Any(), "https://honest.as.example.com", "reserved:user1").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "expired session",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				s := newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING)
+				s.ExpiresAt = 1699999999
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(s, nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.TokenExpired().Build(),
+			},
+		},
+		{
+			name: "confirm: update error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING), nil)
+				sessions.EXPECT().Update(gomock.Any(), "https://honest.as.example.com", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "confirm: bound to another subject",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user2",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user2")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "confirm: already validated",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "decide: not confirmed",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:           "https://honest.as.example.com",
+					UserCode:         "WDJB-MJHT",
+					Subject:          "user1",
+					ConfirmationCode: types.StringRef("bQ6ZeYWkmEZXhUj3"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "decide: invalid confirmation code",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:           "https://honest.as.example.com",
+					UserCode:         "WDJB-MJHT",
+					Subject:          "user1",
+					ConfirmationCode: types.StringRef("XXXXXXXXXXXXXXXX"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "decide: another subject",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:           "https://honest.as.example.com",
+					UserCode:         "WDJB-MJHT",
+					Subject:          "user2",
+					ConfirmationCode: types.StringRef("bQ6ZeYWkmEZXhUj3"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user2")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "decide: storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:           "https://honest.as.example.com",
+					UserCode:         "WDJB-MJHT",
+					Subject:          "user1",
+					ConfirmationCode: types.StringRef("bQ6ZeYWkmEZXhUj3"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+				sessions.EXPECT().Validate(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT", gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "decide: attempt reset error",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:           "https://honest.as.example.com",
+					UserCode:         "WDJB-MJHT",
+					Subject:          "user1",
+					ConfirmationCode: types.StringRef("bQ6ZeYWkmEZXhUj3"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+				sessions.EXPECT().Validate(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT", gomock.Any()).Return(nil)
+				sessions.EXPECT().Delete(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(nil)
+				attempts.EXPECT().Reset(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid confirm",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING), nil)
+				sessions.EXPECT().Update(gomock.Any(), "https://honest.as.example.com", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.DeviceCodeSession) error {
+					if s.Status != sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING {
+						return fmt.Errorf("unexpected session status %v", s.Status)
+					}
+					if s.GetSubject() != "user1" || len(s.ConfirmationCode) != confirmationCodeLen {
+						return fmt.Errorf("unexpected session binding")
+					}
+					return nil
+				})
+			},
+			wantErr:           false,
+			wantGeneratedCode: true,
+			want: &flowv1.DeviceCodeValidationResponse{
+				ClientId:   "s6BhdRkqt3",
+				ClientName: "Living room TV",
+				Scope:      types.StringRef("openid admin"),
+				Audience:   types.StringRef("urn:example:cooperation-context"),
+				Resource:   []string{"urn:example:backend-api"},
+			},
+		},
+		{
+			name: "valid confirm displayed again",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:   "https://honest.as.example.com",
+					UserCode: "WDJB-MJHT",
+					Subject:  "user1",
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+			},
+			wantErr: false,
+			want: &flowv1.DeviceCodeValidationResponse{
+				ConfirmationCode: types.StringRef("bQ6ZeYWkmEZXhUj3"),
+				ClientId:         "s6BhdRkqt3",
+				ClientName:       "Living room TV",
+				Scope:            types.StringRef("openid admin"),
+				Audience:         types.StringRef("urn:example:cooperation-context"),
+				Resource:         []string{"urn:example:backend-api"},
+			},
+		},
+		{
+			name: "valid approval",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:           "https://honest.as.example.com",
+					UserCode:         "wdjb mjht",
+					Subject:          "user1",
+					ConfirmationCode: types.StringRef("bQ6ZeYWkmEZXhUj3"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+				validate := sessions.EXPECT().Validate(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.DeviceCodeSession) error {
+					if s.Status != sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED {
+						return fmt.Errorf("unexpected session status %v", s.Status)
+					}
+					return nil
+				})
+				deleted := sessions.EXPECT().Delete(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(nil).After(validate)
+				attempts.EXPECT().Reset(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(nil).After(deleted)
+			},
+			wantErr: false,
+			want:    &flowv1.DeviceCodeValidationResponse{},
+		},
+		{
+			name: "valid denial",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.DeviceCodeValidationRequest{
+					Issuer:           "https://honest.as.example.com",
+					UserCode:         "WDJB-MJHT",
+					Subject:          "user1",
+					ConfirmationCode: types.StringRef("bQ6ZeYWkmEZXhUj3"),
+					Denied:           true,
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, userCodes *generatormock.MockDeviceUserCode, attempts *storagemock.MockDeviceUserCodeAttempt) {
+				allowAttempt(userCodes, attempts, "user1")
+				sessions.EXPECT().GetByUserCode(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(confirmedSession(), nil)
+				validate := sessions.EXPECT().Validate(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.DeviceCodeSession) error {
+					if s.Status != sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_DENIED {
+						return fmt.Errorf("unexpected session status %v", s.Status)
+					}
+					return nil
+				})
+				deleted := sessions.EXPECT().Delete(gomock.Any(), "https://honest.as.example.com", "WDJB-MJHT").Return(nil).After(validate)
+				attempts.EXPECT().Reset(gomock.Any(), "https://honest.as.example.com", "subject:user1").Return(nil).After(deleted)
+			},
+			wantErr: false,
+			want:    &flowv1.DeviceCodeValidationResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Freeze time
+			timeFunc = func() time.Time { return time.Unix(1700000000, 0) }
+			defer func() { timeFunc = time.Now }()
+
+			// Arm mocks
+			deviceCodeSessions := storagemock.NewMockDeviceCodeSession(ctrl)
+			userCodes := generatormock.NewMockDeviceUserCode(ctrl)
+			userCodeAttempts := storagemock.NewMockDeviceUserCodeAttempt(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(deviceCodeSessions, userCodes, userCodeAttempts)
+			}
+
+			// Prepare service
+			underTest := New(nil, deviceCodeSessions, nil, userCodes, userCodeAttempts, nil, "https://honest.as.example.com/device")
+
+			// Do the request
+			got, err := underTest.Validate(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantGeneratedCode {
+				if len(got.GetConfirmationCode()) != confirmationCodeLen {
+					t.Errorf("service.Validate() confirmation code = %q, want %d characters", got.GetConfirmationCode(), confirmationCodeLen)
+				}
+				got.ConfirmationCode = nil
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.Validate() res =%s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_Validate_ConfirmThenDecide(t *testing.T) {
+	// Freeze time, the in-memory counters expire against the wall clock
+	now := time.Now()
+	timeFunc = func() time.Time { return now }
+	defer func() { timeFunc = time.Now }()
+
+	ctx := context.Background()
+	deviceCodeSessions := inmemory.DeviceCodeSessions()
+	userCodeAttempts := inmemory.DeviceUserCodeAttempts()
+
+	// Register a pending session
+	if _, err := deviceCodeSessions.Register(ctx, "https://honest.as.example.com", "WDJB-MJHT", &sessionv1.DeviceCodeSession{
+		Issuer: "https://honest.as.example.com",
+		Client: &clientv1.Client{
+			ClientId:   "s6BhdRkqt3",
+			ClientName: "Living room TV",
+		},
+		Request: &flowv1.DeviceAuthorizationRequest{
+			ClientId: "s6BhdRkqt3",
+		},
+		DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+		ExpiresAt:  uint64(now.Add(2 * time.Minute).Unix()),
+		Status:     sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
+	}); err != nil {
+		t.Fatalf("unable to register session: %v", err)
+	}
+
+	// Prepare service
+	underTest := New(nil, deviceCodeSessions, nil, generator.DefaultDeviceUserCode(), userCodeAttempts, nil, "https://honest.as.example.com/device")
+
+	// Mistype the user code once
+	if _, err := underTest.Validate(ctx, &flowv1.DeviceCodeValidationRequest{
+		Issuer:   "https://honest.as.example.com",
+		UserCode: "WDJB-MJHX",
+		Subject:  "user1",
+	}); err == nil {
+		t.Fatal("service.Validate() expected an error for an unknown user code")
+	}
+
+	// Wait for the backoff to elapse
+	now = now.Add(time.Second)
+
+	// Confirm the request
+	confirmed, err := underTest.Validate(ctx, &flowv1.DeviceCodeValidationRequest{
+		Issuer:   "https://honest.as.example.com",
+		UserCode: "wdjb mjht",
+		Subject:  "user1",
+	})
+	if err != nil {
+		t.Fatalf("service.Validate() confirm error = %v", err)
+	}
+
+	// Decide immediately
+	decided, err := underTest.Validate(ctx, &flowv1.DeviceCodeValidationRequest{
+		Issuer:           "https://honest.as.example.com",
+		UserCode:         "WDJB-MJHT",
+		Subject:          "user1",
+		ConfirmationCode: confirmed.ConfirmationCode,
+	})
+	if err != nil {
+		t.Fatalf("service.Validate() decide error = %v", err)
+	}
+	if diff := cmp.Diff(decided, &flowv1.DeviceCodeValidationResponse{}, cmpOpts...); diff != "" {
+		t.Errorf("service.Validate() res =%s", diff)
+	}
+
+	// Ensure the failures are forgiven once decided
+	failures, _, err := userCodeAttempts.Get(ctx, "https://honest.as.example.com", "subject:user1")
+	if err != nil {
+		t.Fatalf("unable to retrieve subject attempts: %v", err)
+	}
+	if failures != 0 {
+		t.Errorf("subject failures = %d, want 0", failures)
+	}
+}
@@ -0,0 +1,65 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"fmt"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	"zntr.io/solid/sdk/rar"
+	"zntr.io/solid/sdk/rfcerrors"
+)
+
+// accessTokenAuthorizationDetails returns the authorization details of an
+// access token. The requested ones must be a subset of the granted ones, the
+// granted ones are used when none are requested.
+// https://www.rfc-editor.org/rfc/rfc9396#section-6.1
+func accessTokenAuthorizationDetails(granted string, req *flowv1.TokenRequest, res *flowv1.TokenResponse) (string, error) {
+	// Decode granted authorization details
+	grantedDetails, err := rar.Parse(granted)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return "", fmt.Errorf("unable to decode granted authorization details: %w", err)
+	}
+
+	// Decode requested authorization details
+	requested, err := rar.Parse(req.AuthorizationDetails)
+	if err != nil {
+		res.Error = rfcerrors.InvalidAuthorizationDetails().Build()
+		return "", fmt.Errorf("unable to decode requested authorization details: %w", err)
+	}
+	if len(requested) == 0 {
+		return granted, nil
+	}
+
+	// Check requested authorization details
+	if !rar.Contains(grantedDetails, requested) {
+		res.Error = rfcerrors.InvalidAuthorizationDetails().Build()
+		return "", fmt.Errorf("requested authorization details have not been granted")
+	}
+
+	// Encode the result
+	details, err := rar.Encode(requested)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return "", fmt.Errorf("unable to encode authorization details: %w", err)
+	}
+
+	// No error
+	return details, nil
+}
@@ -19,23 +19,33 @@ package token
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/dchest/uniuri"
+	"google.golang.org/protobuf/proto"
 
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
 )
 
 const (
-	jtiLength = 8
+	jtiLength     = 8
+	grantIDLength = 16
 )
 
 var timeFunc = time.Now
 
-func (s *service) generateAccessToken(ctx context.Context, client *clientv1.Client, meta *tokenv1.TokenMeta, cnf *tokenv1.TokenConfirmation) (*tokenv1.Token, error) {
-	var err error
+func (s *service) generateAccessToken(ctx context.Context, client *clientv1.Client, grantType string, meta *tokenv1.TokenMeta, cnf *tokenv1.TokenConfirmation, grantID, parentID string, actors []*tokenv1.Actor) (*tokenv1.Token, error) {
+	// Resolve token lifetime
+	lifetime, err := s.tokenLifetime(ctx, tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN, grantType, client, meta.Audience)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve token lifetime: %w", err)
+	}
 
 	// Create access token spec
 	now := timeFunc()
@@ -43,21 +53,32 @@ func (s *service) generateAccessToken(ctx context.Context, client *clientv1.Clie
 		TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
 		TokenId:   uniuri.NewLen(jtiLength),
 		Metadata: &tokenv1.TokenMeta{
-			Issuer:    meta.Issuer,
-			Subject:   meta.Subject,
-			ClientId:  client.ClientId,
-			IssuedAt:  uint64(now.Unix()),
-			NotBefore: uint64(now.Unix() + 1),
-			ExpiresAt: uint64(now.Add(1 * time.Hour).Unix()),
-			Scope:     meta.Scope,
-			Audience:  meta.Audience,
+			Issuer:               meta.Issuer,
+			Subject:              meta.Subject,
+			ClientId:             client.ClientId,
+			IssuedAt:             uint64(now.Unix()),
+			NotBefore:            uint64(now.Unix() + 1),
+			ExpiresAt:            uint64(now.Add(lifetime).Unix()),
+			Scope:                meta.Scope,
+			Audience:             meta.Audience,
+			Acr:                  meta.Acr,
+			AuthTime:             meta.AuthTime,
+			AuthorizationDetails: meta.AuthorizationDetails,
 		},
 		Confirmation: cnf,
 		Status:       tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		GrantId:      grantID,
+		ParentId:     parentID,
+		Actor:        actors,
+	}
+
+	// Assign a status list entry
+	if err := s.assignStatusIndex(ctx, at); err != nil {
+		return nil, fmt.Errorf("unable to assign a status list index: %w", err)
 	}
 
 	// Generate an access token
-	at.Value, err = s.accessTokenGen.Generate(ctx, at)
+	at.Value, err = s.accessTokenValue(ctx, client, at)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate an accessToken: %w", err)
 	}
@@ -76,8 +97,12 @@ func (s *service) generateAccessToken(ctx context.Context, client *clientv1.Clie
 	return at, nil
 }
 
-func (s *service) generateRefreshToken(ctx context.Context, client *clientv1.Client, meta *tokenv1.TokenMeta, cnf *tokenv1.TokenConfirmation) (*tokenv1.Token, error) {
-	var err error
+func (s *service) generateRefreshToken(ctx context.Context, client *clientv1.Client, grantType string, meta *tokenv1.TokenMeta, cnf *tokenv1.TokenConfirmation, grantID, parentID string, notAfter uint64) (*tokenv1.Token, error) {
+	// Resolve token lifetime
+	lifetime, err := s.tokenLifetime(ctx, tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN, grantType, client, meta.Audience)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve token lifetime: %w", err)
+	}
 
 	// Create access token spec
 	now := timeFunc()
@@ -85,17 +110,28 @@ func (s *service) generateRefreshToken(ctx context.Context, client *clientv1.Cli
 		TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
 		TokenId:   uniuri.NewLen(jtiLength),
 		Metadata: &tokenv1.TokenMeta{
-			Issuer:    meta.Issuer,
-			Subject:   meta.Subject,
-			ClientId:  client.ClientId,
-			IssuedAt:  uint64(now.Unix()),
-			NotBefore: uint64(now.Unix() + 1),
-			ExpiresAt: uint64(now.AddDate(0, 0, 7).Unix()),
-			Scope:     meta.Scope,
-			Audience:  meta.Audience,
+			Issuer:               meta.Issuer,
+			Subject:              meta.Subject,
+			ClientId:             client.ClientId,
+			IssuedAt:             uint64(now.Unix()),
+			NotBefore:            uint64(now.Unix() + 1),
+			ExpiresAt:            uint64(now.Add(lifetime).Unix()),
+			Scope:                meta.Scope,
+			Audience:             meta.Audience,
+			Acr:                  meta.Acr,
+			AuthTime:             meta.AuthTime,
+			Resources:            meta.Resources,
+			AuthorizationDetails: meta.AuthorizationDetails,
 		},
 		Confirmation: cnf,
 		Status:       tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		GrantId:      grantID,
+		ParentId:     parentID,
+	}
+
+	// Cap expiration when required by the caller
+	if notAfter > 0 && at.Metadata.ExpiresAt > notAfter {
+		at.Metadata.ExpiresAt = notAfter
 	}
 
 	// Generate an access token
@@ -117,3 +153,126 @@ func (s *service) generateRefreshToken(ctx context.Context, client *clientv1.Cli
 	// No error
 	return at, nil
 }
+
+func (s *service) generateIDToken(ctx context.Context, client *clientv1.Client, grantType string, meta *tokenv1.TokenMeta, at *tokenv1.Token, grantID string) (*tokenv1.Token, error) {
+	// Check parameters
+	if types.IsNil(s.idTokenGen) {
+		return nil, fmt.Errorf("unable to generate an id token without id token generator")
+	}
+
+	// Resolve token lifetime
+	lifetime, err := s.tokenLifetime(ctx, tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN, grantType, client, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve token lifetime: %w", err)
+	}
+
+	// Create id token spec
+	now := timeFunc()
+	idt := &tokenv1.Token{
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+		TokenId:   uniuri.NewLen(jtiLength),
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    meta.Issuer,
+			Subject:   meta.Subject,
+			ClientId:  client.ClientId,
+			IssuedAt:  uint64(now.Unix()),
+			NotBefore: uint64(now.Unix()),
+			ExpiresAt: uint64(now.Add(lifetime).Unix()),
+			Scope:     meta.Scope,
+			Audience:  client.ClientId,
+			Acr:       meta.Acr,
+			AuthTime:  meta.AuthTime,
+			Nonce:     meta.Nonce,
+		},
+		Status:  tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		GrantId: grantID,
+	}
+
+	// Generate an id token
+	idt.Value, err = s.idTokenGen.Generate(ctx, client, idt, at)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate an id token: %w", err)
+	}
+
+	// Check generator value
+	if idt.Value == "" {
+		return nil, fmt.Errorf("idTokenGenerator generated an empty value")
+	}
+
+	// No error
+	return idt, nil
+}
+
+// accessTokenValue generates the access token value handed to the client, an
+// opaque phantom token when the client requires it.
+func (s *service) accessTokenValue(ctx context.Context, client *clientv1.Client, at *tokenv1.Token) (string, error) {
+	if !client.PhantomAccessTokens {
+		return s.accessTokenGen.Generate(ctx, at)
+	}
+
+	// Check phantom token support
+	if types.IsNil(s.phantomTokenGen) {
+		return "", errors.New("phantom token generator is not configured")
+	}
+
+	// Phantom token is an opaque reference to the access token
+	pt := proto.Clone(at).(*tokenv1.Token)
+	pt.TokenType = tokenv1.TokenType_TOKEN_TYPE_PHANTOM_TOKEN
+
+	return s.phantomTokenGen.Generate(ctx, pt)
+}
+
+// assignStatusIndex reserves a status list entry for the given access token,
+// skipped when status lists are not configured.
+func (s *service) assignStatusIndex(ctx context.Context, at *tokenv1.Token) error {
+	if types.IsNil(s.statusListGen) || types.IsNil(s.statusLists) {
+		return nil
+	}
+
+	// Reserve the next index
+	idx, err := s.statusLists.AllocateStatusIndex(ctx, at.Metadata.Issuer)
+	if err != nil {
+		return err
+	}
+
+	at.StatusList = &tokenv1.TokenStatusReference{
+		Idx: idx,
+		Uri: s.statusListGen.URI(at.Metadata.Issuer),
+	}
+
+	// No error
+	return nil
+}
+
+func (s *service) tokenLifetime(ctx context.Context, tokenType tokenv1.TokenType, grantType string, client *clientv1.Client, audience string) (time.Duration, error) {
+	// Resolve targeted resource
+	var resource *resourcev1.Resource
+	if audience != "" && !types.IsNil(s.resources) {
+		r, err := s.resources.GetByURI(ctx, audience)
+		switch {
+		case err == nil:
+			resource = r
+		case errors.Is(err, storage.ErrNotFound):
+			// Unregistered resource, fallback to client and grant lifetimes
+		default:
+			return 0, fmt.Errorf("unable to retrieve resource '%s': %w", audience, err)
+		}
+	}
+
+	// Delegate to lifetime policy
+	lifetime, err := s.lifetimes.Lifetime(ctx, tokenType, grantType, client, resource)
+	if err != nil {
+		return 0, err
+	}
+	if lifetime <= 0 {
+		return 0, fmt.Errorf("lifetime policy returned an invalid lifetime")
+	}
+
+	// No error
+	return lifetime, nil
+}
+
+// newGrantID returns a new token family identifier.
+func newGrantID() string {
+	return uniuri.NewLen(grantIDLength)
+}
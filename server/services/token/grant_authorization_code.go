@@ -26,12 +26,15 @@ import (
 	"strings"
 	"unicode/utf8"
 
+	"google.golang.org/protobuf/proto"
+
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
 	"zntr.io/solid/server/storage"
 )
 
@@ -100,8 +103,8 @@ func (s *service) authorizationCode(ctx context.Context, client *clientv1.Client
 		return res, fmt.Errorf("invalid authorization request: code_verifier is too long")
 	}
 
-	// Retrieve authorization request from code
-	ar, err := s.authorizationCodeSessions.Get(ctx, req.Issuer, grant.Code)
+	// Retrieve and burn the authorization request from code
+	ar, err := s.authorizationCodeSessions.Consume(ctx, req.Issuer, grant.Code)
 	if err != nil {
 		if err != storage.ErrNotFound {
 			res.Error = rfcerrors.ServerError().Build()
@@ -117,13 +120,6 @@ func (s *service) authorizationCode(ctx context.Context, client *clientv1.Client
 		return res, fmt.Errorf("retrieve authorization request is invalid '%s': %w", grant.Code, err)
 	}
 
-	// Delete session
-	err = s.authorizationCodeSessions.Delete(ctx, req.Issuer, grant.Code)
-	if err != nil {
-		res.Error = rfcerrors.ServerError().Build()
-		return res, fmt.Errorf("unable to remove authorization session from code '%s': %w", grant.Code, err)
-	}
-
 	// Validate redirectUri
 	if ar.Request.RedirectUri != grant.RedirectUri {
 		res.Error = rfcerrors.InvalidGrant().State(ar.Request.State).Build()
@@ -149,18 +145,48 @@ func (s *service) authorizationCode(ctx context.Context, client *clientv1.Client
 		return res, fmt.Errorf("invalid code_challenge_method in request `%s`", ar.Request.CodeChallengeMethod)
 	}
 
+	// Requested resources must be a subset of the authorized ones
+	// https://www.rfc-editor.org/rfc/rfc8707#section-2.2
+	targets, err := s.requestedTargets(ctx, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested resources: %w", err)
+	}
+	granted := resourceindicator.Granted(ar.Request.Audience, ar.Request.Resource)
+	audience, err := accessTokenTarget(targets, granted, ar.Request.Audience, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token resource: %w", err)
+	}
+
+	// Access token authorization details can be narrowed from the granted ones
+	details, err := accessTokenAuthorizationDetails(ar.Request.AuthorizationDetails, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token authorization details: %w", err)
+	}
+
 	// Validate scopes
 	scopes := types.StringArray(strings.Fields(ar.Request.Scope))
 
 	// Generate OpenID tokens (AT / RT / IDT)
 	if scopes.Contains(oidc.ScopeOpenID) {
+		// All tokens issued from this code belong to the same family
+		grantID := newGrantID()
+
+		// Prepare token meta
+		tm := &tokenv1.TokenMeta{
+			Issuer:               req.Issuer,
+			Subject:              ar.Subject,
+			Audience:             audience,
+			Scope:                ar.Request.Scope,
+			Acr:                  ar.Acr,
+			AuthTime:             ar.AuthTime,
+			Resources:            granted,
+			AuthorizationDetails: ar.Request.AuthorizationDetails,
+		}
+
 		// Generate access token
-		at, err := s.generateAccessToken(ctx, client, &tokenv1.TokenMeta{
-			Issuer:   req.Issuer,
-			Subject:  ar.Subject,
-			Audience: ar.Request.Audience,
-			Scope:    ar.Request.Scope,
-		}, req.TokenConfirmation)
+		atMeta := proto.Clone(tm).(*tokenv1.TokenMeta)
+		atMeta.AuthorizationDetails = details
+		at, err := s.generateAccessToken(ctx, client, req.GrantType, atMeta, req.TokenConfirmation, grantID, "", nil)
 		if err != nil {
 			res.Error = rfcerrors.ServerError().Build()
 			return res, fmt.Errorf("unable to generate access token: %w", err)
@@ -169,12 +195,7 @@ func (s *service) authorizationCode(ctx context.Context, client *clientv1.Client
 		// Check if request has offline_access to generate refresh_token
 		if scopes.Contains(oidc.ScopeOfflineAccess) {
 			// Generate refresh token
-			rt, err := s.generateRefreshToken(ctx, client, &tokenv1.TokenMeta{
-				Issuer:   req.Issuer,
-				Subject:  ar.Subject,
-				Audience: ar.Request.Audience,
-				Scope:    ar.Request.Scope,
-			}, at.Confirmation)
+			rt, err := s.generateRefreshToken(ctx, client, req.GrantType, tm, at.Confirmation, grantID, "", 0)
 			if err != nil {
 				res.Error = rfcerrors.ServerError().Build()
 				return res, fmt.Errorf("unable to generate refresh token: %w", err)
@@ -184,8 +205,22 @@ func (s *service) authorizationCode(ctx context.Context, client *clientv1.Client
 			res.RefreshToken = rt
 		}
 
+		// Bind id token to the authentication request
+		if ar.Request.Nonce != "" {
+			tm.Nonce = types.StringRef(ar.Request.Nonce)
+		}
+
+		// Generate id token
+		idt, err := s.generateIDToken(ctx, client, req.GrantType, tm, at, grantID)
+		if err != nil {
+			res.RefreshToken = nil
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to generate id token: %w", err)
+		}
+
 		// Assign response
 		res.AccessToken = at
+		res.IdToken = idt
 	}
 
 	// No error
@@ -33,7 +33,9 @@ import (
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
 	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/storage"
 	storagemock "zntr.io/solid/server/storage/mock"
 )
@@ -47,7 +49,7 @@ func Test_service_authorizationCode(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockAuthorizationCodeSession, *storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator)
+		prepare func(*storagemock.MockAuthorizationCodeSession, *storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator, *tokenmock.MockIDTokenGenerator)
 		want    *flowv1.TokenResponse
 		wantErr bool
 	}{
@@ -351,8 +353,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil, storage.ErrNotFound)
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -383,8 +385,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil, fmt.Errorf("foo"))
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -415,8 +417,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: nil,
 				}, nil)
 			},
@@ -426,7 +428,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "session deletion error",
+			name: "redirect_uri mismatch",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -449,8 +451,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -462,15 +464,14 @@ func Test_service_authorizationCode(t *testing.T) {
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(fmt.Errorf("foo"))
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
-				Error: rfcerrors.ServerError().Build(),
+				Error: rfcerrors.InvalidGrant().State("af0ifjsldkj").Build(),
 			},
 		},
 		{
-			name: "redirect_uri mismatch",
+			name: "redirect_uri mismatch: client changes between request",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -488,25 +489,24 @@ func Test_service_authorizationCode(t *testing.T) {
 						AuthorizationCode: &flowv1.GrantAuthorizationCode{
 							Code:         "1234567891234567890",
 							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
-							RedirectUri:  "https://client.example.org/cb12346",
+							RedirectUri:  "https://client.example.org/cb1",
 						},
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
 						Scope:               "openid profile email",
 						ClientId:            "s6BhdRkqt3",
 						State:               "af0ifjsldkj",
-						RedirectUri:         "https://client.example.org/cb",
+						RedirectUri:         "https://client.example.org/cb1",
 						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -514,7 +514,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "redirect_uri mismatch: client changes between request",
+			name: "invalid code_verifier",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -531,26 +531,25 @@ func Test_service_authorizationCode(t *testing.T) {
 					Grant: &flowv1.TokenRequest_AuthorizationCode{
 						AuthorizationCode: &flowv1.GrantAuthorizationCode{
 							Code:         "1234567891234567890",
-							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
-							RedirectUri:  "https://client.example.org/cb1",
+							CodeVerifier: "foofoofoofoofoofoofoofoofoofoofoofoofoofoofoo",
+							RedirectUri:  "https://client.example.org/cb",
 						},
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
 						Scope:               "openid profile email",
 						ClientId:            "s6BhdRkqt3",
 						State:               "af0ifjsldkj",
-						RedirectUri:         "https://client.example.org/cb1",
+						RedirectUri:         "https://client.example.org/cb",
 						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -558,7 +557,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid code_verifier",
+			name: "invalid code_challenge_method",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -575,14 +574,14 @@ func Test_service_authorizationCode(t *testing.T) {
 					Grant: &flowv1.TokenRequest_AuthorizationCode{
 						AuthorizationCode: &flowv1.GrantAuthorizationCode{
 							Code:         "1234567891234567890",
-							CodeVerifier: "foofoofoofoofoofoofoofoofoofoofoofoofoofoofoo",
+							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
 							RedirectUri:  "https://client.example.org/cb",
 						},
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -591,18 +590,18 @@ func Test_service_authorizationCode(t *testing.T) {
 						State:               "af0ifjsldkj",
 						RedirectUri:         "https://client.example.org/cb",
 						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
-						CodeChallengeMethod: "S256",
+						CodeChallengeMethod: "xxx",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
 				Error: rfcerrors.InvalidGrant().State("af0ifjsldkj").Build(),
 			},
 		},
+		// ---------------------------------------------------------------------
 		{
-			name: "invalid code_challenge_method",
+			name: "openid: generate access token error",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -625,8 +624,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -635,19 +634,18 @@ func Test_service_authorizationCode(t *testing.T) {
 						State:               "af0ifjsldkj",
 						RedirectUri:         "https://client.example.org/cb",
 						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
-						CodeChallengeMethod: "xxx",
+						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
-				Error: rfcerrors.InvalidGrant().State("af0ifjsldkj").Build(),
+				Error: rfcerrors.ServerError().Build(),
 			},
 		},
-		// ---------------------------------------------------------------------
 		{
-			name: "openid: generate access token error",
+			name: "openid: empty generated access token",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -670,8 +668,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -683,8 +681,7 @@ func Test_service_authorizationCode(t *testing.T) {
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
-				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -692,7 +689,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "openid: empty generated access token",
+			name: "openid: access token storage error",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -715,8 +712,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -728,8 +725,8 @@ func Test_service_authorizationCode(t *testing.T) {
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
-				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo"))
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -737,7 +734,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "openid: access token storage error",
+			name: "openid: generate refresh token error",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -760,12 +757,12 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
-						Scope:               "openid profile email",
+						Scope:               "openid profile email offline_access",
 						ClientId:            "s6BhdRkqt3",
 						State:               "af0ifjsldkj",
 						RedirectUri:         "https://client.example.org/cb",
@@ -773,9 +770,9 @@ func Test_service_authorizationCode(t *testing.T) {
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
-				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo"))
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -783,7 +780,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "openid: generate refresh token error",
+			name: "openid: empty generated refresh token",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -806,8 +803,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -819,10 +816,9 @@ func Test_service_authorizationCode(t *testing.T) {
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
-				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -830,7 +826,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "openid: empty generated refresh token",
+			name: "openid: refresh token storage error",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -853,8 +849,8 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -866,10 +862,10 @@ func Test_service_authorizationCode(t *testing.T) {
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
-				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
-				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo")).After(atSave)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -877,7 +873,7 @@ func Test_service_authorizationCode(t *testing.T) {
 			},
 		},
 		{
-			name: "openid: refresh token storage error",
+			name: "openid: id token generation error",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -900,8 +896,11 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+					AuthTime: types.UInt64Ref(1),
+					Acr:      types.StringRef("urn:mace:incommon:iap:silver"),
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -911,13 +910,14 @@ func Test_service_authorizationCode(t *testing.T) {
 						RedirectUri:         "https://client.example.org/cb",
 						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
 						CodeChallengeMethod: "S256",
+						Nonce:               "n-0S6_WzA2Mj",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
-				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo")).After(atSave)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -949,9 +949,11 @@ func Test_service_authorizationCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+					AuthTime: types.UInt64Ref(1),
+					Acr:      types.StringRef("urn:mace:incommon:iap:silver"),
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -961,13 +963,14 @@ func Test_service_authorizationCode(t *testing.T) {
 						RedirectUri:         "https://client.example.org/cb",
 						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
 						CodeChallengeMethod: "S256",
+						Nonce:               "n-0S6_WzA2Mj",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
 			},
 			wantErr: false,
 			want: &flowv1.TokenResponse{
@@ -982,6 +985,8 @@ func Test_service_authorizationCode(t *testing.T) {
 						IssuedAt:  1,
 						NotBefore: 2,
 						ExpiresAt: 3601,
+						Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+						AuthTime:  types.UInt64Ref(1),
 					},
 					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
 				},
@@ -995,9 +1000,166 @@ func Test_service_authorizationCode(t *testing.T) {
 						IssuedAt:  1,
 						NotBefore: 2,
 						ExpiresAt: 604801,
+						Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+						AuthTime:  types.UInt64Ref(1),
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"},
+					},
+					Value: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+				},
+				IdToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+						Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+						AuthTime:  types.UInt64Ref(1),
+						Nonce:     types.StringRef("n-0S6_WzA2Mj"),
+					},
+					Value: "eyJ.idt.sig",
+				},
+			},
+		},
+		{
+			name: "authorization details not granted",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType:            oidc.GrantTypeAuthorizationCode,
+					AuthorizationDetails: `[{"type":"payment_initiation","actions":["initiate"]}]`,
+					Grant: &flowv1.TokenRequest_AuthorizationCode{
+						AuthorizationCode: &flowv1.GrantAuthorizationCode{
+							Code:         "1234567891234567890",
+							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+							RedirectUri:  "https://client.example.org/cb",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+					Request: &flowv1.AuthorizationRequest{
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:         "code",
+						Scope:                "openid offline_access",
+						ClientId:             "s6BhdRkqt3",
+						State:                "af0ifjsldkj",
+						RedirectUri:          "https://client.example.org/cb",
+						CodeChallenge:        "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+						CodeChallengeMethod:  "S256",
+						AuthorizationDetails: `[{"type":"account_information"},{"type":"payment_initiation"}]`,
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidAuthorizationDetails().Build(),
+			},
+		},
+		{
+			name: "openid: valid - narrow authorization details",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes: []string{"code"},
+					RedirectUris:  []string{"https://client.example.org/cb"},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType:            oidc.GrantTypeAuthorizationCode,
+					AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+					Grant: &flowv1.TokenRequest_AuthorizationCode{
+						AuthorizationCode: &flowv1.GrantAuthorizationCode{
+							Code:         "1234567891234567890",
+							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+							RedirectUri:  "https://client.example.org/cb",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+					Request: &flowv1.AuthorizationRequest{
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:         "code",
+						Scope:                "openid offline_access",
+						ClientId:             "s6BhdRkqt3",
+						State:                "af0ifjsldkj",
+						RedirectUri:          "https://client.example.org/cb",
+						CodeChallenge:        "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+						CodeChallengeMethod:  "S256",
+						AuthorizationDetails: `[{"type":"account_information"},{"type":"payment_initiation"}]`,
+					},
+				}, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://127.0.0.1:8080",
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:                "openid offline_access",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            3601,
+						AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+				RefreshToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://127.0.0.1:8080",
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:                "openid offline_access",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            604801,
+						Resources:            []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"},
+						AuthorizationDetails: `[{"type":"account_information"},{"type":"payment_initiation"}]`,
 					},
 					Value: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
 				},
+				IdToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "",
+						Scope:     "openid offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+					},
+					Value: "eyJ.idt.sig",
+				},
 			},
 		},
 	}
@@ -1010,17 +1172,20 @@ func Test_service_authorizationCode(t *testing.T) {
 			sessions := storagemock.NewMockAuthorizationCodeSession(ctrl)
 			accessTokens := tokenmock.NewMockGenerator(ctrl)
 			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			idTokens := tokenmock.NewMockIDTokenGenerator(ctrl)
 			tokens := storagemock.NewMockToken(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(sessions, tokens, accessTokens, refreshTokens)
+				tt.prepare(sessions, tokens, accessTokens, refreshTokens, idTokens)
 			}
 
 			s := &service{
 				authorizationCodeSessions: sessions,
 				accessTokenGen:            accessTokens,
+				lifetimes:                 token.DefaultLifetimePolicy(),
 				refreshTokenGen:           refreshTokens,
+				idTokenGen:                idTokens,
 				tokens:                    tokens,
 			}
 			got, err := s.authorizationCode(tt.args.ctx, tt.args.client, tt.args.req)
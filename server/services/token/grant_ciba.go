@@ -0,0 +1,260 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
+	"zntr.io/solid/server/storage"
+)
+
+//nolint:funlen,gocyclo // to refactor
+func (s *service) ciba(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest) (*flowv1.TokenResponse, error) {
+	res := &flowv1.TokenResponse{}
+	grant := req.GetCiba()
+
+	// Check parameters
+	if client == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to process with nil client")
+	}
+	if req == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to process with nil request")
+	}
+	if grant == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to process with nil grant")
+	}
+	if types.IsNil(s.backchannelSessions) {
+		res.Error = rfcerrors.UnsupportedGrantType().Build()
+		return res, fmt.Errorf("backchannel authentication is not enabled")
+	}
+
+	// Check issuer syntax
+	if req.Issuer == "" {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("issuer must not be blank")
+	}
+
+	_, err := url.ParseRequestURI(req.Issuer)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("issuer must be a valid url: %w", err)
+	}
+
+	// Validate client capabilities
+	if !types.StringArray(client.GrantTypes).Contains(oidc.GrantTypeCIBA) {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeCIBA)
+	}
+
+	// Validate auth_req_id
+	if grant.AuthReqId == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("auth_req_id must not be blank")
+	}
+
+	// Resolve authentication request
+	session, err := s.backchannelSessions.Get(ctx, req.Issuer, grant.AuthReqId)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidGrant().Build()
+		}
+		return res, fmt.Errorf("unable to retrieve authentication request: %w", err)
+	}
+
+	// Check session
+	if session == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("retrieved nil session for '%s'", grant.AuthReqId)
+	}
+	if session.Request == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("session has nil request for '%s'", grant.AuthReqId)
+	}
+	if session.Client == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("session has nil client for '%s'", grant.AuthReqId)
+	}
+
+	// Check client match
+	if session.Client.ClientId != client.ClientId {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return res, fmt.Errorf("client does not match")
+	}
+
+	// Check expiration
+	now := uint64(timeFunc().Unix())
+	if session.ExpiresAt < now {
+		res.Error = rfcerrors.ExpiredToken().Build()
+		return res, fmt.Errorf("auth_req_id '%s' is expired", grant.AuthReqId)
+	}
+
+	switch session.Status {
+	case sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING:
+		// Enforce polling interval
+		tooFast := slowDown(&session.LastPolledAt, &session.Interval, now)
+
+		// Update ephemeral storage
+		if err := s.backchannelSessions.Update(ctx, req.Issuer, grant.AuthReqId, session); err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to update authentication request '%s': %w", grant.AuthReqId, err)
+		}
+
+		if tooFast {
+			res.Error = rfcerrors.Slowdown().Build()
+			return res, fmt.Errorf("auth_req_id '%s' is polled too frequently", grant.AuthReqId)
+		}
+
+		res.Error = rfcerrors.AuthorizationPending().Build()
+		return res, fmt.Errorf("auth_req_id '%s' is waiting for authorization", grant.AuthReqId)
+	case sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_DENIED:
+		// Consume the authentication request
+		if _, err := s.consumeBackchannelSession(ctx, req.Issuer, grant.AuthReqId, res); err != nil {
+			return res, err
+		}
+
+		res.Error = rfcerrors.AccessDenied().Build()
+		return res, fmt.Errorf("auth_req_id '%s' has been denied", grant.AuthReqId)
+	case sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED:
+	default:
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return res, fmt.Errorf("auth_req_id '%s' is invalid", grant.AuthReqId)
+	}
+
+	// Consume the authentication request, only one caller can redeem it
+	session, err = s.consumeBackchannelSession(ctx, req.Issuer, grant.AuthReqId, res)
+	if err != nil {
+		return res, err
+	}
+
+	// Check subject attribute
+	if session.Request == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("session has nil request for '%s'", grant.AuthReqId)
+	}
+	if session.Subject == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("session has no subject for '%s'", grant.AuthReqId)
+	}
+
+	// Requested resources must be a subset of the authorized ones
+	targets, err := s.requestedTargets(ctx, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested resources: %w", err)
+	}
+	sessionAudience := session.GetAudience()
+	granted := resourceindicator.Granted(sessionAudience, session.Request.Resource)
+	audience, err := accessTokenTarget(targets, granted, sessionAudience, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token resource: %w", err)
+	}
+
+	// Prepare token
+	tm := &tokenv1.TokenMeta{
+		Issuer:    req.Issuer,
+		Subject:   *session.Subject,
+		Audience:  audience,
+		Scope:     session.GetScope(),
+		Acr:       session.Acr,
+		AuthTime:  session.AuthTime,
+		Resources: granted,
+	}
+
+	// All tokens issued from this authentication request belong to the same family
+	grantID := newGrantID()
+
+	// Generate access token
+	at, err := s.generateAccessToken(ctx, client, req.GrantType, tm, req.TokenConfirmation, grantID, "", nil)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to generate access token: %w", err)
+	}
+
+	// Assign response
+	res.AccessToken = at
+
+	// Validate scopes
+	scopes := types.StringArray(strings.Fields(tm.Scope))
+
+	// Check if request has offline_access to generate refresh_token
+	if scopes.Contains(oidc.ScopeOfflineAccess) {
+		// Generate refresh token
+		rt, err := s.generateRefreshToken(ctx, client, req.GrantType, tm, at.Confirmation, grantID, "", 0)
+		if err != nil {
+			res.AccessToken = nil
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to generate refresh token: %w", err)
+		}
+
+		// Assign response
+		res.RefreshToken = rt
+	}
+
+	// Check if request has openid to generate id_token
+	if scopes.Contains(oidc.ScopeOpenID) {
+		// Generate id token
+		idt, err := s.generateIDToken(ctx, client, req.GrantType, tm, at, grantID)
+		if err != nil {
+			res.AccessToken = nil
+			res.RefreshToken = nil
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to generate id token: %w", err)
+		}
+
+		// Assign response
+		res.IdToken = idt
+	}
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+// consumeBackchannelSession atomically removes the authentication request, a
+// concurrent redemption is handled as an invalid grant.
+func (s *service) consumeBackchannelSession(ctx context.Context, issuer, authReqID string, res *flowv1.TokenResponse) (*sessionv1.BackchannelAuthenticationSession, error) {
+	session, err := s.backchannelSessions.Consume(ctx, issuer, authReqID)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidGrant().Build()
+		}
+		return nil, fmt.Errorf("unable to consume authentication request '%s': %w", authReqID, err)
+	}
+
+	// No error
+	return session, nil
+}
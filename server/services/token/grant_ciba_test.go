@@ -0,0 +1,526 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
+	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+	storagemock "zntr.io/solid/server/storage/mock"
+)
+
+func Test_service_ciba(t *testing.T) {
+	cibaClient := func() *clientv1.Client {
+		return &clientv1.Client{
+			GrantTypes: []string{oidc.GrantTypeCIBA},
+			ClientId:   "s6BhdRkqt3",
+		}
+	}
+	cibaRequest := func() *flowv1.TokenRequest {
+		return &flowv1.TokenRequest{
+			Issuer:    "http://127.0.0.1:8080",
+			GrantType: oidc.GrantTypeCIBA,
+			Grant: &flowv1.TokenRequest_Ciba{
+				Ciba: &flowv1.GrantCIBA{
+					AuthReqId: "1c266114-a1be-4252-8ad1-04986c5b9ac1",
+				},
+			},
+		}
+	}
+	cibaSession := func(status sessionv1.BackchannelAuthenticationStatus) *sessionv1.BackchannelAuthenticationSession {
+		return &sessionv1.BackchannelAuthenticationSession{
+			Client: &clientv1.Client{
+				ClientId: "s6BhdRkqt3",
+			},
+			Request:   &flowv1.BackchannelAuthenticationRequest{},
+			ExpiresAt: 200,
+			Interval:  5,
+			Status:    status,
+		}
+	}
+
+	type args struct {
+		ctx    context.Context
+		client *clientv1.Client
+		req    *flowv1.TokenRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockBackchannelAuthenticationSession, *storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator, *tokenmock.MockIDTokenGenerator)
+		want    *flowv1.TokenResponse
+		wantErr bool
+	}{
+		{
+			name: "nil client",
+			args: args{
+				ctx: context.Background(),
+				req: cibaRequest(),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "nil request",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "nil grant",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeCIBA,
+				},
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "invalid issuer",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req: &flowv1.TokenRequest{
+					Issuer:    "foo",
+					GrantType: oidc.GrantTypeCIBA,
+					Grant: &flowv1.TokenRequest_Ciba{
+						Ciba: &flowv1.GrantCIBA{},
+					},
+				},
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "client not support grant_type",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeAuthorizationCode},
+				},
+				req: cibaRequest(),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
+		{
+			name: "blank auth_req_id",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeCIBA,
+					Grant: &flowv1.TokenRequest_Ciba{
+						Ciba: &flowv1.GrantCIBA{},
+					},
+				},
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "session not found",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "session storage error",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "client mismatch",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeCIBA},
+					ClientId:   "attacker",
+				},
+				req: cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED), nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "expired",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(201, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING), nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ExpiredToken().Build(),
+			},
+		},
+		{
+			name: "authorization pending",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING), nil)
+				sessions.EXPECT().Update(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.BackchannelAuthenticationSession) error {
+					if s.LastPolledAt != 10 || s.Interval != 5 {
+						return fmt.Errorf("unexpected polling state %d/%d", s.LastPolledAt, s.Interval)
+					}
+					return nil
+				})
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.AuthorizationPending().Build(),
+			},
+		},
+		{
+			name: "slow down",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(12, 0) }
+				s := cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING)
+				s.LastPolledAt = 10
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+				sessions.EXPECT().Update(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, s *sessionv1.BackchannelAuthenticationSession) error {
+					if s.LastPolledAt != 12 || s.Interval != 10 {
+						return fmt.Errorf("unexpected polling state %d/%d", s.LastPolledAt, s.Interval)
+					}
+					return nil
+				})
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.Slowdown().Build(),
+			},
+		},
+		{
+			name: "pending update error",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING), nil)
+				sessions.EXPECT().Update(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1", gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "access denied",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_DENIED), nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_DENIED), nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.AccessDenied().Build(),
+			},
+		},
+		{
+			name: "already redeemed",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED), nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "consume error",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED), nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "authorized without subject",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED), nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED), nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "access token generation error",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, _ *storagemock.MockToken, at, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				s := cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED)
+				s.Subject = types.StringRef("user1")
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				s := cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED)
+				s.Subject = types.StringRef("user1")
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+						ClientId:  "s6BhdRkqt3",
+						Subject:   "user1",
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - openid offline_access",
+			args: args{
+				ctx:    context.Background(),
+				client: cibaClient(),
+				req:    cibaRequest(),
+			},
+			prepare: func(sessions *storagemock.MockBackchannelAuthenticationSession, tokens *storagemock.MockToken, at, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				s := cibaSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED)
+				s.Subject = types.StringRef("user1")
+				s.Scope = types.StringRef("openid offline_access")
+				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1c266114-a1be-4252-8ad1-04986c5b9ac1").Return(s, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+						ClientId:  "s6BhdRkqt3",
+						Subject:   "user1",
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+				RefreshToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						ClientId:  "s6BhdRkqt3",
+						Subject:   "user1",
+					},
+					Value: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+				},
+				IdToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+						ClientId:  "s6BhdRkqt3",
+						Audience:  "s6BhdRkqt3",
+						Subject:   "user1",
+					},
+					Value: "eyJ.idt.sig",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			defer func() { timeFunc = time.Now }()
+
+			// Arm mocks
+			sessions := storagemock.NewMockBackchannelAuthenticationSession(ctrl)
+			accessTokens := tokenmock.NewMockGenerator(ctrl)
+			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			idTokens := tokenmock.NewMockIDTokenGenerator(ctrl)
+			tokens := storagemock.NewMockToken(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(sessions, tokens, accessTokens, refreshTokens, idTokens)
+			}
+
+			s := &service{
+				backchannelSessions: sessions,
+				tokens:              tokens,
+				accessTokenGen:      accessTokens,
+				lifetimes:           token.DefaultLifetimePolicy(),
+				refreshTokenGen:     refreshTokens,
+				idTokenGen:          idTokens,
+			}
+			got, err := s.ciba(tt.args.ctx, tt.args.client, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.ciba() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.ciba() res = %s", diff)
+			}
+		})
+	}
+}
@@ -76,19 +76,30 @@ func (s *service) clientCredentials(ctx context.Context, client *clientv1.Client
 		return res, fmt.Errorf("client doesn't support 'client_credentials' as grant type")
 	}
 
+	// Validate requested scope
+	scope, err := s.requestedScope(ctx, client, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested scope: %w", err)
+	}
+
 	// Prepare token
 	tokenMeta := &tokenv1.TokenMeta{
 		Issuer: req.Issuer,
+		Scope:  scope,
 	}
-	if req.Scope != nil {
-		tokenMeta.Scope = *req.Scope
+
+	// Resolve targeted resource
+	targets, err := s.requestedTargets(ctx, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested resources: %w", err)
 	}
-	if req.Audience != nil {
-		tokenMeta.Audience = *req.Audience
+	tokenMeta.Audience, err = accessTokenTarget(targets, targets, "", res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token resource: %w", err)
 	}
 
 	// Generate access token
-	at, err := s.generateAccessToken(ctx, client, tokenMeta, req.TokenConfirmation)
+	at, err := s.generateAccessToken(ctx, client, req.GrantType, tokenMeta, req.TokenConfirmation, newGrantID(), "", nil)
 	if err != nil {
 		res.Error = rfcerrors.ServerError().Build()
 		return res, fmt.Errorf("unable to generate access token: %w", err)
@@ -28,10 +28,14 @@ import (
 
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
 	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
 	storagemock "zntr.io/solid/server/storage/mock"
 )
 
@@ -44,7 +48,7 @@ func Test_service_clientCredentials(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockToken, *tokenmock.MockGenerator)
+		prepare func(*storagemock.MockToken, *tokenmock.MockGenerator, *storagemock.MockResourceReader)
 		want    *flowv1.TokenResponse
 		wantErr bool
 	}{
@@ -191,7 +195,7 @@ func Test_service_clientCredentials(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, _ *storagemock.MockResourceReader) {
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -218,7 +222,7 @@ func Test_service_clientCredentials(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, _ *storagemock.MockResourceReader) {
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", nil)
 			},
 			wantErr: true,
@@ -245,7 +249,7 @@ func Test_service_clientCredentials(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, _ *storagemock.MockResourceReader) {
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo"))
 			},
@@ -254,6 +258,32 @@ func Test_service_clientCredentials(t *testing.T) {
 				Error: rfcerrors.ServerError().Build(),
 			},
 		},
+		{
+			name: "scope not allowed for client",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					Scopes:     []string{"read"},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Scope:     types.StringRef("read admin"),
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "valid",
@@ -274,8 +304,266 @@ func Test_service_clientCredentials(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, _ *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - scope",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					Scopes:     []string{"read", "write"},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Scope:     types.StringRef("read  read"),
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, _ *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "read",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - phantom",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType:          clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes:          []string{oidc.GrantTypeClientCredentials},
+					PhantomAccessTokens: true,
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, _ *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *tokenv1.Token) (string, error) {
+					if t.TokenType != tokenv1.TokenType_TOKEN_TYPE_PHANTOM_TOKEN {
+						return "", fmt.Errorf("unexpected token type %v", t.TokenType)
+					}
+					return "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil
+				})
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					Value: "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - client lifetime",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType:          clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes:          []string{oidc.GrantTypeClientCredentials},
+					AccessTokenLifetime: types.UInt64Ref(300),
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, _ *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 301,
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "unknown audience",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Audience:  types.StringRef("urn:example:unknown"),
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:unknown").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		{
+			name: "resource registry error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Resource:  []string{"urn:example:backend-api"},
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(nil, fmt.Errorf("test"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "multiple resources",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Audience:  types.StringRef("urn:example:cooperation-context"),
+					Resource:  []string{"urn:example:backend-api"},
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:cooperation-context").Return(&resourcev1.Resource{Urn: "urn:example:cooperation-context"}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{Urn: "urn:example:backend-api"}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		{
+			name: "valid - resource",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Resource:  []string{"urn:example:backend-api"},
+					Grant: &flowv1.TokenRequest_ClientCredentials{
+						ClientCredentials: &flowv1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{Urn: "urn:example:backend-api"}, nil).Times(2)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 			},
@@ -287,6 +575,7 @@ func Test_service_clientCredentials(t *testing.T) {
 					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
 					Metadata: &tokenv1.TokenMeta{
 						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "urn:example:backend-api",
 						IssuedAt:  1,
 						NotBefore: 2,
 						ExpiresAt: 3601,
@@ -304,15 +593,19 @@ func Test_service_clientCredentials(t *testing.T) {
 			// Arm mocks
 			accessTokens := tokenmock.NewMockGenerator(ctrl)
 			tokens := storagemock.NewMockToken(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(tokens, accessTokens)
+				tt.prepare(tokens, accessTokens, resources)
 			}
 
 			s := &service{
-				tokens:         tokens,
-				accessTokenGen: accessTokens,
+				tokens:          tokens,
+				accessTokenGen:  accessTokens,
+				phantomTokenGen: accessTokens,
+				lifetimes:       token.DefaultLifetimePolicy(),
+				resources:       resources,
 			}
 			got, err := s.clientCredentials(tt.args.ctx, tt.args.client, tt.args.req)
 			if (err != nil) != tt.wantErr {
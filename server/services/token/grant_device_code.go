@@ -19,6 +19,7 @@ package token
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
 	"zntr.io/solid/server/storage"
 )
 
@@ -108,40 +110,87 @@ func (s *service) deviceCode(ctx context.Context, client *clientv1.Client, req *
 	}
 
 	// Check expiration
-	if session.ExpiresAt < uint64(timeFunc().Unix()) {
+	now := uint64(timeFunc().Unix())
+	if session.ExpiresAt < now {
 		res.Error = rfcerrors.TokenExpired().Build()
 		return res, fmt.Errorf("token '%s' is expired", grant.DeviceCode)
 	}
 
 	// Check if it's validated
-	if session.Status == sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING {
+	switch session.Status {
+	case sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING:
+		// Enforce polling interval
+		tooFast := slowDown(&session.LastPolledAt, &session.Interval, now)
+
+		// Record the poll, a decision recorded meanwhile is returned on the
+		// next poll
+		if err := s.deviceCodeSessions.RecordPoll(ctx, req.Issuer, grant.DeviceCode, session.LastPolledAt, session.Interval); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to update device code session '%s': %w", grant.DeviceCode, err)
+		}
+
+		if tooFast {
+			res.Error = rfcerrors.Slowdown().Build()
+			return res, fmt.Errorf("token '%s' is polled too frequently", grant.DeviceCode)
+		}
+
 		res.Error = rfcerrors.AuthorizationPending().Build()
 		return res, fmt.Errorf("token '%s' is waiting for authorization", grant.DeviceCode)
-	}
-
-	// Check token state
-	if session.Status != sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED {
+	case sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_DENIED:
+		res.Error = rfcerrors.AccessDenied().Build()
+		return res, fmt.Errorf("token '%s' has been denied", grant.DeviceCode)
+	case sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED:
+	default:
+		// Check token state
 		res.Error = rfcerrors.InvalidToken().Build()
 		return res, fmt.Errorf("token '%s' is invalid", grant.DeviceCode)
 	}
 
+	// Consume the device code, only one caller can redeem it
+	session, err = s.consumeDeviceCodeSession(ctx, req.Issuer, grant.DeviceCode, res)
+	if err != nil {
+		return res, err
+	}
+
 	// Check subject attribute
 	if session.Subject == nil {
 		res.Error = rfcerrors.ServerError().Build()
 		return res, fmt.Errorf("session has no subject for '%s'", grant.DeviceCode)
 	}
 
+	// Requested resources must be a subset of the authorized ones
+	targets, err := s.requestedTargets(ctx, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested resources: %w", err)
+	}
+	var sessionAudience string
+	if session.Audience != nil {
+		sessionAudience = *session.Audience
+	}
+	granted := resourceindicator.Granted(sessionAudience, session.Request.Resource)
+	audience, err := accessTokenTarget(targets, granted, sessionAudience, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token resource: %w", err)
+	}
+
 	// Prepare token
 	tm := &tokenv1.TokenMeta{
-		Issuer:  req.Issuer,
-		Subject: *session.Subject,
+		Issuer:    req.Issuer,
+		Subject:   *session.Subject,
+		Audience:  audience,
+		Acr:       session.Acr,
+		AuthTime:  session.AuthTime,
+		Resources: granted,
 	}
 	if session.Scope != nil {
 		tm.Scope = *session.Scope
 	}
 
+	// All tokens issued from this device code belong to the same family
+	grantID := newGrantID()
+
 	// Generate access token
-	at, err := s.generateAccessToken(ctx, client, tm, req.TokenConfirmation)
+	at, err := s.generateAccessToken(ctx, client, req.GrantType, tm, req.TokenConfirmation, grantID, "", nil)
 	if err != nil {
 		res.Error = rfcerrors.ServerError().Build()
 		return res, fmt.Errorf("unable to generate access token: %w", err)
@@ -158,7 +207,7 @@ func (s *service) deviceCode(ctx context.Context, client *clientv1.Client, req *
 		// Check if request has offline_access to generate refresh_token
 		if scopes.Contains(oidc.ScopeOfflineAccess) {
 			// Generate refresh token
-			rt, err := s.generateRefreshToken(ctx, client, tm, at.Confirmation)
+			rt, err := s.generateRefreshToken(ctx, client, req.GrantType, tm, at.Confirmation, grantID, "", 0)
 			if err != nil {
 				res.AccessToken = nil
 				res.Error = rfcerrors.ServerError().Build()
@@ -168,8 +217,40 @@ func (s *service) deviceCode(ctx context.Context, client *clientv1.Client, req *
 			// Assign response
 			res.RefreshToken = rt
 		}
+
+		// Check if request has openid to generate id_token
+		if scopes.Contains(oidc.ScopeOpenID) {
+			// Generate id token
+			idt, err := s.generateIDToken(ctx, client, req.GrantType, tm, at, grantID)
+			if err != nil {
+				res.AccessToken = nil
+				res.RefreshToken = nil
+				res.Error = rfcerrors.ServerError().Build()
+				return res, fmt.Errorf("unable to generate id token: %w", err)
+			}
+
+			// Assign response
+			res.IdToken = idt
+		}
 	}
 
 	// No error
 	return res, nil
 }
+
+// consumeDeviceCodeSession atomically removes the device code session, a
+// concurrent redemption is handled as an invalid grant.
+func (s *service) consumeDeviceCodeSession(ctx context.Context, issuer, deviceCode string, res *flowv1.TokenResponse) (*sessionv1.DeviceCodeSession, error) {
+	session, err := s.deviceCodeSessions.Consume(ctx, issuer, deviceCode)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidGrant().Build()
+		}
+		return nil, fmt.Errorf("unable to consume device code '%s': %w", deviceCode, err)
+	}
+
+	// No error
+	return session, nil
+}
@@ -32,6 +32,7 @@ import (
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
 	tokenmock "zntr.io/solid/sdk/token/mock"
 	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/storage"
@@ -47,7 +48,7 @@ func Test_service_deviceCode(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockDeviceCodeSession, *storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator)
+		prepare func(*storagemock.MockDeviceCodeSession, *storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator, *tokenmock.MockIDTokenGenerator)
 		want    *flowv1.TokenResponse
 		wantErr bool
 	}{
@@ -198,7 +199,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
@@ -227,7 +228,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -256,7 +257,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(nil, nil)
 			},
 			wantErr: true,
@@ -285,7 +286,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{}, nil)
 			},
 			wantErr: true,
@@ -314,7 +315,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
 					Request: &flowv1.DeviceAuthorizationRequest{},
 				}, nil)
@@ -345,7 +346,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
 						ClientId: "s6BhdRkqt3",
@@ -379,7 +380,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(10, 0) }
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
@@ -415,7 +416,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(10, 0) }
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
@@ -427,12 +428,249 @@ func Test_service_deviceCode(t *testing.T) {
 					ExpiresAt: 200,
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
 				}, nil)
+				sessions.EXPECT().RecordPoll(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", uint64(10), uint64(5)).Return(nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
 				Error: rfcerrors.AuthorizationPending().Build(),
 			},
 		},
+		{
+			name: "authorization pending - interval respected",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(15, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt:    200,
+					Status:       sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
+					LastPolledAt: 10,
+					Interval:     5,
+				}, nil)
+				sessions.EXPECT().RecordPoll(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", uint64(15), uint64(5)).Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.AuthorizationPending().Build(),
+			},
+		},
+		{
+			name: "slow down",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(12, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt:    200,
+					Status:       sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
+					LastPolledAt: 10,
+					Interval:     5,
+				}, nil)
+				sessions.EXPECT().RecordPoll(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", uint64(12), uint64(10)).Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.Slowdown().Build(),
+			},
+		},
+		{
+			name: "slow down - escalated interval",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(30, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt:    200,
+					Status:       sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
+					LastPolledAt: 25,
+					Interval:     10,
+				}, nil)
+				sessions.EXPECT().RecordPoll(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", uint64(30), uint64(15)).Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.Slowdown().Build(),
+			},
+		},
+		{
+			name: "polling state update error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt: 200,
+					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
+				}, nil)
+				sessions.EXPECT().RecordPoll(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", uint64(10), uint64(5)).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "confirmation pending",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt:        200,
+					Status:           sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING,
+					ConfirmationCode: "bQ6ZeYWkmEZXhUj3",
+					Subject:          types.StringRef("user1"),
+				}, nil)
+				sessions.EXPECT().RecordPoll(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", uint64(10), uint64(5)).Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.AuthorizationPending().Build(),
+			},
+		},
+		{
+			name: "access denied",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt: 200,
+					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_DENIED,
+					Subject:   types.StringRef("user1"),
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.AccessDenied().Build(),
+			},
+		},
 		{
 			name: "session invalid status",
 			args: args{
@@ -452,7 +690,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(10, 0) }
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
@@ -471,7 +709,46 @@ func Test_service_deviceCode(t *testing.T) {
 			},
 		},
 		{
-			name: "session validated with no subject error",
+			name: "decision recorded while polling",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt: 200,
+					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
+					Subject:   types.StringRef("user1"),
+				}, nil)
+				sessions.EXPECT().RecordPoll(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", uint64(10), uint64(5)).Return(storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.AuthorizationPending().Build(),
+			},
+		},
+		{
+			name: "device code already redeemed",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -489,7 +766,7 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(10, 0) }
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
@@ -500,8 +777,88 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 					ExpiresAt: 200,
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
-					Subject:   nil,
+					Subject:   types.StringRef("user1"),
 				}, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "device code consume error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt: 200,
+					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
+					Subject:   types.StringRef("user1"),
+				}, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "session validated with no subject error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				session := &sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt: 200,
+					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
+					Subject:   nil,
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
@@ -527,9 +884,9 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(10, 0) }
-				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+				session := &sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
 						ClientId: "s6BhdRkqt3",
 					},
@@ -539,7 +896,9 @@ func Test_service_deviceCode(t *testing.T) {
 					ExpiresAt: 200,
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
 					Subject:   types.StringRef("user-1"),
-				}, nil)
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -566,9 +925,9 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(10, 0) }
-				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+				session := &sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
 						ClientId: "s6BhdRkqt3",
 					},
@@ -578,7 +937,9 @@ func Test_service_deviceCode(t *testing.T) {
 					ExpiresAt: 200,
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
 					Subject:   types.StringRef("user1"),
-				}, nil)
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo"))
 			},
@@ -607,9 +968,9 @@ func Test_service_deviceCode(t *testing.T) {
 					Scope: types.StringRef(oidc.ScopeOfflineAccess),
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
-				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+				session := &sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
 						ClientId: "s6BhdRkqt3",
 					},
@@ -621,7 +982,9 @@ func Test_service_deviceCode(t *testing.T) {
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
 					Subject:   types.StringRef("user1"),
 					Scope:     types.StringRef("offline_access"),
-				}, nil)
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
@@ -632,6 +995,56 @@ func Test_service_deviceCode(t *testing.T) {
 				Error: rfcerrors.ServerError().Build(),
 			},
 		},
+		{
+			name: "id token generation error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+					Scope: types.StringRef("openid offline_access"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				session := &sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+						Scope:    types.StringRef("openid offline_access"),
+					},
+					ExpiresAt: 200,
+					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
+					Subject:   types.StringRef("user1"),
+					Scope:     types.StringRef("openid offline_access"),
+					AuthTime:  types.UInt64Ref(1),
+					Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "valid",
@@ -652,9 +1065,9 @@ func Test_service_deviceCode(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
-				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+				session := &sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
 						ClientId: "s6BhdRkqt3",
 					},
@@ -664,7 +1077,9 @@ func Test_service_deviceCode(t *testing.T) {
 					ExpiresAt: 200,
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
 					Subject:   types.StringRef("user1"),
-				}, nil)
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 			},
@@ -706,9 +1121,9 @@ func Test_service_deviceCode(t *testing.T) {
 					Scope: types.StringRef(oidc.ScopeOfflineAccess),
 				},
 			},
-			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
-				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+				session := &sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
 						ClientId: "s6BhdRkqt3",
 					},
@@ -720,7 +1135,9 @@ func Test_service_deviceCode(t *testing.T) {
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
 					Subject:   types.StringRef("user1"),
 					Scope:     types.StringRef("offline_access"),
-				}, nil)
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
@@ -759,6 +1176,105 @@ func Test_service_deviceCode(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid - openid",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:   "s6BhdRkqt3",
+				},
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &flowv1.TokenRequest_DeviceCode{
+						DeviceCode: &flowv1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+					Scope: types.StringRef("openid offline_access"),
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				session := &sessionv1.DeviceCodeSession{
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &flowv1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+						Scope:    types.StringRef("openid offline_access"),
+					},
+					ExpiresAt: 200,
+					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
+					Subject:   types.StringRef("user1"),
+					Scope:     types.StringRef("openid offline_access"),
+					AuthTime:  types.UInt64Ref(1),
+					Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Error: nil,
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+						ClientId:  "s6BhdRkqt3",
+						Subject:   "user1",
+						Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+						AuthTime:  types.UInt64Ref(1),
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+				RefreshToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						ClientId:  "s6BhdRkqt3",
+						Subject:   "user1",
+						Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+						AuthTime:  types.UInt64Ref(1),
+					},
+					Value: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+				},
+				IdToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+						ClientId:  "s6BhdRkqt3",
+						Audience:  "s6BhdRkqt3",
+						Subject:   "user1",
+						Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+						AuthTime:  types.UInt64Ref(1),
+					},
+					Value: "eyJ.idt.sig",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -769,18 +1285,21 @@ func Test_service_deviceCode(t *testing.T) {
 			sessions := storagemock.NewMockDeviceCodeSession(ctrl)
 			accessTokens := tokenmock.NewMockGenerator(ctrl)
 			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			idTokens := tokenmock.NewMockIDTokenGenerator(ctrl)
 			tokens := storagemock.NewMockToken(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(sessions, tokens, accessTokens, refreshTokens)
+				tt.prepare(sessions, tokens, accessTokens, refreshTokens, idTokens)
 			}
 
 			s := &service{
 				deviceCodeSessions: sessions,
 				tokens:             tokens,
 				accessTokenGen:     accessTokens,
+				lifetimes:          token.DefaultLifetimePolicy(),
 				refreshTokenGen:    refreshTokens,
+				idTokenGen:         idTokens,
 			}
 			got, err := s.deviceCode(tt.args.ctx, tt.args.client, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,282 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/go-jose/go-jose/v4"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/jwk"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+)
+
+// jwtBearerSignatureAlgorithms lists algorithms accepted for client signed
+// assertions.
+var jwtBearerSignatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.EdDSA,
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.PS256, jose.PS384, jose.PS512,
+}
+
+// jwtBearerClaims describes authorization grant assertion claims.
+// https://www.rfc-editor.org/rfc/rfc7523#section-3
+type jwtBearerClaims struct {
+	Iss string           `json:"iss"`
+	Sub string           `json:"sub"`
+	Aud josejwt.Audience `json:"aud"`
+	Exp uint64           `json:"exp"`
+	Nbf uint64           `json:"nbf"`
+	Iat uint64           `json:"iat"`
+	JTI string           `json:"jti"`
+}
+
+func (s *service) jwtBearer(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest) (*flowv1.TokenResponse, error) {
+	res := &flowv1.TokenResponse{}
+
+	// Check parameters
+	if client == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to process with nil client")
+	}
+	if req == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to process with nil request")
+	}
+
+	grant := req.GetJwtBearer()
+	if grant == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to process with nil grant")
+	}
+	if types.IsNil(s.assertionJTIs) {
+		res.Error = rfcerrors.UnsupportedGrantType().Build()
+		return res, fmt.Errorf("jwt bearer assertions are not enabled")
+	}
+
+	// Check issuer syntax
+	if req.Issuer == "" {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("issuer must not be blank")
+	}
+
+	_, err := url.ParseRequestURI(req.Issuer)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("issuer must be a valid url: %w", err)
+	}
+
+	// Check assertion
+	if grant.Assertion == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("assertion must not be empty")
+	}
+
+	// Validate client capabilities
+	if !types.StringArray(client.GrantTypes).Contains(oidc.GrantTypeJWTBearer) {
+		res.Error = rfcerrors.UnsupportedGrantType().Build()
+		return res, fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeJWTBearer)
+	}
+
+	// Decode assertion without validation first
+	rawAssertion, err := jose.ParseSigned(grant.Assertion, jwtBearerSignatureAlgorithms)
+	if err != nil {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return res, fmt.Errorf("assertion is syntaxically invalid: %w", err)
+	}
+
+	var claims jwtBearerClaims
+	if errDecode := json.Unmarshal(rawAssertion.UnsafePayloadWithoutVerification(), &claims); errDecode != nil {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return res, fmt.Errorf("unable to decode assertion claims: %w", errDecode)
+	}
+
+	// Verify assertion according to its issuer
+	var st *tokenv1.Token
+	switch {
+	case claims.Iss != "" && claims.Iss == client.ClientId:
+		st, err = s.jwtBearerClientAssertion(ctx, client, req, rawAssertion, &claims, res)
+	case !types.IsNil(s.trustedIssuers):
+		st, err = s.jwtBearerExternalAssertion(ctx, client, req, grant.Assertion, res)
+	default:
+		res.Error = rfcerrors.InvalidGrant().Build()
+		err = fmt.Errorf("assertion issuer '%s' is not trusted", claims.Iss)
+	}
+	if err != nil {
+		return res, fmt.Errorf("unable to validate assertion: %w", err)
+	}
+
+	// Prevent assertion replay
+	if err := s.assertionJTIs.Use(ctx, st.Metadata.Issuer, st.TokenId, st.Metadata.ExpiresAt); err != nil {
+		if !errors.Is(err, storage.ErrAlreadyUsed) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidGrant().Build()
+		}
+		return res, fmt.Errorf("unable to register assertion identifier: %w", err)
+	}
+
+	// Prepare token
+	tokenMeta := &tokenv1.TokenMeta{
+		Issuer:  req.Issuer,
+		Subject: st.Metadata.Subject,
+		Scope:   st.Metadata.Scope,
+	}
+
+	// Resolve targeted resource
+	targets, err := s.requestedTargets(ctx, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested resources: %w", err)
+	}
+	tokenMeta.Audience, err = accessTokenTarget(targets, targets, "", res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token resource: %w", err)
+	}
+
+	// Generate access token
+	at, err := s.generateAccessToken(ctx, client, req.GrantType, tokenMeta, req.TokenConfirmation, newGrantID(), "", nil)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to generate access token: %w", err)
+	}
+
+	// Assign response
+	res.AccessToken = at
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+// jwtBearerClientAssertion validates an assertion signed by the client itself
+// with one of its registered keys. A client can only assert its own identity,
+// acting on behalf of a user requires an assertion from a trusted issuer.
+func (s *service) jwtBearerClientAssertion(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest, rawAssertion *jose.JSONWebSignature, claims *jwtBearerClaims, res *flowv1.TokenResponse) (*tokenv1.Token, error) {
+	// Retrieve JWK associated to the client
+	if len(client.Jwks) == 0 {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("client jwks is nil")
+	}
+
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(client.Jwks, &jwks); err != nil {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("client jwks is invalid: %w", err)
+	}
+
+	// Try to validate assertion with one of keys
+	if err := jwk.ValidateSignature(&jwks, rawAssertion); err != nil {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion signature is invalid: %w", err)
+	}
+
+	// Check claims
+	now := uint64(timeFunc().Unix())
+	switch {
+	case claims.Sub == "":
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion subject must not be blank")
+	case claims.Sub != client.ClientId:
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("self-signed assertion subject '%s' must be the client identifier", claims.Sub)
+	case !claims.Aud.Contains(req.Issuer):
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion audience must contain '%s'", req.Issuer)
+	case claims.Exp == 0:
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion expiration is mandatory")
+	case claims.Exp < now:
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion is expired")
+	case claims.Nbf > now:
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion is not yet valid")
+	case claims.JTI == "":
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion identifier is mandatory")
+	default:
+	}
+
+	// Validate requested scope
+	scope, err := s.requestedScope(ctx, client, req, res)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate requested scope: %w", err)
+	}
+
+	// No error
+	return &tokenv1.Token{
+		TokenId: claims.JTI,
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    claims.Iss,
+			Subject:   claims.Sub,
+			ExpiresAt: claims.Exp,
+			Scope:     scope,
+		},
+	}, nil
+}
+
+// jwtBearerExternalAssertion validates an assertion signed by a trusted
+// external issuer. The requested scope must be a subset of the mapped scopes.
+func (s *service) jwtBearerExternalAssertion(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest, raw string, res *flowv1.TokenResponse) (*tokenv1.Token, error) {
+	// Verify with trusted issuer settings
+	a, err := s.trustedIssuers.Verify(ctx, raw)
+	if err != nil {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("unable to verify external assertion: %w", err)
+	}
+
+	// Check claims
+	if a.JTI == "" {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return nil, fmt.Errorf("assertion identifier is mandatory")
+	}
+
+	// Check requested scope, only downscoping is allowed
+	scope, err := s.narrowedScope(ctx, client, a.Scope, req, res)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate requested scope: %w", err)
+	}
+	if scope == "" {
+		res.Error = rfcerrors.InvalidScope().Build()
+		return nil, fmt.Errorf("external assertion from '%s' doesn't grant any scope", a.Issuer)
+	}
+
+	// No error
+	return &tokenv1.Token{
+		TokenId: a.JTI,
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    a.Issuer,
+			Subject:   a.Subject,
+			ExpiresAt: a.ExpiresAt,
+			Scope:     scope,
+		},
+	}, nil
+}
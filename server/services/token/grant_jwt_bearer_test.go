@@ -0,0 +1,526 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
+	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+	storagemock "zntr.io/solid/server/storage/mock"
+	"zntr.io/solid/server/trust"
+	trustmock "zntr.io/solid/server/trust/mock"
+)
+
+func mustJWTBearerKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return pk
+}
+
+func mustJWTBearerAssertion(t *testing.T, pk *ecdsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.ES256,
+		Key:       pk,
+	}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatalf("unable to prepare signer: %v", err)
+	}
+
+	raw, err := josejwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		t.Fatalf("unable to sign assertion: %v", err)
+	}
+
+	return raw
+}
+
+func Test_service_jwtBearer(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		client *clientv1.Client
+		req    *flowv1.TokenRequest
+	}
+
+	pk := mustJWTBearerKey(t)
+	otherPk := mustJWTBearerKey(t)
+
+	jwks, err := json.Marshal(&jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: pk.Public(), Use: "sig", Algorithm: string(jose.ES256)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to encode client jwks: %v", err)
+	}
+
+	client := &clientv1.Client{
+		ClientId:   "s6BhdRkqt3",
+		ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+		GrantTypes: []string{oidc.GrantTypeJWTBearer},
+		Jwks:       jwks,
+	}
+	restrictedClient := &clientv1.Client{
+		ClientId:   "s6BhdRkqt3",
+		ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+		GrantTypes: []string{oidc.GrantTypeJWTBearer},
+		Jwks:       jwks,
+		Scopes:     []string{"profile"},
+	}
+	request := func(assertion string, scope *string) *flowv1.TokenRequest {
+		return &flowv1.TokenRequest{
+			Issuer:    "http://127.0.0.1:8080",
+			GrantType: oidc.GrantTypeJWTBearer,
+			Scope:     scope,
+			Grant: &flowv1.TokenRequest_JwtBearer{
+				JwtBearer: &flowv1.GrantJWTBearer{
+					Assertion: assertion,
+				},
+			},
+		}
+	}
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss": "s6BhdRkqt3",
+			"sub": "s6BhdRkqt3",
+			"aud": "http://127.0.0.1:8080",
+			"exp": 3601,
+			"jti": "YjAwYzE3MzQtNTgyMS00",
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+	clientAssertion := mustJWTBearerAssertion(t, pk, claims(nil))
+	externalAssertion := mustJWTBearerAssertion(t, otherPk, claims(map[string]any{
+		"iss": "https://partner.example.com",
+	}))
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockToken, *tokenmock.MockGenerator, *storagemock.MockAssertionJTI, *trustmock.MockRegistry)
+		want    *flowv1.TokenResponse
+		wantErr bool
+	}{
+		{
+			name: "nil client",
+			args: args{
+				ctx: context.Background(),
+				req: request(clientAssertion, nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "nil request",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "nil grant",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req: &flowv1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeJWTBearer,
+				},
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "blank assertion",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request("", nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "client not allowed to use grant type",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				},
+				req: request(clientAssertion, nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.UnsupportedGrantType().Build(),
+			},
+		},
+		{
+			name: "malformed assertion",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request("not-a-jwt", nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: invalid signature",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(mustJWTBearerAssertion(t, otherPk, claims(nil)), nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: missing subject",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(mustJWTBearerAssertion(t, pk, claims(map[string]any{"sub": nil})), nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: arbitrary subject",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(mustJWTBearerAssertion(t, pk, claims(map[string]any{"sub": "user@example.com"})), nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: audience mismatch",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(mustJWTBearerAssertion(t, pk, claims(map[string]any{"aud": "https://other.example.com"})), nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: expired",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(clientAssertion, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(3602, 0) }
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: missing jti",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(mustJWTBearerAssertion(t, pk, claims(map[string]any{"jti": nil})), nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: replayed assertion",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(clientAssertion, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, jtis *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				jtis.EXPECT().Use(gomock.Any(), "s6BhdRkqt3", "YjAwYzE3MzQtNTgyMS00", uint64(3601)).Return(storage.ErrAlreadyUsed)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: replay storage error",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(clientAssertion, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, jtis *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				jtis.EXPECT().Use(gomock.Any(), "s6BhdRkqt3", "YjAwYzE3MzQtNTgyMS00", uint64(3601)).Return(errors.New("test"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "external: untrusted issuer",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(externalAssertion, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, registry *trustmock.MockRegistry) {
+				registry.EXPECT().Verify(gomock.Any(), externalAssertion).Return(nil, fmt.Errorf("test: %w", trust.ErrUntrustedIssuer))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "external: missing jti",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(externalAssertion, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, registry *trustmock.MockRegistry) {
+				registry.EXPECT().Verify(gomock.Any(), externalAssertion).Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile",
+					ExpiresAt: 3601,
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "client: scope not allowed for client",
+			args: args{
+				ctx:    context.Background(),
+				client: restrictedClient,
+				req:    request(clientAssertion, types.StringRef("openid")),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "external: scope escalation",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(externalAssertion, types.StringRef("profile admin")),
+			},
+			prepare: func(_ *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *storagemock.MockAssertionJTI, registry *trustmock.MockRegistry) {
+				registry.EXPECT().Verify(gomock.Any(), externalAssertion).Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile",
+					JTI:       "YjAwYzE3MzQtNTgyMS00",
+					ExpiresAt: 3601,
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid - client assertion",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(clientAssertion, types.StringRef("openid")),
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, jtis *storagemock.MockAssertionJTI, _ *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				jtis.EXPECT().Use(gomock.Any(), "s6BhdRkqt3", "YjAwYzE3MzQtNTgyMS00", uint64(3601)).Return(nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "s6BhdRkqt3",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - external assertion",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(externalAssertion, types.StringRef("profile")),
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, jtis *storagemock.MockAssertionJTI, registry *trustmock.MockRegistry) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				registry.EXPECT().Verify(gomock.Any(), externalAssertion).Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile admin",
+					JTI:       "YjAwYzE3MzQtNTgyMS00",
+					ExpiresAt: 3601,
+				}, nil)
+				jtis.EXPECT().Use(gomock.Any(), "https://partner.example.com", "YjAwYzE3MzQtNTgyMS00", uint64(3601)).Return(nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "partner|jdoe",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "profile",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			accessTokens := tokenmock.NewMockGenerator(ctrl)
+			assertionJTIs := storagemock.NewMockAssertionJTI(ctrl)
+			trustedIssuers := trustmock.NewMockRegistry(ctrl)
+			tokens := storagemock.NewMockToken(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(tokens, accessTokens, assertionJTIs, trustedIssuers)
+			}
+
+			s := &service{
+				tokens:         tokens,
+				accessTokenGen: accessTokens,
+				assertionJTIs:  assertionJTIs,
+				trustedIssuers: trustedIssuers,
+				lifetimes:      token.DefaultLifetimePolicy(),
+			}
+			got, err := s.jwtBearer(tt.args.ctx, tt.args.client, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.jwtBearer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.jwtBearer() res = %s", diff)
+			}
+		})
+	}
+}
@@ -19,8 +19,12 @@ package token
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
 
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
@@ -28,6 +32,8 @@ import (
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
 	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
+	"zntr.io/solid/server/scopepolicy"
 	"zntr.io/solid/server/storage"
 )
 
@@ -84,6 +90,11 @@ func (s *service) refreshToken(ctx context.Context, client *clientv1.Client, req
 		return res, fmt.Errorf("unable to retrieve token '%s' from storage: %w", grant.RefreshToken, err)
 	}
 
+	// Revoked refresh token presented again, the whole family is compromised
+	if rt.Status == tokenv1.TokenStatus_TOKEN_STATUS_REVOKED && rt.TokenType == tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN {
+		return s.refreshTokenReuse(ctx, req.Issuer, rt, res)
+	}
+
 	// Check token
 	if rt.Status != tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE {
 		res.Error = rfcerrors.InvalidRequest().Build()
@@ -110,26 +121,88 @@ func (s *service) refreshToken(ctx context.Context, client *clientv1.Client, req
 		return res, fmt.Errorf("only requestor client must use the refresh_token")
 	}
 
+	// Access token can be downscoped to one of the granted resources
+	targets, err := s.requestedTargets(ctx, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested resources: %w", err)
+	}
+	granted := rt.Metadata.Resources
+	if len(granted) == 0 {
+		granted = resourceindicator.Granted(rt.Metadata.Audience, nil)
+	}
+	atMeta := proto.Clone(rt.Metadata).(*tokenv1.TokenMeta)
+	atMeta.Audience, err = accessTokenTarget(targets, granted, rt.Metadata.Audience, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token resource: %w", err)
+	}
+
+	// Access token scope can only be narrowed from the granted one
+	// https://www.rfc-editor.org/rfc/rfc6749#section-6
+	atMeta.Scope, err = scopepolicy.Downscope(rt.Metadata.Scope, req.Scope)
+	if err != nil {
+		res.Error = rfcerrors.InvalidScope().Build()
+		return res, fmt.Errorf("unable to downscope refresh token scope: %w", err)
+	}
+
+	// Access token authorization details can only be narrowed from the granted ones
+	atMeta.AuthorizationDetails, err = accessTokenAuthorizationDetails(rt.Metadata.AuthorizationDetails, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to select access token authorization details: %w", err)
+	}
+
+	// Issued tokens belong to the refresh token family, refresh tokens issued
+	// before token families seed it with their own identifier.
+	grantID := tokenFamily(rt)
+
+	// Rotate on every use unless the client opted for rotation on expiration
+	rotate := true
+	if client.RefreshTokenRotation == clientv1.RefreshTokenRotation_REFRESH_TOKEN_ROTATION_ON_EXPIRATION {
+		lifetime, err := s.tokenLifetime(ctx, tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN, req.GrantType, client, atMeta.Audience)
+		if err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to resolve access token lifetime: %w", err)
+		}
+
+		// If AT expiration is greater than RT expiration
+		rotate = uint64(timeFunc().Add(lifetime).Unix()) > rt.Metadata.ExpiresAt
+	}
+
+	// Consume the refresh token before issuing anything, a concurrent use of
+	// the same refresh token is handled as a reuse.
+	if rotate {
+		if err := s.tokens.Consume(ctx, req.Issuer, rt.TokenId); err != nil {
+			if errors.Is(err, storage.ErrAlreadyUsed) {
+				return s.refreshTokenReuse(ctx, req.Issuer, rt, res)
+			}
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to consume refresh token '%s': %w", rt.TokenId, err)
+		}
+	}
+
 	// Generate access token
-	at, err := s.generateAccessToken(ctx, client, rt.Metadata, rt.Confirmation)
+	at, err := s.generateAccessToken(ctx, client, req.GrantType, atMeta, rt.Confirmation, grantID, rt.TokenId, nil)
 	if err != nil {
 		res.Error = rfcerrors.ServerError().Build()
 		return res, fmt.Errorf("unable to generate access token: %w", err)
 	}
 
-	// If AT expiration is greater than RT expiration
-	if at.Metadata.ExpiresAt > rt.Metadata.ExpiresAt {
-		// Generate new refresh token
-		newRt, err := s.generateRefreshToken(ctx, client, rt.Metadata, at.Confirmation)
+	// Check if refresh token has openid to generate id_token
+	var idt *tokenv1.Token
+	if types.StringArray(strings.Fields(atMeta.Scope)).Contains(oidc.ScopeOpenID) {
+		// Generate id token
+		idt, err = s.generateIDToken(ctx, client, req.GrantType, rt.Metadata, at, grantID)
 		if err != nil {
 			res.Error = rfcerrors.ServerError().Build()
-			return res, fmt.Errorf("unable to generate refresh token: %w", err)
+			return res, fmt.Errorf("unable to generate id token: %w", err)
 		}
+	}
 
-		// Revoke old refresh token
-		if err := s.tokens.Revoke(ctx, req.Issuer, rt.TokenId); err != nil {
+	if rotate {
+		// Generate new refresh token
+		newRt, err := s.generateRefreshToken(ctx, client, req.GrantType, rt.Metadata, at.Confirmation, grantID, rt.TokenId, 0)
+		if err != nil {
 			res.Error = rfcerrors.ServerError().Build()
-			return res, fmt.Errorf("unable to revoke old refresh token '%s': %w", rt.Value, err)
+			return res, fmt.Errorf("unable to generate refresh token: %w", err)
 		}
 
 		// Assign new refresh token
@@ -138,7 +211,38 @@ func (s *service) refreshToken(ctx context.Context, client *clientv1.Client, req
 
 	// Assign access token
 	res.AccessToken = at
+	res.IdToken = idt
+
+	// Assign scope if different
+	if atMeta.Scope != rt.Metadata.Scope {
+		res.Scope = types.StringRef(atMeta.Scope)
+	}
 
 	// No error
 	return res, nil
 }
+
+// -----------------------------------------------------------------------------
+
+// refreshTokenReuse revokes the token family of a refresh token presented
+// after its rotation.
+func (s *service) refreshTokenReuse(ctx context.Context, issuer string, rt *tokenv1.Token, res *flowv1.TokenResponse) (*flowv1.TokenResponse, error) {
+	grantID := tokenFamily(rt)
+	if err := s.tokens.RevokeByGrant(ctx, issuer, grantID); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to revoke token family '%s': %w", grantID, err)
+	}
+
+	res.Error = rfcerrors.InvalidGrant().Build()
+	return res, fmt.Errorf("refresh_token '%s' reuse detected", rt.TokenId)
+}
+
+// tokenFamily returns the token family of the given refresh token. Refresh
+// tokens issued before token families are the root of their own family.
+func tokenFamily(rt *tokenv1.Token) string {
+	if rt.GrantId != "" {
+		return rt.GrantId
+	}
+
+	return rt.TokenId
+}
@@ -28,10 +28,13 @@ import (
 
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
 	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/storage"
 	storagemock "zntr.io/solid/server/storage/mock"
 )
@@ -45,7 +48,7 @@ func Test_service_refreshToken(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator)
+		prepare func(*storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator, *tokenmock.MockIDTokenGenerator, *storagemock.MockResourceReader)
 		want    *flowv1.TokenResponse
 		wantErr bool
 	}{
@@ -196,7 +199,7 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
@@ -224,7 +227,7 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -252,7 +255,7 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
 					TokenId:   "0123456789",
@@ -285,7 +288,7 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
 					TokenId:   "0123456789",
@@ -318,7 +321,7 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
 					TokenId:   "0123456789",
@@ -351,7 +354,7 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
 				timeFunc = func() time.Time { return time.Unix(100, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -393,7 +396,7 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -437,7 +440,8 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -453,6 +457,7 @@ func Test_service_refreshToken(t *testing.T) {
 						ExpiresAt: 604801,
 					},
 				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -480,7 +485,8 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -496,6 +502,7 @@ func Test_service_refreshToken(t *testing.T) {
 						ExpiresAt: 604801,
 					},
 				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", nil)
 			},
 			wantErr: true,
@@ -523,7 +530,8 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -539,6 +547,7 @@ func Test_service_refreshToken(t *testing.T) {
 						ExpiresAt: 604801,
 					},
 				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo"))
 			},
@@ -567,7 +576,8 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -583,8 +593,10 @@ func Test_service_refreshToken(t *testing.T) {
 						NotBefore: 2,
 					},
 				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
 				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(fmt.Errorf("foo")).After(atSave)
 			},
@@ -594,7 +606,7 @@ func Test_service_refreshToken(t *testing.T) {
 			},
 		},
 		{
-			name: "rt revocation error",
+			name: "rt consumption error",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -613,7 +625,8 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -629,20 +642,791 @@ func Test_service_refreshToken(t *testing.T) {
 						NotBefore: 2,
 					},
 				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "concurrent use detected",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					GrantId:   "vRt8cGm5EJpbGXbw",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						ExpiresAt: 2,
+						NotBefore: 2,
+					},
+				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(storage.ErrAlreadyUsed)
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "http://127.0.0.1:8080", "vRt8cGm5EJpbGXbw").Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "reuse detected",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					GrantId:   "vRt8cGm5EJpbGXbw",
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+				}, nil)
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "http://127.0.0.1:8080", "vRt8cGm5EJpbGXbw").Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "reuse detected without family",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+				}, nil)
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "family revocation error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					GrantId:   "vRt8cGm5EJpbGXbw",
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+				}, nil)
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "http://127.0.0.1:8080", "vRt8cGm5EJpbGXbw").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "id token generation error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes:           []string{oidc.GrantTypeRefreshToken},
+					RefreshTokenRotation: clientv1.RefreshTokenRotation_REFRESH_TOKEN_ROTATION_ON_EXPIRATION,
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+					},
+				}, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid - rotation on expiration",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes:           []string{oidc.GrantTypeRefreshToken},
+					RefreshTokenRotation: clientv1.RefreshTokenRotation_REFRESH_TOKEN_ROTATION_ON_EXPIRATION,
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+					},
+				}, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				AccessToken: &tokenv1.Token{
+					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+				},
+				IdToken: &tokenv1.Token{
+					Value:     "eyJ.idt.sig",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+					},
+				},
+			},
+		},
+		{
+			name: "resource not granted",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Resource:  []string{"urn:example:billing-api"},
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH", "urn:example:backend-api"},
+					},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:billing-api").Return(&resourcev1.Resource{Urn: "urn:example:billing-api"}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		{
+			name: "unknown resource",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Resource:  []string{"urn:example:unknown"},
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH", "urn:example:backend-api"},
+					},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:unknown").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		{
+			name: "scope not granted",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Scope:     types.StringRef("profile email"),
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH", "urn:example:backend-api"},
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "valid - downscope to one resource",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Resource:  []string{"urn:example:backend-api"},
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH", "urn:example:backend-api"},
+					},
+				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{Urn: "urn:example:backend-api", AccessTokenLifetime: types.UInt64Ref(300)}, nil).AnyTimes()
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				AccessToken: &tokenv1.Token{
+					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "urn:example:backend-api",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 301,
+					},
+				},
+				RefreshToken: &tokenv1.Token{
+					Value:     "JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr",
+					ParentId:  "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH", "urn:example:backend-api"},
+					},
+				},
+			},
+		},
+		{
+			name: "valid - downscope scope",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Scope:     types.StringRef("profile"),
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH", "urn:example:backend-api"},
+					},
+				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
 				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
-				tokens.EXPECT().Revoke(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(fmt.Errorf("foo"))
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Scope: types.StringRef("profile"),
+				AccessToken: &tokenv1.Token{
+					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+				},
+				RefreshToken: &tokenv1.Token{
+					Value:     "JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr",
+					ParentId:  "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "profile offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH", "urn:example:backend-api"},
+					},
+				},
+			},
+		},
+		{
+			name: "authorization details not granted",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType:            oidc.GrantTypeRefreshToken,
+					AuthorizationDetails: `[{"type":"account_information"}]`,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, _ *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://127.0.0.1:8080",
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:                "profile offline_access",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            604801,
+						AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+					},
+				}, nil)
 			},
 			wantErr: true,
 			want: &flowv1.TokenResponse{
-				Error: rfcerrors.ServerError().Build(),
+				Error: rfcerrors.InvalidAuthorizationDetails().Build(),
+			},
+		},
+		{
+			name: "valid - narrow authorization details",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType:            oidc.GrantTypeRefreshToken,
+					AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://127.0.0.1:8080",
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:                "profile offline_access",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            604801,
+						AuthorizationDetails: `[{"type":"account_information"},{"type":"payment_initiation"}]`,
+					},
+				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				AccessToken: &tokenv1.Token{
+					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://127.0.0.1:8080",
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:                "profile offline_access",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            3601,
+						AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+					},
+				},
+				RefreshToken: &tokenv1.Token{
+					Value:     "JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr",
+					ParentId:  "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "http://127.0.0.1:8080",
+						Audience:             "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:                "profile offline_access",
+						IssuedAt:             1,
+						NotBefore:            2,
+						ExpiresAt:            604801,
+						AuthorizationDetails: `[{"type":"account_information"},{"type":"payment_initiation"}]`,
+					},
+				},
+			},
+		},
+		{
+			name: "valid with new rt",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &flowv1.TokenRequest_RefreshToken{
+						RefreshToken: &flowv1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 2,
+					},
+				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				AccessToken: &tokenv1.Token{
+					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+				},
+				RefreshToken: &tokenv1.Token{
+					Value:     "JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr",
+					ParentId:  "0123456789",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+					},
+				},
+				IdToken: &tokenv1.Token{
+					Value:     "eyJ.idt.sig",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+					},
+				},
 			},
 		},
-		// ---------------------------------------------------------------------
 		{
-			name: "valid",
+			name: "valid - rotation on every use",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -661,11 +1445,13 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
 					TokenId:   "0123456789",
+					GrantId:   "vRt8cGm5EJpbGXbw",
 					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
 					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
 					Metadata: &tokenv1.TokenMeta{
@@ -677,13 +1463,23 @@ func Test_service_refreshToken(t *testing.T) {
 						ExpiresAt: 604801,
 					},
 				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+				inFamily := func(_ context.Context, _ string, t *tokenv1.Token) {
+					if t.GrantId != "vRt8cGm5EJpbGXbw" {
+						panic("token must belong to the refresh token family")
+					}
+				}
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
-				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Do(inFamily).Return(nil)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Do(inFamily).Return(nil).After(atSave)
 			},
 			wantErr: false,
 			want: &flowv1.TokenResponse{
 				AccessToken: &tokenv1.Token{
 					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
 					TokenId:   "0123456789",
 					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
 					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
@@ -696,10 +1492,37 @@ func Test_service_refreshToken(t *testing.T) {
 						ExpiresAt: 3601,
 					},
 				},
+				RefreshToken: &tokenv1.Token{
+					Value:     "JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr",
+					ParentId:  "0123456789",
+					TokenId:   "0123456789",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+					},
+				},
+				IdToken: &tokenv1.Token{
+					Value:     "eyJ.idt.sig",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+					},
+				},
 			},
 		},
 		{
-			name: "valid with new rt",
+			name: "valid - legacy refresh token seeds its family",
 			args: args{
 				ctx: context.Background(),
 				client: &clientv1.Client{
@@ -718,7 +1541,8 @@ func Test_service_refreshToken(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator) {
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, idt *tokenmock.MockIDTokenGenerator, resources *storagemock.MockResourceReader) {
+				resources.EXPECT().GetByURI(gomock.Any(), "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH").Return(nil, storage.ErrNotFound).AnyTimes()
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -731,19 +1555,26 @@ func Test_service_refreshToken(t *testing.T) {
 						Scope:     "openid profile email offline_access",
 						IssuedAt:  1,
 						NotBefore: 2,
-						ExpiresAt: 2,
+						ExpiresAt: 604801,
 					},
 				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+				inFamily := func(_ context.Context, _ string, t *tokenv1.Token) {
+					if t.GrantId != "0123456789" {
+						panic("token must belong to the refresh token family")
+					}
+				}
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
-				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Do(inFamily).Return(nil)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr", nil)
-				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
-				tokens.EXPECT().Revoke(gomock.Any(), "http://127.0.0.1:8080", "0123456789").Return(nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Do(inFamily).Return(nil).After(atSave)
 			},
 			wantErr: false,
 			want: &flowv1.TokenResponse{
 				AccessToken: &tokenv1.Token{
 					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
 					TokenId:   "0123456789",
 					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
 					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
@@ -758,6 +1589,7 @@ func Test_service_refreshToken(t *testing.T) {
 				},
 				RefreshToken: &tokenv1.Token{
 					Value:     "JHP.HscxBIrTOYZWgupVlrABwkdbhtqVFrmr",
+					ParentId:  "0123456789",
 					TokenId:   "0123456789",
 					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
 					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
@@ -770,6 +1602,18 @@ func Test_service_refreshToken(t *testing.T) {
 						ExpiresAt: 604801,
 					},
 				},
+				IdToken: &tokenv1.Token{
+					Value:     "eyJ.idt.sig",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+					},
+				},
 			},
 		},
 	}
@@ -781,17 +1625,22 @@ func Test_service_refreshToken(t *testing.T) {
 			// Arm mocks
 			accessTokens := tokenmock.NewMockGenerator(ctrl)
 			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			idTokens := tokenmock.NewMockIDTokenGenerator(ctrl)
 			tokens := storagemock.NewMockToken(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(tokens, accessTokens, refreshTokens)
+				tt.prepare(tokens, accessTokens, refreshTokens, idTokens, resources)
 			}
 
 			s := &service{
 				tokens:          tokens,
 				accessTokenGen:  accessTokens,
+				lifetimes:       token.DefaultLifetimePolicy(),
 				refreshTokenGen: refreshTokens,
+				idTokenGen:      idTokens,
+				resources:       resources,
 			}
 			got, err := s.refreshToken(tt.args.ctx, tt.args.client, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -22,19 +22,62 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
-	"time"
 
-	"github.com/dchest/uniuri"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
 
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
 	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
 	"zntr.io/solid/server/storage"
+	"zntr.io/solid/server/trust"
 )
 
+// tokenExchangePolicy defines the token types which can be issued for a
+// given subject token type.
+var tokenExchangePolicy = map[string][]string{
+	// Access tokens can only be downscoped or retargeted.
+	oidc.TokenExchangeAccessTokenType: {oidc.TokenExchangeAccessTokenType},
+	// Refresh tokens can be exchanged for access tokens or downscoped
+	// refresh tokens of the same family.
+	oidc.TokenExchangeRefreshTokenType: {oidc.TokenExchangeAccessTokenType, oidc.TokenExchangeRefreshTokenType},
+	// Identity assertions can only be exchanged for access tokens.
+	oidc.TokenExchangeIDTokenType: {oidc.TokenExchangeAccessTokenType},
+	oidc.TokenExchangeJWTType:     {oidc.TokenExchangeAccessTokenType},
+}
+
+// tokenExchangeAssertionTypes defines the header types accepted for the
+// assertions signed by this server. Only ID tokens are self-contained
+// assertions, stored tokens must be exchanged with their own token type to
+// check their status.
+var tokenExchangeAssertionTypes = map[string][]string{
+	oidc.TokenExchangeIDTokenType: {token.TypeIDToken},
+	oidc.TokenExchangeJWTType:     {token.TypeIDToken},
+}
+
+// tokenExchangeAssertionClaims describes subject token claims extracted from
+// ID tokens and JWTs issued by this server.
+type tokenExchangeAssertionClaims struct {
+	Iss      string                     `json:"iss"`
+	Sub      string                     `json:"sub"`
+	Aud      josejwt.Audience           `json:"aud"`
+	Azp      string                     `json:"azp"`
+	Exp      uint64                     `json:"exp"`
+	Nbf      uint64                     `json:"nbf"`
+	Iat      uint64                     `json:"iat"`
+	JTI      string                     `json:"jti"`
+	Scope    string                     `json:"scope"`
+	Acr      *string                    `json:"acr"`
+	AuthTime *uint64                    `json:"auth_time"`
+	Cnf      *tokenv1.TokenConfirmation `json:"cnf"`
+	Act      *token.ActorClaims         `json:"act"`
+	MayAct   *token.ActorClaims         `json:"may_act"`
+}
+
 //nolint:funlen,gocyclo // to refactor
 func (s *service) tokenExchange(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest) (*flowv1.TokenResponse, error) {
 	res := &flowv1.TokenResponse{}
@@ -78,143 +121,470 @@ func (s *service) tokenExchange(ctx context.Context, client *clientv1.Client, re
 		return res, fmt.Errorf("subject_token must not be empty")
 	}
 
+	// Check actor token
+	if grant.ActorToken != nil && *grant.ActorToken == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("actor_token must not be empty when specified")
+	}
+	if (grant.ActorToken == nil) != (grant.ActorTokenType == nil) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("actor_token_type must be specified with actor_token only")
+	}
+
 	// Validate client capabilities
 	if !types.StringArray(client.GrantTypes).Contains(oidc.GrantTypeTokenExchange) {
 		res.Error = rfcerrors.UnsupportedGrantType().Build()
 		return res, fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeTokenExchange)
 	}
 
-	// Dispatch according to subject_token_type.
-	switch grant.SubjectTokenType {
-	case oidc.TokenExchangeAccessTokenType:
-		err = s.tokenExchangeAccessToken(ctx, client, req, res)
-	default:
+	// Check conversion policy
+	allowed, ok := tokenExchangePolicy[grant.SubjectTokenType]
+	if !ok {
 		res.Error = rfcerrors.InvalidRequest().Build()
 		return res, fmt.Errorf("this subject_token_type is invalid or not supported")
 	}
+	requestedTokenType := oidc.TokenExchangeAccessTokenType
+	if grant.RequestedTokenType != nil {
+		requestedTokenType = *grant.RequestedTokenType
+	}
+	if !types.StringArray(allowed).Contains(requestedTokenType) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("'%s' can't be exchanged for '%s'", grant.SubjectTokenType, requestedTokenType)
+	}
 
+	// Resolve subject token
+	st, err := s.tokenExchangeSubject(ctx, client, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate subject_token: %w", err)
+	}
+
+	// Check requested scope, only downscoping is allowed
+	scope, err := s.narrowedScope(ctx, client, st.Metadata.Scope, req, res)
+	if err != nil {
+		return res, fmt.Errorf("unable to validate requested scope: %w", err)
+	}
+
+	// Dispatch according to requested_token_type.
+	switch requestedTokenType {
+	case oidc.TokenExchangeRefreshTokenType:
+		err = s.tokenExchangeRefreshToken(ctx, client, req, st, scope, res)
+	default:
+		err = s.tokenExchangeAccessToken(ctx, client, req, st, scope, res)
+	}
 	if err != nil {
 		return res, fmt.Errorf("unable to process token exchange: %w", err)
 	}
 
+	// Assign scope if different
+	if st.Metadata.Scope != scope {
+		res.Scope = types.StringRef(scope)
+	}
+
 	// No error
 	return res, nil
 }
 
-func (s *service) tokenExchangeAccessToken(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest, res *flowv1.TokenResponse) error {
-	// Check parameters
-	if res == nil {
-		return fmt.Errorf("unable to process with nil result")
+func (s *service) tokenExchangeAccessToken(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest, st *tokenv1.Token, scope string, res *flowv1.TokenResponse) error {
+	// Resolve delegation chain
+	actors, err := s.tokenExchangeActor(ctx, req, st, res)
+	if err != nil {
+		return fmt.Errorf("unable to resolve actor: %w", err)
 	}
-	if client == nil {
+
+	// Resolve targeted resource, only the subject token resources can be
+	// targeted unless the client is allowed to target additional ones.
+	targets, err := s.requestedTargets(ctx, req, res)
+	if err != nil {
+		return fmt.Errorf("unable to validate requested resources: %w", err)
+	}
+	granted := types.StringArray(resourceindicator.Granted(st.Metadata.Audience, st.Metadata.Resources))
+	for _, r := range client.TokenExchangeResources {
+		granted.AddIfNotContains(r)
+	}
+	audience, err := accessTokenTarget(targets, granted, st.Metadata.Audience, res)
+	if err != nil {
+		return fmt.Errorf("unable to select access token resource: %w", err)
+	}
+
+	// Generate access token in the subject token family
+	at, err := s.generateAccessToken(ctx, client, oidc.GrantTypeTokenExchange, &tokenv1.TokenMeta{
+		Issuer:   st.Metadata.Issuer,
+		Subject:  st.Metadata.Subject,
+		Scope:    scope,
+		Audience: audience,
+		Acr:      st.Metadata.Acr,
+		AuthTime: st.Metadata.AuthTime,
+	}, st.Confirmation, st.GrantId, st.TokenId, actors)
+	if err != nil {
 		res.Error = rfcerrors.ServerError().Build()
-		return fmt.Errorf("unable to process with nil client")
+		return fmt.Errorf("unable to generate access token: %w", err)
 	}
-	if req == nil {
+
+	// Assign access token
+	res.Issuer = st.Metadata.Issuer
+	res.AccessToken = at
+	res.IssuedTokenType = types.StringRef(oidc.TokenExchangeAccessTokenType)
+
+	// No error
+	return nil
+}
+
+func (s *service) tokenExchangeRefreshToken(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest, st *tokenv1.Token, scope string, res *flowv1.TokenResponse) error {
+	// Check client capabilities
+	if !types.StringArray(client.GrantTypes).Contains(oidc.GrantTypeRefreshToken) {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeRefreshToken)
+	}
+
+	// Refresh tokens keep their subject and audience
+	if req.GetTokenExchange().ActorToken != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return fmt.Errorf("actor_token can't be used to issue a refresh token")
+	}
+	if req.Audience != nil || len(req.Resource) > 0 {
+		res.Error = rfcerrors.InvalidTarget().Build()
+		return fmt.Errorf("audience can't be changed for a refresh token")
+	}
+
+	// Consume the subject refresh token before issuing anything, a concurrent
+	// use of the same refresh token is handled as a reuse.
+	if err := s.tokens.Consume(ctx, req.Issuer, st.TokenId); err != nil {
+		if errors.Is(err, storage.ErrAlreadyUsed) {
+			_, err = s.refreshTokenReuse(ctx, req.Issuer, st, res)
+			return err
+		}
 		res.Error = rfcerrors.ServerError().Build()
-		return fmt.Errorf("unable to process with nil request")
+		return fmt.Errorf("unable to consume refresh token '%s': %w", st.TokenId, err)
 	}
-	grant := req.GetTokenExchange()
-	if grant == nil {
+
+	// Generate refresh token in the same token family, it can't outlive the
+	// subject refresh token.
+	rt, err := s.generateRefreshToken(ctx, client, oidc.GrantTypeTokenExchange, &tokenv1.TokenMeta{
+		Issuer:    st.Metadata.Issuer,
+		Subject:   st.Metadata.Subject,
+		Scope:     scope,
+		Audience:  st.Metadata.Audience,
+		Acr:       st.Metadata.Acr,
+		AuthTime:  st.Metadata.AuthTime,
+		Resources: st.Metadata.Resources,
+	}, st.Confirmation, tokenFamily(st), st.TokenId, st.Metadata.ExpiresAt)
+	if err != nil {
 		res.Error = rfcerrors.ServerError().Build()
-		return fmt.Errorf("unable to process with nil grant")
+		return fmt.Errorf("unable to generate refresh token: %w", err)
+	}
+
+	// Issued token is always returned as access_token
+	// https://www.rfc-editor.org/rfc/rfc8693.html#name-successful-response
+	res.Issuer = st.Metadata.Issuer
+	res.AccessToken = rt
+	res.IssuedTokenType = types.StringRef(oidc.TokenExchangeRefreshTokenType)
+
+	// No error
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+
+// tokenExchangeSubject returns the token described by the subject_token.
+func (s *service) tokenExchangeSubject(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest, res *flowv1.TokenResponse) (*tokenv1.Token, error) {
+	grant := req.GetTokenExchange()
+
+	switch grant.SubjectTokenType {
+	case oidc.TokenExchangeAccessTokenType:
+		return s.tokenExchangeLookup(ctx, req.Issuer, grant.SubjectToken, tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN, res)
+	case oidc.TokenExchangeRefreshTokenType:
+		st, err := s.tokenExchangeLookup(ctx, req.Issuer, grant.SubjectToken, tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN, res)
+		if err != nil {
+			return nil, err
+		}
+
+		// Refresh tokens are bound to the client they were issued to
+		if st.Metadata.ClientId != client.ClientId {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return nil, fmt.Errorf("refresh token was not issued to the client")
+		}
+
+		return st, nil
+	case oidc.TokenExchangeJWTType:
+		// Check trusted external issuers first
+		if !types.IsNil(s.trustedIssuers) {
+			st, err := s.tokenExchangeExternalAssertion(ctx, client, req.Issuer, grant.SubjectToken, res)
+			if !errors.Is(err, trust.ErrUntrustedIssuer) {
+				return st, err
+			}
+		}
+
+		return s.tokenExchangeAssertion(ctx, client, req.Issuer, grant.SubjectTokenType, grant.SubjectToken, res)
+	case oidc.TokenExchangeIDTokenType:
+		return s.tokenExchangeAssertion(ctx, client, req.Issuer, grant.SubjectTokenType, grant.SubjectToken, res)
+	default:
 	}
 
+	res.Error = rfcerrors.InvalidRequest().Build()
+	return nil, fmt.Errorf("this subject_token_type is invalid or not supported")
+}
+
+// tokenExchangeLookup returns the usable stored token matching the given value.
+func (s *service) tokenExchangeLookup(ctx context.Context, issuer, value string, tokenType tokenv1.TokenType, res *flowv1.TokenResponse) (*tokenv1.Token, error) {
 	// Check given token
-	st, err := s.tokens.GetByValue(ctx, req.Issuer, grant.SubjectToken)
+	t, err := s.tokens.GetByValue(ctx, issuer, value)
 	if err != nil {
 		if err != storage.ErrNotFound {
 			res.Error = rfcerrors.ServerError().Build()
 		} else {
 			res.Error = rfcerrors.InvalidRequest().Build()
 		}
-		return fmt.Errorf("unable to retrieve token '%s' from storage: %w", grant.SubjectToken, err)
+		return nil, fmt.Errorf("unable to retrieve token from storage: %w", err)
 	}
 
 	// Check token
-	if st.Status != tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE {
+	if t.Status != tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE {
 		res.Error = rfcerrors.InvalidRequest().Build()
-		return fmt.Errorf("subject_token in not active")
+		return nil, fmt.Errorf("token is not active")
 	}
-	if st.TokenType != tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN {
+	if t.TokenType != tokenType {
 		res.Error = rfcerrors.InvalidRequest().Build()
-		return fmt.Errorf("subject_token must not be empty")
+		return nil, fmt.Errorf("token type mismatch, expected '%s'", tokenType)
 	}
-	if st.Metadata == nil {
+	if t.Metadata == nil {
 		res.Error = rfcerrors.ServerError().Build()
-		return fmt.Errorf("token doesn't have metadata")
+		return nil, fmt.Errorf("token doesn't have metadata")
 	}
 
 	// If expired
-	if st.Metadata.ExpiresAt < uint64(timeFunc().Unix()) {
+	if t.Metadata.ExpiresAt < uint64(timeFunc().Unix()) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("token is expired")
+	}
+
+	// No error
+	return t, nil
+}
+
+// tokenExchangeAssertion returns a transient token built from an ID token or a
+// JWT signed by this server. The assertion must have been issued to the
+// requesting client.
+func (s *service) tokenExchangeAssertion(ctx context.Context, client *clientv1.Client, issuer, tokenType, raw string, res *flowv1.TokenResponse) (*tokenv1.Token, error) {
+	// Check arguments
+	if types.IsNil(s.tokenVerifier) {
 		res.Error = rfcerrors.InvalidRequest().Build()
-		return fmt.Errorf("subject_token is expired")
+		return nil, fmt.Errorf("token verifier is not configured")
 	}
 
-	// Prepare token metadata
-	scope := st.Metadata.Scope
-	if req.Scope != nil {
-		scope = *req.Scope
+	// Check token type
+	t, err := s.tokenVerifier.Parse(raw)
+	if err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("unable to parse token: %w", err)
+	}
+	typ, err := t.Type()
+	if err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("unable to retrieve token type: %w", err)
+	}
+	if !types.StringArray(tokenExchangeAssertionTypes[tokenType]).Contains(typ) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("'%s' token can't be exchanged as '%s'", typ, tokenType)
+	}
+
+	// Pairwise subjects can't be mapped back to the local subject
+	if client.SubjectType == oidc.SubjectTypePairwise {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("pairwise subject assertions can't be exchanged")
 	}
 
-	// Create access token spec
-	now := timeFunc()
-	at := &tokenv1.Token{
-		TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
-		TokenId:   uniuri.NewLen(jtiLength),
+	// Verify signature and extract claims
+	var claims tokenExchangeAssertionClaims
+	if err := s.tokenVerifier.Claims(ctx, raw, &claims); err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("unable to verify token: %w", err)
+	}
+
+	// Check claims
+	now := uint64(timeFunc().Unix())
+	switch {
+	case claims.Iss != issuer:
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("token issuer '%s' is not accepted", claims.Iss)
+	case claims.Sub == "":
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("token subject must not be blank")
+	case !claims.Aud.Contains(client.ClientId):
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("token was not issued to the client")
+	case claims.Azp != "" && claims.Azp != client.ClientId:
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("token authorized party '%s' is not the client", claims.Azp)
+	case claims.Exp < now:
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("token is expired")
+	case claims.Nbf > now:
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("token is not yet valid")
+	default:
+	}
+
+	// Build token spec
+	st := &tokenv1.Token{
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_UNKNOWN,
+		TokenId:   claims.JTI,
 		Metadata: &tokenv1.TokenMeta{
-			Issuer:    st.Metadata.Issuer,
-			Subject:   st.Metadata.Subject,
-			ClientId:  client.ClientId,
-			IssuedAt:  uint64(now.Unix()),
-			ExpiresAt: uint64(now.Add(1 * time.Minute).Unix()),
-			Scope:     scope,
+			Issuer:    claims.Iss,
+			Subject:   claims.Sub,
+			IssuedAt:  claims.Iat,
+			NotBefore: claims.Nbf,
+			ExpiresAt: claims.Exp,
+			Scope:     claims.Scope,
+			Acr:       claims.Acr,
+			AuthTime:  claims.AuthTime,
 		},
-		Confirmation: st.Confirmation,
+		Confirmation: claims.Cnf,
 		Status:       tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		GrantId:      newGrantID(),
+	}
+	if tokenType == oidc.TokenExchangeIDTokenType {
+		// ID tokens only assert the user identity
+		st.TokenType = tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN
+		st.Metadata.Scope = oidc.ScopeOpenID
+	}
+	if claims.Act != nil {
+		st.Actor = []*tokenv1.Actor{claims.Act.Actor()}
+	}
+	if claims.MayAct != nil {
+		st.MayAct = []*tokenv1.Actor{claims.MayAct.Actor()}
 	}
 
-	// Add optional meta
-	if req.Audience != nil {
-		aud, err := s.resources.GetByURI(ctx, *req.Audience)
-		if err != nil && errors.Is(err, storage.ErrNotFound) {
-			return fmt.Errorf("unable to validate audience: %w", err)
+	// No error
+	return st, nil
+}
+
+// tokenExchangeExternalAssertion returns a transient token built from a JWT
+// signed by a trusted external issuer the client is allowed to exchange
+// assertions from. The token is issued by this server for the mapped subject
+// and scopes, each assertion can only be exchanged once.
+func (s *service) tokenExchangeExternalAssertion(ctx context.Context, client *clientv1.Client, issuer, raw string, res *flowv1.TokenResponse) (*tokenv1.Token, error) {
+	// Verify with trusted issuer settings
+	a, err := s.trustedIssuers.Verify(ctx, raw)
+	if err != nil {
+		if !errors.Is(err, trust.ErrUntrustedIssuer) {
+			res.Error = rfcerrors.InvalidRequest().Build()
 		}
-		if errors.Is(err, storage.ErrNotFound) {
-			res.Error = rfcerrors.InvalidTarget().Build()
-			return fmt.Errorf("audience '%s' not found", *req.Audience)
+		return nil, fmt.Errorf("unable to verify external assertion: %w", err)
+	}
+
+	// Check client capabilities
+	if !types.StringArray(client.TokenExchangeIssuers).Contains(a.Issuer) {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return nil, fmt.Errorf("client is not allowed to exchange assertions from '%s'", a.Issuer)
+	}
+
+	// Check mapped scopes
+	if a.Scope == "" {
+		res.Error = rfcerrors.InvalidScope().Build()
+		return nil, fmt.Errorf("external assertion from '%s' doesn't grant any scope", a.Issuer)
+	}
+
+	// Prevent assertion replay
+	if a.JTI == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("external assertion from '%s' must have an identifier", a.Issuer)
+	}
+	if types.IsNil(s.assertionJTIs) {
+		res.Error = rfcerrors.ServerError().Build()
+		return nil, fmt.Errorf("assertion identifier storage is not configured")
+	}
+	if err := s.assertionJTIs.Use(ctx, a.Issuer, a.JTI, a.ExpiresAt); err != nil {
+		if !errors.Is(err, storage.ErrAlreadyUsed) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidRequest().Build()
 		}
+		return nil, fmt.Errorf("unable to register assertion identifier: %w", err)
+	}
+
+	// No error
+	return &tokenv1.Token{
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_UNKNOWN,
+		TokenId:   a.JTI,
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    issuer,
+			Subject:   a.Subject,
+			IssuedAt:  a.IssuedAt,
+			NotBefore: a.NotBefore,
+			ExpiresAt: a.ExpiresAt,
+			Scope:     a.Scope,
+		},
+		Status:  tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		GrantId: newGrantID(),
+	}, nil
+}
+
+// tokenExchangeActor returns the actor chain of the token to issue. Without
+// actor token, the issued token impersonates the subject and keeps its actor
+// chain. With an actor token, the actor must be authorized by the subject
+// token may_act claim and becomes the current actor of the chain.
+// https://www.rfc-editor.org/rfc/rfc8693.html#name-delegation-vs-impersonation
+func (s *service) tokenExchangeActor(ctx context.Context, req *flowv1.TokenRequest, st *tokenv1.Token, res *flowv1.TokenResponse) ([]*tokenv1.Actor, error) {
+	grant := req.GetTokenExchange()
 
-		// Assign urn
-		at.Metadata.Audience = aud.Urn
+	// Impersonation
+	if grant.ActorToken == nil {
+		return st.Actor, nil
 	}
 
-	// Generate an access token
-	at.Value, err = s.accessTokenGen.Generate(ctx, at)
+	// Check actor token type
+	if grant.GetActorTokenType() != oidc.TokenExchangeAccessTokenType {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("this actor_token_type is invalid or not supported")
+	}
+
+	// Check given token
+	act, err := s.tokenExchangeLookup(ctx, req.Issuer, grant.GetActorToken(), tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN, res)
 	if err != nil {
-		return fmt.Errorf("unable to generate an accessToken: %w", err)
+		return nil, fmt.Errorf("unable to validate actor_token: %w", err)
 	}
 
-	// Check generator value
-	if at.Value == "" {
-		return fmt.Errorf("accessTokenGenerator generated an empty value")
+	// Check delegation authorization
+	if !mayAct(st.MayAct, act.Metadata) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return nil, fmt.Errorf("actor '%s' is not authorized to act on behalf of the subject", act.Metadata.Subject)
 	}
 
-	// Store the token spec
-	if err := s.tokens.Create(ctx, req.Issuer, at); err != nil {
-		return fmt.Errorf("unable to register access token spec in token storage: %w", err)
+	// Prepend the actor to the subject token chain
+	actor := &tokenv1.Actor{
+		Issuer:   act.Metadata.Issuer,
+		Subject:  act.Metadata.Subject,
+		ClientId: act.Metadata.ClientId,
+	}
+	if len(st.Actor) > 0 {
+		actor.Act = st.Actor[0]
 	}
 
-	// Assign access token
-	res.Issuer = st.Metadata.Issuer
-	res.AccessToken = at
-	res.IssuedTokenType = types.StringRef(oidc.TokenExchangeAccessTokenType)
+	// No error
+	return []*tokenv1.Actor{actor}, nil
+}
 
-	// Assign scope if different
-	if st.Metadata.Scope != scope {
-		res.Scope = types.StringRef(scope)
+// mayAct returns true when one of the authorized actors matches the given
+// actor token metadata. Blank authorized actor attributes are not checked.
+func mayAct(authorized []*tokenv1.Actor, meta *tokenv1.TokenMeta) bool {
+	for _, a := range authorized {
+		switch {
+		case a == nil:
+			continue
+		case a.Subject == "" && a.ClientId == "":
+			// Reject wildcard authorizations
+			continue
+		case a.Issuer != "" && a.Issuer != meta.Issuer:
+			continue
+		case a.Subject != "" && a.Subject != meta.Subject:
+			continue
+		case a.ClientId != "" && a.ClientId != meta.ClientId:
+			continue
+		default:
+		}
+
+		return true
 	}
 
-	// No error
-	return nil
+	return false
 }
@@ -0,0 +1,1368 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
+	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+	storagemock "zntr.io/solid/server/storage/mock"
+	"zntr.io/solid/server/trust"
+	trustmock "zntr.io/solid/server/trust/mock"
+)
+
+// tokenTypeStub overrides the parsed token type.
+type tokenTypeStub struct {
+	token.Token
+	typ string
+}
+
+func (t *tokenTypeStub) Type() (string, error) {
+	return t.typ, nil
+}
+
+func Test_service_tokenExchange(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		client *clientv1.Client
+		req    *flowv1.TokenRequest
+	}
+
+	client := &clientv1.Client{
+		ClientId:   "s6BhdRkqt3",
+		ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+		GrantTypes: []string{oidc.GrantTypeTokenExchange},
+	}
+	partnerClient := &clientv1.Client{
+		ClientId:             "s6BhdRkqt3",
+		ClientType:           clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+		GrantTypes:           []string{oidc.GrantTypeTokenExchange},
+		TokenExchangeIssuers: []string{"https://partner.example.com"},
+	}
+	exchange := func(subjectTokenType string, requestedTokenType, scope *string) *flowv1.TokenRequest {
+		return &flowv1.TokenRequest{
+			Issuer:    "http://127.0.0.1:8080",
+			GrantType: oidc.GrantTypeTokenExchange,
+			Scope:     scope,
+			Grant: &flowv1.TokenRequest_TokenExchange{
+				TokenExchange: &flowv1.GrantTokenExchange{
+					SubjectToken:       "subject-token",
+					SubjectTokenType:   subjectTokenType,
+					RequestedTokenType: requestedTokenType,
+				},
+			},
+		}
+	}
+	refreshToken := &tokenv1.Token{
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+		TokenId:   "rt-1",
+		Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		GrantId:   "family",
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    "http://127.0.0.1:8080",
+			Subject:   "user@example.com",
+			ClientId:  "s6BhdRkqt3",
+			Scope:     "openid email",
+			ExpiresAt: 604801,
+		},
+	}
+	assertion := func(claims tokenExchangeAssertionClaims) func(context.Context, string, any) error {
+		return func(_ context.Context, _ string, out any) error {
+			*(out.(*tokenExchangeAssertionClaims)) = claims
+			return nil
+		}
+	}
+	request := func(actorToken, actorTokenType *string) *flowv1.TokenRequest {
+		return &flowv1.TokenRequest{
+			Issuer:    "http://127.0.0.1:8080",
+			GrantType: oidc.GrantTypeTokenExchange,
+			Grant: &flowv1.TokenRequest_TokenExchange{
+				TokenExchange: &flowv1.GrantTokenExchange{
+					SubjectToken:     "subject-token",
+					SubjectTokenType: oidc.TokenExchangeAccessTokenType,
+					ActorToken:       actorToken,
+					ActorTokenType:   actorTokenType,
+				},
+			},
+		}
+	}
+	subjectToken := func(mayAct ...*tokenv1.Actor) *tokenv1.Token {
+		return &tokenv1.Token{
+			TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+			Metadata: &tokenv1.TokenMeta{
+				Issuer:    "http://127.0.0.1:8080",
+				Subject:   "user@example.com",
+				ClientId:  "frontend",
+				Scope:     "openid",
+				ExpiresAt: 3601,
+			},
+			Actor: []*tokenv1.Actor{
+				{Subject: "support@example.com"},
+			},
+			MayAct: mayAct,
+		}
+	}
+	actorToken := &tokenv1.Token{
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+		Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    "http://127.0.0.1:8080",
+			Subject:   "admin@example.com",
+			ClientId:  "s6BhdRkqt3",
+			ExpiresAt: 3601,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockToken, *tokenmock.MockGenerator, *tokenmock.MockGenerator, *tokenmock.MockVerifier, *trustmock.MockRegistry, *storagemock.MockAssertionJTI)
+		want    *flowv1.TokenResponse
+		wantErr bool
+	}{
+		{
+			name: "actor_token without actor_token_type",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "actor_token_type without actor_token",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(nil, types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "blank actor_token",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef(""), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "unsupported actor_token_type",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeSAML2Type)),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "actor_token not found",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "actor-token").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "actor_token storage error",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "actor-token").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "actor_token revoked",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "actor-token").Return(&tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+					Metadata:  actorToken.Metadata,
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "actor_token expired",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(3602, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(&tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ExpiresAt: 7200,
+					},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "actor-token").Return(actorToken, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "actor not authorized - no may_act",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "actor-token").Return(actorToken, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "actor not authorized - may_act mismatch",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(
+					&tokenv1.Actor{Subject: "admin@example.com", ClientId: "backoffice"},
+					&tokenv1.Actor{},
+				), nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "actor-token").Return(actorToken, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "unsupported subject_token_type",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeSAML2Type, nil, nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "refresh_token requested from access_token",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeAccessTokenType, types.StringRef(oidc.TokenExchangeRefreshTokenType), nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "id_token requested from refresh_token",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeRefreshTokenType, types.StringRef(oidc.TokenExchangeIDTokenType), nil),
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "scope escalation",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeAccessTokenType, nil, types.StringRef("openid admin")),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "scope not allowed for client",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeTokenExchange},
+					Scopes:     []string{"email"},
+				},
+				req: exchange(oidc.TokenExchangeAccessTokenType, nil, types.StringRef("openid")),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "refresh_token issued to another client",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "frontend",
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeTokenExchange},
+				},
+				req: exchange(oidc.TokenExchangeRefreshTokenType, nil, nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(refreshToken, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "refresh_token requested by client without refresh_token grant",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeRefreshTokenType, types.StringRef(oidc.TokenExchangeRefreshTokenType), nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(refreshToken, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
+		{
+			name: "id_token: not an id_token",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeIDTokenType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: "jarm"}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "jwt: stored token type",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(nil, trust.ErrUntrustedIssuer)
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: token.TypeAccessToken}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "jwt: invalid signature",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(nil, trust.ErrUntrustedIssuer)
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: "JWT"}, nil)
+				verifier.EXPECT().Claims(gomock.Any(), "subject-token", gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "jwt: foreign issuer",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(nil, trust.ErrUntrustedIssuer)
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: "JWT"}, nil)
+				verifier.EXPECT().Claims(gomock.Any(), "subject-token", gomock.Any()).DoAndReturn(assertion(tokenExchangeAssertionClaims{
+					Iss: "https://attacker.example.com",
+					Sub: "user@example.com",
+					Exp: 3601,
+				}))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "jwt: expired",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(nil, trust.ErrUntrustedIssuer)
+				timeFunc = func() time.Time { return time.Unix(3602, 0) }
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: "JWT"}, nil)
+				verifier.EXPECT().Claims(gomock.Any(), "subject-token", gomock.Any()).DoAndReturn(assertion(tokenExchangeAssertionClaims{
+					Iss: "http://127.0.0.1:8080",
+					Sub: "user@example.com",
+					Aud: josejwt.Audience{"s6BhdRkqt3"},
+					Exp: 3601,
+				}))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "jwt: unexpected token type",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(nil, trust.ErrUntrustedIssuer)
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: token.TypeStatusList}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "id_token: issued to another client",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeIDTokenType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: token.TypeIDToken}, nil)
+				verifier.EXPECT().Claims(gomock.Any(), "subject-token", gomock.Any()).DoAndReturn(assertion(tokenExchangeAssertionClaims{
+					Iss: "http://127.0.0.1:8080",
+					Sub: "user@example.com",
+					Aud: josejwt.Audience{"frontend"},
+					Azp: "frontend",
+					Exp: 3601,
+				}))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "id_token: authorized party mismatch",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeIDTokenType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: token.TypeIDToken}, nil)
+				verifier.EXPECT().Claims(gomock.Any(), "subject-token", gomock.Any()).DoAndReturn(assertion(tokenExchangeAssertionClaims{
+					Iss: "http://127.0.0.1:8080",
+					Sub: "user@example.com",
+					Aud: josejwt.Audience{"frontend", "s6BhdRkqt3"},
+					Azp: "frontend",
+					Exp: 3601,
+				}))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "id_token: pairwise subject",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:    "s6BhdRkqt3",
+					ClientType:  clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes:  []string{oidc.GrantTypeTokenExchange},
+					SubjectType: oidc.SubjectTypePairwise,
+				},
+				req: exchange(oidc.TokenExchangeIDTokenType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: token.TypeIDToken}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "external: invalid assertion",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "external: issuer not allowed",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile admin",
+					JTI:       "123456789",
+					ExpiresAt: 3601,
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
+		{
+			name: "external: no mapped scope",
+			args: args{
+				ctx:    context.Background(),
+				client: partnerClient,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					ExpiresAt: 3601,
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidScope().Build(),
+			},
+		},
+		{
+			name: "external: missing assertion identifier",
+			args: args{
+				ctx:    context.Background(),
+				client: partnerClient,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile admin",
+					ExpiresAt: 3601,
+				}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "external: replayed assertion",
+			args: args{
+				ctx:    context.Background(),
+				client: partnerClient,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, registry *trustmock.MockRegistry, jtis *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile admin",
+					JTI:       "123456789",
+					ExpiresAt: 3601,
+				}, nil)
+				jtis.EXPECT().Use(gomock.Any(), "https://partner.example.com", "123456789", uint64(3601)).Return(storage.ErrAlreadyUsed)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "external: assertion identifier storage error",
+			args: args{
+				ctx:    context.Background(),
+				client: partnerClient,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(_ *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, registry *trustmock.MockRegistry, jtis *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile admin",
+					JTI:       "123456789",
+					ExpiresAt: 3601,
+				}, nil)
+				jtis.EXPECT().Use(gomock.Any(), "https://partner.example.com", "123456789", uint64(3601)).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "refresh_token reuse",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeTokenExchange, oidc.GrantTypeRefreshToken},
+				},
+				req: exchange(oidc.TokenExchangeRefreshTokenType, types.StringRef(oidc.TokenExchangeRefreshTokenType), nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(refreshToken, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "rt-1").Return(storage.ErrAlreadyUsed)
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "http://127.0.0.1:8080", "family").Return(nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "refresh_token consume error",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeTokenExchange, oidc.GrantTypeRefreshToken},
+				},
+				req: exchange(oidc.TokenExchangeRefreshTokenType, types.StringRef(oidc.TokenExchangeRefreshTokenType), nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(refreshToken, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "rt-1").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid - impersonation",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(nil, nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(), nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 61,
+					},
+					Actor: []*tokenv1.Actor{
+						{Subject: "support@example.com"},
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - delegation",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("actor-token"), types.StringRef(oidc.TokenExchangeAccessTokenType)),
+			},
+			prepare: func(tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken(
+					&tokenv1.Actor{Subject: "admin@example.com", ClientId: "backoffice"},
+					&tokenv1.Actor{Issuer: "http://127.0.0.1:8080", Subject: "admin@example.com"},
+				), nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "actor-token").Return(actorToken, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 61,
+					},
+					Actor: []*tokenv1.Actor{
+						{
+							Issuer:   "http://127.0.0.1:8080",
+							Subject:  "admin@example.com",
+							ClientId: "s6BhdRkqt3",
+							Act: &tokenv1.Actor{
+								Subject: "support@example.com",
+							},
+						},
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - refresh_token for access_token",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeRefreshTokenType, nil, types.StringRef("email")),
+			},
+			prepare: func(tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(refreshToken, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+				Scope:           types.StringRef("email"),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "email",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 61,
+					},
+					Value:    "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					ParentId: "rt-1",
+				},
+			},
+		},
+		{
+			name: "valid - scope restricted to client",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeTokenExchange},
+					Scopes:     []string{"email", "profile"},
+				},
+				req: exchange(oidc.TokenExchangeRefreshTokenType, nil, nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(refreshToken, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+				Scope:           types.StringRef("email"),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "email",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 61,
+					},
+					Value:    "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					ParentId: "rt-1",
+				},
+			},
+		},
+		{
+			name: "valid - refresh_token for refresh_token",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeTokenExchange, oidc.GrantTypeRefreshToken},
+				},
+				req: exchange(oidc.TokenExchangeRefreshTokenType, types.StringRef(oidc.TokenExchangeRefreshTokenType), types.StringRef("email")),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, rt *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(refreshToken, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "rt-1").Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *tokenv1.Token) (string, error) {
+					if t.GrantId != "family" {
+						panic("refresh token must stay in the subject token family")
+					}
+					return "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil
+				})
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeRefreshTokenType),
+				Scope:           types.StringRef("email"),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "email",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 604801,
+					},
+					Value:    "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					ParentId: "rt-1",
+				},
+			},
+		},
+		{
+			name: "valid - refresh_token capped to the subject token expiration",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes: []string{oidc.GrantTypeTokenExchange, oidc.GrantTypeRefreshToken},
+				},
+				req: exchange(oidc.TokenExchangeRefreshTokenType, types.StringRef(oidc.TokenExchangeRefreshTokenType), nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, _, rt *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(&tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					TokenId:   "rt-1",
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					GrantId:   "family",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "openid email",
+						ExpiresAt: 3601,
+					},
+				}, nil)
+				tokens.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "rt-1").Return(nil)
+				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeRefreshTokenType),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "openid email",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 3601,
+					},
+					Value:    "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					ParentId: "rt-1",
+				},
+			},
+		},
+		{
+			name: "valid - id_token",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeIDTokenType, nil, nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, _ *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: token.TypeIDToken}, nil)
+				verifier.EXPECT().Claims(gomock.Any(), "subject-token", gomock.Any()).DoAndReturn(assertion(tokenExchangeAssertionClaims{
+					Iss:      "http://127.0.0.1:8080",
+					Sub:      "user@example.com",
+					Aud:      josejwt.Audience{"s6BhdRkqt3"},
+					Azp:      "s6BhdRkqt3",
+					Exp:      3601,
+					Acr:      types.StringRef("urn:mace:incommon:iap:silver"),
+					AuthTime: types.UInt64Ref(1),
+				}))
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "openid",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 61,
+						Acr:       types.StringRef("urn:mace:incommon:iap:silver"),
+						AuthTime:  types.UInt64Ref(1),
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - jwt",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, types.StringRef("email")),
+			},
+			prepare: func(tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, verifier *tokenmock.MockVerifier, registry *trustmock.MockRegistry, _ *storagemock.MockAssertionJTI) {
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(nil, trust.ErrUntrustedIssuer)
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				verifier.EXPECT().Parse("subject-token").Return(&tokenTypeStub{typ: "JWT"}, nil)
+				verifier.EXPECT().Claims(gomock.Any(), "subject-token", gomock.Any()).DoAndReturn(assertion(tokenExchangeAssertionClaims{
+					Iss:   "http://127.0.0.1:8080",
+					Sub:   "user@example.com",
+					Aud:   josejwt.Audience{"s6BhdRkqt3"},
+					Exp:   3601,
+					Scope: "openid email",
+					Act: &token.ActorClaims{
+						Sub: "support@example.com",
+					},
+				}))
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+				Scope:           types.StringRef("email"),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "email",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 61,
+					},
+					Actor: []*tokenv1.Actor{
+						{Subject: "support@example.com"},
+					},
+					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+		},
+		{
+			name: "valid - external jwt",
+			args: args{
+				ctx:    context.Background(),
+				client: partnerClient,
+				req:    exchange(oidc.TokenExchangeJWTType, nil, nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, at, _ *tokenmock.MockGenerator, _ *tokenmock.MockVerifier, registry *trustmock.MockRegistry, jtis *storagemock.MockAssertionJTI) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				registry.EXPECT().Verify(gomock.Any(), "subject-token").Return(&trust.Assertion{
+					Issuer:    "https://partner.example.com",
+					Subject:   "partner|jdoe",
+					Scope:     "profile admin",
+					JTI:       "123456789",
+					ExpiresAt: 3601,
+				}, nil)
+				jtis.EXPECT().Use(gomock.Any(), "https://partner.example.com", "123456789", uint64(3601)).Return(nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &flowv1.TokenResponse{
+				Issuer:          "http://127.0.0.1:8080",
+				IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+				AccessToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Subject:   "partner|jdoe",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "profile admin",
+						IssuedAt:  1,
+						NotBefore: 2,
+						ExpiresAt: 61,
+					},
+					Value:    "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					ParentId: "123456789",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			accessTokens := tokenmock.NewMockGenerator(ctrl)
+			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			tokenVerifier := tokenmock.NewMockVerifier(ctrl)
+			trustedIssuers := trustmock.NewMockRegistry(ctrl)
+			tokens := storagemock.NewMockToken(ctrl)
+			assertionJTIs := storagemock.NewMockAssertionJTI(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(tokens, accessTokens, refreshTokens, tokenVerifier, trustedIssuers, assertionJTIs)
+			}
+
+			s := &service{
+				tokens:          tokens,
+				accessTokenGen:  accessTokens,
+				refreshTokenGen: refreshTokens,
+				tokenVerifier:   tokenVerifier,
+				trustedIssuers:  trustedIssuers,
+				assertionJTIs:   assertionJTIs,
+				lifetimes:       token.DefaultLifetimePolicy(),
+			}
+			got, err := s.tokenExchange(tt.args.ctx, tt.args.client, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.tokenExchange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.tokenExchange() res = %s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_tokenExchange_Targets(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		client *clientv1.Client
+		req    *flowv1.TokenRequest
+	}
+
+	client := &clientv1.Client{
+		ClientId:   "s6BhdRkqt3",
+		ClientType: clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+		GrantTypes: []string{oidc.GrantTypeTokenExchange},
+	}
+	request := func(audience *string) *flowv1.TokenRequest {
+		return &flowv1.TokenRequest{
+			Issuer:    "http://127.0.0.1:8080",
+			GrantType: oidc.GrantTypeTokenExchange,
+			Audience:  audience,
+			Grant: &flowv1.TokenRequest_TokenExchange{
+				TokenExchange: &flowv1.GrantTokenExchange{
+					SubjectToken:     "subject-token",
+					SubjectTokenType: oidc.TokenExchangeAccessTokenType,
+				},
+			},
+		}
+	}
+	subjectToken := &tokenv1.Token{
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+		Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    "http://127.0.0.1:8080",
+			Subject:   "user@example.com",
+			ClientId:  "frontend",
+			Scope:     "openid",
+			Audience:  "urn:example:frontend-api",
+			Resources: []string{"urn:example:backend-api"},
+			ExpiresAt: 3601,
+		},
+	}
+	issued := func(audience string) *flowv1.TokenResponse {
+		return &flowv1.TokenResponse{
+			Issuer:          "http://127.0.0.1:8080",
+			IssuedTokenType: types.StringRef(oidc.TokenExchangeAccessTokenType),
+			AccessToken: &tokenv1.Token{
+				TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+				Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+				Metadata: &tokenv1.TokenMeta{
+					Issuer:    "http://127.0.0.1:8080",
+					Subject:   "user@example.com",
+					ClientId:  "s6BhdRkqt3",
+					Scope:     "openid",
+					Audience:  audience,
+					IssuedAt:  1,
+					NotBefore: 2,
+					ExpiresAt: 61,
+				},
+				Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockToken, *tokenmock.MockGenerator, *storagemock.MockResourceReader)
+		want    *flowv1.TokenResponse
+		wantErr bool
+	}{
+		{
+			name: "resource not granted",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("urn:example:admin-api")),
+			},
+			prepare: func(tokens *storagemock.MockToken, _ *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:admin-api").Return(&resourcev1.Resource{Urn: "urn:example:admin-api"}, nil)
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidTarget().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid - subject token audience",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(nil),
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:frontend-api").Return(&resourcev1.Resource{Urn: "urn:example:frontend-api"}, nil)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want:    issued("urn:example:frontend-api"),
+		},
+		{
+			name: "valid - granted resource",
+			args: args{
+				ctx:    context.Background(),
+				client: client,
+				req:    request(types.StringRef("urn:example:backend-api")),
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{Urn: "urn:example:backend-api"}, nil).Times(2)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want:    issued("urn:example:backend-api"),
+		},
+		{
+			name: "valid - client exchange resource",
+			args: args{
+				ctx: context.Background(),
+				client: &clientv1.Client{
+					ClientId:               "s6BhdRkqt3",
+					ClientType:             clientv1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+					GrantTypes:             []string{oidc.GrantTypeTokenExchange},
+					TokenExchangeResources: []string{"urn:example:admin-api"},
+				},
+				req: request(types.StringRef("urn:example:admin-api")),
+			},
+			prepare: func(tokens *storagemock.MockToken, at *tokenmock.MockGenerator, resources *storagemock.MockResourceReader) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "subject-token").Return(subjectToken, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:admin-api").Return(&resourcev1.Resource{Urn: "urn:example:admin-api"}, nil).Times(2)
+				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
+				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want:    issued("urn:example:admin-api"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			accessTokens := tokenmock.NewMockGenerator(ctrl)
+			tokens := storagemock.NewMockToken(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(tokens, accessTokens, resources)
+			}
+
+			s := &service{
+				tokens:         tokens,
+				accessTokenGen: accessTokens,
+				resources:      resources,
+				lifetimes:      token.DefaultLifetimePolicy(),
+			}
+			got, err := s.tokenExchange(tt.args.ctx, tt.args.client, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.tokenExchange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.tokenExchange() res = %s", diff)
+			}
+		})
+	}
+}
@@ -23,8 +23,13 @@ import (
 	"fmt"
 	"net/url"
 
+	"google.golang.org/protobuf/proto"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/storage"
 )
 
@@ -56,7 +61,7 @@ func (s *service) Introspect(ctx context.Context, req *tokenv1.IntrospectRequest
 	}
 
 	// Retrieve client information
-	_, err = s.clients.Get(ctx, req.Client.ClientId)
+	client, err := s.clients.Get(ctx, req.Client.ClientId)
 	if err != nil {
 		if !errors.Is(err, storage.ErrNotFound) {
 			res.Error = rfcerrors.ServerError().Build()
@@ -67,23 +72,133 @@ func (s *service) Introspect(ctx context.Context, req *tokenv1.IntrospectRequest
 	}
 
 	// Retrieve token by value
+	var disclosed *tokenv1.Token
 	t, err := s.tokens.GetByValue(ctx, req.Issuer, req.Token)
-	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+	switch {
+	case err != nil && !errors.Is(err, storage.ErrNotFound):
 		res.Error = rfcerrors.ServerError().Build()
 		return res, fmt.Errorf("unable to retrieve to token: %w", err)
+	case err != nil:
+		disclosed = unknownToken(req)
+	case t.GetMetadata().GetClientId() == client.ClientId:
+		// The token owner gets all details
+		disclosed = t
+	default:
+		// Resource servers only get access tokens issued for them
+		resource, err := s.introspectionResource(ctx, client, t)
+		if err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to resolve token resource: %w", err)
+		}
+		if resource == nil {
+			// Undisclosed tokens are reported as inactive
+			disclosed = unknownToken(req)
+		} else {
+			disclosed = discloseClaims(t, resource.IntrospectionClaims)
+		}
 	}
-	if err != nil && errors.Is(err, storage.ErrNotFound) {
-		res.Token = &tokenv1.Token{
-			Issuer: req.Issuer,
-			Value:  req.Token,
-			Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
+
+	// Produce JWT-secured response when requested
+	// https://www.rfc-editor.org/rfc/rfc9701
+	if req.JwtResponse {
+		if types.IsNil(s.introspectionGen) {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("JWT-secured introspection responses are not supported")
 		}
-		return res, nil
+
+		jwt, err := s.introspectionGen.Generate(ctx, req.Issuer, client, disclosed)
+		if err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to generate JWT-secured introspection response: %w", err)
+		}
+		res.Jwt = types.StringRef(jwt)
 	}
 
 	// Return the token
-	res.Token = t
+	res.Token = disclosed
 
 	// No error
 	return res, nil
 }
+
+// -----------------------------------------------------------------------------
+
+// introspectionResource returns the resource targeted by the given token when
+// the client is allowed to introspect it, nil otherwise.
+func (s *service) introspectionResource(ctx context.Context, client *clientv1.Client, t *tokenv1.Token) (*resourcev1.Resource, error) {
+	// Check resource registry
+	if types.IsNil(s.resources) {
+		return nil, nil
+	}
+
+	// Only access tokens are disclosed to resource servers
+	if t.TokenType != tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN || t.GetMetadata().GetAudience() == "" {
+		return nil, nil
+	}
+
+	// Retrieve token audience
+	resource, err := s.resources.GetByURI(ctx, t.Metadata.Audience)
+	switch {
+	case err == nil:
+	case errors.Is(err, storage.ErrNotFound):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unable to retrieve resource '%s': %w", t.Metadata.Audience, err)
+	}
+
+	// Check client registration
+	if !types.StringArray(resource.IntrospectionClients).Contains(client.ClientId) {
+		return nil, nil
+	}
+
+	// No error
+	return resource, nil
+}
+
+// discloseClaims returns a copy of the given token restricted to the given
+// introspection claims. Token identification and validity claims are always
+// disclosed.
+func discloseClaims(t *tokenv1.Token, claims []string) *tokenv1.Token {
+	// Nothing to filter
+	if len(claims) == 0 {
+		return t
+	}
+
+	out := proto.Clone(t).(*tokenv1.Token)
+	allowed := types.StringArray(claims)
+	if !allowed.Contains("sub") {
+		out.Metadata.Subject = ""
+	}
+	if !allowed.Contains("client_id") {
+		out.Metadata.ClientId = ""
+	}
+	if !allowed.Contains("scope") {
+		out.Metadata.Scope = ""
+	}
+	if !allowed.Contains("acr") {
+		out.Metadata.Acr = nil
+	}
+	if !allowed.Contains("auth_time") {
+		out.Metadata.AuthTime = nil
+	}
+	if !allowed.Contains("cnf") {
+		out.Confirmation = nil
+	}
+	if !allowed.Contains("act") {
+		out.Actor = nil
+	}
+	if !allowed.Contains("authorization_details") {
+		out.Metadata.AuthorizationDetails = ""
+	}
+
+	return out
+}
+
+// unknownToken returns the inactive token reported for the given request.
+func unknownToken(req *tokenv1.IntrospectRequest) *tokenv1.Token {
+	return &tokenv1.Token{
+		Issuer: req.Issuer,
+		Value:  req.Token,
+		Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
+	}
+}
@@ -26,10 +26,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
 	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/storage"
 	storagemock "zntr.io/solid/server/storage/mock"
 )
@@ -42,7 +44,7 @@ func Test_service_Introspect(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockClientReader, *storagemock.MockToken)
+		prepare func(*storagemock.MockClientReader, *storagemock.MockToken, *storagemock.MockResourceReader, *tokenmock.MockIntrospectionGenerator)
 		want    *tokenv1.IntrospectResponse
 		wantErr bool
 	}{
@@ -137,7 +139,7 @@ func Test_service_Introspect(t *testing.T) {
 					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
@@ -157,7 +159,7 @@ func Test_service_Introspect(t *testing.T) {
 					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -178,7 +180,7 @@ func Test_service_Introspect(t *testing.T) {
 					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
 				}, nil)
@@ -205,7 +207,7 @@ func Test_service_Introspect(t *testing.T) {
 					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
 				}, nil)
@@ -216,9 +218,132 @@ func Test_service_Introspect(t *testing.T) {
 				Error: rfcerrors.ServerError().Build(),
 			},
 		},
+		{
+			name: "resource storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "0oa2hl2inow5Uqc6c357").Return(&clientv1.Client{
+					ClientId: "0oa2hl2inow5Uqc6c357",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.IntrospectResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "jwt response generator error",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token:       "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					JwtResponse: true,
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *storagemock.MockResourceReader, introspections *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId: "s6BhdRkqt3",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, storage.ErrNotFound)
+				introspections.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com", gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.IntrospectResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
 		// ---------------------------------------------------------------------
 		{
-			name: "valid",
+			name: "valid - owner",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId: "s6BhdRkqt3",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+		},
+		{
+			name: "valid - owner refresh token",
 			args: args{
 				ctx: context.Background(),
 				req: &tokenv1.IntrospectRequest{
@@ -229,13 +354,128 @@ func Test_service_Introspect(t *testing.T) {
 					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
-				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{}, nil)
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId: "s6BhdRkqt3",
+				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
-					Issuer:  "https://honest.as.example.com",
-					Status:  tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
-					TokenId: "123456789",
-					Value:   "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+		},
+		{
+			name: "valid - other client without audience",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "0oa2hl2inow5Uqc6c357").Return(&clientv1.Client{
+					ClientId: "0oa2hl2inow5Uqc6c357",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer: "https://honest.as.example.com",
+					Value:  "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
+				},
+			},
+		},
+		{
+			name: "valid - other client refresh token",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "0oa2hl2inow5Uqc6c357").Return(&clientv1.Client{
+					ClientId: "0oa2hl2inow5Uqc6c357",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
 				}, nil)
 			},
 			wantErr: false,
@@ -243,8 +483,252 @@ func Test_service_Introspect(t *testing.T) {
 				Token: &tokenv1.Token{
 					Issuer: "https://honest.as.example.com",
 					Value:  "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
-					Status: tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
+				},
+			},
+		},
+		{
+			name: "valid - other client unknown resource",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "0oa2hl2inow5Uqc6c357").Return(&clientv1.Client{
+					ClientId: "0oa2hl2inow5Uqc6c357",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer: "https://honest.as.example.com",
+					Value:  "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
+				},
+			},
+		},
+		{
+			name: "valid - other client not registered for resource",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "0oa2hl2inow5Uqc6c357").Return(&clientv1.Client{
+					ClientId: "0oa2hl2inow5Uqc6c357",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{
+					Urn:                  "urn:example:backend-api",
+					IntrospectionClients: []string{"3f7a1c9d2b"},
+				}, nil)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer: "https://honest.as.example.com",
+					Value:  "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
+				},
+			},
+		},
+		{
+			name: "valid - resource server",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "0oa2hl2inow5Uqc6c357").Return(&clientv1.Client{
+					ClientId: "0oa2hl2inow5Uqc6c357",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{
+					Urn:                  "urn:example:backend-api",
+					IntrospectionClients: []string{"0oa2hl2inow5Uqc6c357"},
+				}, nil)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+		},
+		{
+			name: "valid - resource server claim filtering",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, resources *storagemock.MockResourceReader, _ *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "0oa2hl2inow5Uqc6c357").Return(&clientv1.Client{
+					ClientId: "0oa2hl2inow5Uqc6c357",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:               "https://honest.as.example.com",
+						Subject:              "user@example.com",
+						ClientId:             "s6BhdRkqt3",
+						Scope:                "read",
+						Audience:             "urn:example:backend-api",
+						ExpiresAt:            3601,
+						AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+					},
+					Confirmation: &tokenv1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+				resources.EXPECT().GetByURI(gomock.Any(), "urn:example:backend-api").Return(&resourcev1.Resource{
+					Urn:                  "urn:example:backend-api",
+					IntrospectionClients: []string{"0oa2hl2inow5Uqc6c357"},
+					IntrospectionClaims:  []string{"scope"},
+				}, nil)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "",
+						ClientId:  "",
+						Scope:     "read",
+						Audience:  "urn:example:backend-api",
+						ExpiresAt: 3601,
+					},
+				},
+			},
+		},
+		{
+			name: "valid - jwt response",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.IntrospectRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token:       "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					JwtResponse: true,
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *storagemock.MockResourceReader, introspections *tokenmock.MockIntrospectionGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId: "s6BhdRkqt3",
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, storage.ErrNotFound)
+				introspections.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com", gomock.Any(), gomock.Any()).Return("eyJhbGciOiJFUzM4NCIsInR5cCI6InRva2VuLWludHJvc3BlY3Rpb24rand0In0.e30.c2ln", nil)
+			},
+			wantErr: false,
+			want: &tokenv1.IntrospectResponse{
+				Token: &tokenv1.Token{
+					Issuer: "https://honest.as.example.com",
+					Value:  "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Status: tokenv1.TokenStatus_TOKEN_STATUS_UNKNOWN,
 				},
+				Jwt: types.StringRef("eyJhbGciOiJFUzM4NCIsInR5cCI6InRva2VuLWludHJvc3BlY3Rpb24rand0In0.e30.c2ln"),
 			},
 		},
 	}
@@ -257,18 +741,21 @@ func Test_service_Introspect(t *testing.T) {
 			clients := storagemock.NewMockClientReader(ctrl)
 			accessTokens := tokenmock.NewMockGenerator(ctrl)
 			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			idTokens := tokenmock.NewMockIDTokenGenerator(ctrl)
 			tokens := storagemock.NewMockToken(ctrl)
 			authorizationRequests := storagemock.NewMockAuthorizationRequest(ctrl)
 			authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSession(ctrl)
 			deviceCodeSessions := storagemock.NewMockDeviceCodeSession(ctrl)
+			resources := storagemock.NewMockResourceReader(ctrl)
+			introspections := tokenmock.NewMockIntrospectionGenerator(ctrl)
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(clients, tokens)
+				tt.prepare(clients, tokens, resources, introspections)
 			}
 
 			// instantiate service
-			underTest := New(accessTokens, refreshTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, nil)
+			underTest := New(accessTokens, refreshTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, resources, WithIDTokenGenerator(idTokens), WithIntrospectionGenerator(introspections))
 
 			got, err := underTest.Introspect(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,109 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"zntr.io/solid/sdk/token"
+	"zntr.io/solid/server/storage"
+	"zntr.io/solid/server/trust"
+)
+
+// Option is used to enable optional token service features.
+type Option func(*service)
+
+// WithIDTokenGenerator sets the generator used to issue id_token for openid
+// scoped requests.
+func WithIDTokenGenerator(gen token.IDTokenGenerator) Option {
+	return func(s *service) {
+		s.idTokenGen = gen
+	}
+}
+
+// WithPhantomTokens enables the phantom token pattern. references generates
+// the opaque value handed to the client, and signed generates the self-contained
+// JWT or CWT representation returned to resolvers.
+func WithPhantomTokens(references, signed token.Generator) Option {
+	return func(s *service) {
+		s.phantomTokenGen = references
+		s.phantomSignedTokenGen = signed
+	}
+}
+
+// WithIntrospectionGenerator sets the generator used to produce signed
+// introspection responses.
+func WithIntrospectionGenerator(gen token.IntrospectionGenerator) Option {
+	return func(s *service) {
+		s.introspectionGen = gen
+	}
+}
+
+// WithStatusLists enables token status list allocation and publication.
+func WithStatusLists(gen token.StatusListGenerator, lists storage.TokenStatusList) Option {
+	return func(s *service) {
+		s.statusListGen = gen
+		s.statusLists = lists
+	}
+}
+
+// WithLifetimePolicy overrides the default token lifetime policy. Token
+// generators must derive their ceilings from the same policy, see
+// token.WithLifetimePolicy.
+func WithLifetimePolicy(lifetimes token.LifetimePolicy) Option {
+	return func(s *service) {
+		s.lifetimes = lifetimes
+	}
+}
+
+// WithTokenVerifier sets the verifier used to validate subject and actor
+// tokens during token exchange.
+func WithTokenVerifier(verifier token.Verifier) Option {
+	return func(s *service) {
+		s.tokenVerifier = verifier
+	}
+}
+
+// WithTrustedIssuers sets the registry used to validate assertions issued by
+// external identity providers.
+func WithTrustedIssuers(registry trust.Registry) Option {
+	return func(s *service) {
+		s.trustedIssuers = registry
+	}
+}
+
+// WithScopes sets the scope registry used to validate requested scopes.
+func WithScopes(scopes storage.ScopeReader) Option {
+	return func(s *service) {
+		s.scopes = scopes
+	}
+}
+
+// WithAssertionJTIs enables the JWT bearer grant and external assertion
+// exchange by setting the storage used to prevent assertion replay.
+func WithAssertionJTIs(jtis storage.AssertionJTI) Option {
+	return func(s *service) {
+		s.assertionJTIs = jtis
+	}
+}
+
+// WithBackchannelSessions enables the CIBA grant by setting the backchannel
+// authentication session storage.
+func WithBackchannelSessions(sessions storage.BackchannelAuthenticationSession) Option {
+	return func(s *service) {
+		s.backchannelSessions = sessions
+	}
+}
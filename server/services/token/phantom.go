@@ -0,0 +1,143 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+)
+
+// phantomTokenMaxAge bounds how long a resolver can cache the signed
+// representation, so that a revocation is propagated without waiting for the
+// access token expiration.
+const phantomTokenMaxAge = 5 * time.Minute
+
+func (s *service) ResolvePhantom(ctx context.Context, req *tokenv1.PhantomTokenRequest) (*tokenv1.PhantomTokenResponse, error) {
+	res := &tokenv1.PhantomTokenResponse{}
+
+	// Check parameters
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("could not process nil request")
+	}
+	// Check issuer syntax
+	if req.Issuer == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must not be blank")
+	}
+	_, err := url.ParseRequestURI(req.Issuer)
+	if err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must be a valid url: %w", err)
+	}
+	if req.Client == nil {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("no client authentication found")
+	}
+	if req.Token == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("token parameter is mandatory")
+	}
+
+	// Retrieve client information
+	client, err := s.clients.Get(ctx, req.Client.ClientId)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidClient().Build()
+		}
+		return res, fmt.Errorf("unable to retrieve client details: %w", err)
+	}
+
+	// Validate client capabilities
+	if !client.PhantomTokenResolver {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("client '%s' is not allowed to resolve phantom tokens", client.ClientId)
+	}
+
+	// Retrieve token by value
+	t, err := s.tokens.GetByValue(ctx, req.Issuer, req.Token)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.ServerError().Build()
+		} else {
+			res.Error = rfcerrors.InvalidToken().Build()
+		}
+		return res, fmt.Errorf("unable to retrieve token: %w", err)
+	}
+
+	// Check token
+	if t.TokenType != tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN {
+		res.Error = rfcerrors.InvalidToken().Build()
+		return res, fmt.Errorf("only access tokens can be resolved")
+	}
+	if t.Status != tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE {
+		res.Error = rfcerrors.InvalidToken().Build()
+		return res, fmt.Errorf("token is not active")
+	}
+	if t.Metadata == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("token doesn't have metadata")
+	}
+
+	// If expired
+	now := uint64(timeFunc().Unix())
+	if t.Metadata.ExpiresAt <= now {
+		res.Error = rfcerrors.InvalidToken().Build()
+		return res, fmt.Errorf("token is expired")
+	}
+
+	// Check phantom token support
+	if types.IsNil(s.phantomSignedTokenGen) {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("phantom token signed representation generator is not configured")
+	}
+
+	// Generate the signed representation
+	phantom, err := s.phantomSignedTokenGen.Generate(ctx, t)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to generate the signed representation: %w", err)
+	}
+	if phantom == "" {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("phantomSignedTokenGenerator generated an empty value")
+	}
+
+	// Assign token, the representation can be cached until token expiration
+	// within the phantom token cache limit.
+	t.Value = req.Token
+	t.Phantom = types.StringRef(phantom)
+	res.Token = t
+	res.MaxAge = t.Metadata.ExpiresAt - now
+	if maxAge := uint64(phantomTokenMaxAge.Seconds()); res.MaxAge > maxAge {
+		res.MaxAge = maxAge
+	}
+
+	// No error
+	return res, nil
+}
@@ -0,0 +1,427 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
+	"zntr.io/solid/sdk/token/jwt"
+	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+	storagemock "zntr.io/solid/server/storage/mock"
+)
+
+func Test_service_ResolvePhantom(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tokenv1.PhantomTokenRequest
+	}
+
+	request := &tokenv1.PhantomTokenRequest{
+		Issuer: "https://honest.as.example.com",
+		Client: &clientv1.Client{
+			ClientId: "gateway",
+		},
+		Token: "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+	}
+	gateway := &clientv1.Client{
+		ClientId:             "gateway",
+		PhantomTokenResolver: true,
+	}
+	accessToken := func(status tokenv1.TokenStatus, expiresAt uint64) *tokenv1.Token {
+		return &tokenv1.Token{
+			Issuer:    "https://honest.as.example.com",
+			TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			TokenId:   "123456789",
+			Status:    status,
+			Value:     "hashed-value",
+			Metadata: &tokenv1.TokenMeta{
+				Issuer:    "https://honest.as.example.com",
+				Subject:   "user@example.com",
+				ClientId:  "s6BhdRkqt3",
+				ExpiresAt: expiresAt,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockClientReader, *storagemock.MockToken, *tokenmock.MockGenerator)
+		want    *tokenv1.PhantomTokenResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.PhantomTokenRequest{
+					Issuer: "foo",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "nil client authentication",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.PhantomTokenRequest{
+					Issuer: "https://honest.as.example.com",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "empty token",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.PhantomTokenRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{},
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "client not found",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockToken, _ *tokenmock.MockGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "client not allowed to resolve",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockToken, _ *tokenmock.MockGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(&clientv1.Client{ClientId: "gateway"}, nil)
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
+		{
+			name: "token not found",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *tokenmock.MockGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "token storage error",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *tokenmock.MockGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "refresh token",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *tokenmock.MockGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				rt := accessToken(tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, 3601)
+				rt.TokenType = tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(rt, nil)
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "revoked token",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *tokenmock.MockGenerator) {
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(accessToken(tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, 3601), nil)
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "expired token",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, _ *tokenmock.MockGenerator) {
+				timeFunc = func() time.Time { return time.Unix(3601, 0) }
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(accessToken(tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, 3601), nil)
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "generator error",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, phantoms *tokenmock.MockGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(accessToken(tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, 3601), nil)
+				phantoms.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.PhantomTokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, phantoms *tokenmock.MockGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(accessToken(tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, 3601), nil)
+				phantoms.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("eyJhbGciOiJFUzM4NCJ9.e30.c2lnbmF0dXJl", nil)
+			},
+			wantErr: false,
+			want: &tokenv1.PhantomTokenResponse{
+				Token: &tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Value:     "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Phantom:   types.StringRef("eyJhbGciOiJFUzM4NCJ9.e30.c2lnbmF0dXJl"),
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						ExpiresAt: 3601,
+					},
+				},
+				MaxAge: 300,
+			},
+		},
+		{
+			name: "valid: expires before cache limit",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken, phantoms *tokenmock.MockGenerator) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				clients.EXPECT().Get(gomock.Any(), "gateway").Return(gateway, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(accessToken(tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, 61), nil)
+				phantoms.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("eyJhbGciOiJFUzM4NCJ9.e30.c2lnbmF0dXJl", nil)
+			},
+			wantErr: false,
+			want: &tokenv1.PhantomTokenResponse{
+				Token: &tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Value:     "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Phantom:   types.StringRef("eyJhbGciOiJFUzM4NCJ9.e30.c2lnbmF0dXJl"),
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "https://honest.as.example.com",
+						Subject:   "user@example.com",
+						ClientId:  "s6BhdRkqt3",
+						ExpiresAt: 61,
+					},
+				},
+				MaxAge: 60,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			clients := storagemock.NewMockClientReader(ctrl)
+			accessTokens := tokenmock.NewMockGenerator(ctrl)
+			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			phantomTokens := tokenmock.NewMockGenerator(ctrl)
+			signedPhantomTokens := tokenmock.NewMockGenerator(ctrl)
+			tokens := storagemock.NewMockToken(ctrl)
+			authorizationRequests := storagemock.NewMockAuthorizationRequest(ctrl)
+			authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSession(ctrl)
+			deviceCodeSessions := storagemock.NewMockDeviceCodeSession(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(clients, tokens, signedPhantomTokens)
+			}
+
+			// instantiate service
+			underTest := New(accessTokens, refreshTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, nil, WithPhantomTokens(phantomTokens, signedPhantomTokens))
+
+			got, err := underTest.ResolvePhantom(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.ResolvePhantom() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.ResolvePhantom() res = %s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_ResolvePhantom_SignedRepresentation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	keys := func(_ context.Context) (*jose.JSONWebKey, error) {
+		return &jose.JSONWebKey{Key: pk, KeyID: "phantom", Use: "sig", Algorithm: string(jose.ES384)}, nil
+	}
+	keySet := func(_ context.Context) (*jose.JSONWebKeySet, error) {
+		return &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: pk.Public(), KeyID: "phantom", Use: "sig", Algorithm: string(jose.ES384)}}}, nil
+	}
+
+	now := time.Now()
+	timeFunc = func() time.Time { return now }
+	at := &tokenv1.Token{
+		Issuer:    "https://honest.as.example.com",
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+		TokenId:   "123456789",
+		Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		Value:     "hashed-value",
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    "https://honest.as.example.com",
+			Subject:   "user@example.com",
+			Audience:  "https://api.example.com",
+			ClientId:  "s6BhdRkqt3",
+			Scope:     "openid",
+			IssuedAt:  uint64(now.Unix()),
+			NotBefore: uint64(now.Unix()),
+			ExpiresAt: uint64(now.Add(time.Hour).Unix()),
+		},
+	}
+
+	// Arm mocks
+	clients := storagemock.NewMockClientReader(ctrl)
+	tokens := storagemock.NewMockToken(ctrl)
+	clients.EXPECT().Get(gomock.Any(), "gateway").Return(&clientv1.Client{ClientId: "gateway", PhantomTokenResolver: true}, nil)
+	tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(at, nil)
+
+	// instantiate service
+	underTest := New(nil, nil, clients, nil, nil, nil, tokens, nil, WithPhantomTokens(token.OpaqueToken(), token.AccessToken(jwt.AccessTokenSigner(jose.ES384, keys))))
+
+	res, err := underTest.ResolvePhantom(context.Background(), &tokenv1.PhantomTokenRequest{
+		Issuer: "https://honest.as.example.com",
+		Client: &clientv1.Client{ClientId: "gateway"},
+		Token:  "sldpt_cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+	})
+	if err != nil {
+		t.Fatalf("unable to resolve phantom token: %v", err)
+	}
+
+	// Verify the signed representation
+	verifier := jwt.DefaultVerifier(keySet, []jose.SignatureAlgorithm{jose.ES384})
+	if err := verifier.Verify(res.Token.GetPhantom()); err != nil {
+		t.Fatalf("signed representation must be verifiable: %v", err)
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+		JTI     string `json:"jti"`
+	}
+	if err := verifier.Claims(context.Background(), res.Token.GetPhantom(), &claims); err != nil {
+		t.Fatalf("unable to decode signed representation claims: %v", err)
+	}
+	if claims.Subject != "user@example.com" || claims.JTI != "123456789" {
+		t.Errorf("unexpected signed representation claims: %+v", claims)
+	}
+	if res.MaxAge != 300 {
+		t.Errorf("unexpected max age: %d", res.MaxAge)
+	}
+}
@@ -0,0 +1,49 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+const (
+	// defaultPollingInterval defines the polling interval in seconds applied
+	// to sessions created without one.
+	defaultPollingInterval = 5
+	// slowDownIncrement defines the polling interval increment in seconds
+	// applied on each slow_down error.
+	// https://www.rfc-editor.org/rfc/rfc8628#section-3.5
+	slowDownIncrement = 5
+)
+
+// slowDown records the poll time and reports whether the client polled before
+// the end of the current interval, the interval is then increased for this
+// and all subsequent requests.
+func slowDown(lastPolledAt, interval *uint64, now uint64) bool {
+	// Apply default interval
+	if *interval == 0 {
+		*interval = defaultPollingInterval
+	}
+
+	// Check polling frequency
+	tooFast := *lastPolledAt > 0 && now < *lastPolledAt+*interval
+	if tooFast {
+		*interval += slowDownIncrement
+	}
+
+	// Record poll time
+	*lastPolledAt = now
+
+	return tooFast
+}
@@ -0,0 +1,89 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import "testing"
+
+func Test_slowDown(t *testing.T) {
+	tests := []struct {
+		name             string
+		lastPolledAt     uint64
+		interval         uint64
+		now              uint64
+		want             bool
+		wantLastPolledAt uint64
+		wantInterval     uint64
+	}{
+		{
+			name:             "first poll",
+			now:              10,
+			interval:         5,
+			want:             false,
+			wantLastPolledAt: 10,
+			wantInterval:     5,
+		},
+		{
+			name:             "first poll without interval",
+			now:              10,
+			want:             false,
+			wantLastPolledAt: 10,
+			wantInterval:     defaultPollingInterval,
+		},
+		{
+			name:             "interval respected",
+			lastPolledAt:     10,
+			interval:         5,
+			now:              15,
+			want:             false,
+			wantLastPolledAt: 15,
+			wantInterval:     5,
+		},
+		{
+			name:             "too fast",
+			lastPolledAt:     10,
+			interval:         5,
+			now:              14,
+			want:             true,
+			wantLastPolledAt: 14,
+			wantInterval:     10,
+		},
+		{
+			name:             "too fast after escalation",
+			lastPolledAt:     14,
+			interval:         10,
+			now:              20,
+			want:             true,
+			wantLastPolledAt: 20,
+			wantInterval:     15,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lastPolledAt, interval := tt.lastPolledAt, tt.interval
+			if got := slowDown(&lastPolledAt, &interval, tt.now); got != tt.want {
+				t.Errorf("slowDown() = %v, want %v", got, tt.want)
+			}
+			if lastPolledAt != tt.wantLastPolledAt {
+				t.Errorf("slowDown() lastPolledAt = %v, want %v", lastPolledAt, tt.wantLastPolledAt)
+			}
+			if interval != tt.wantInterval {
+				t.Errorf("slowDown() interval = %v, want %v", interval, tt.wantInterval)
+			}
+		})
+	}
+}
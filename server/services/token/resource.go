@@ -0,0 +1,72 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/resourceindicator"
+)
+
+// requestedTargets resolves the audience and resource indicators of the token
+// request against the resource registry.
+func (s *service) requestedTargets(ctx context.Context, req *flowv1.TokenRequest, res *flowv1.TokenResponse) ([]string, error) {
+	audience := ""
+	if req.Audience != nil {
+		audience = *req.Audience
+	}
+
+	// Resolve from registry
+	targets, err := resourceindicator.Resolve(ctx, s.resources, audience, req.Resource)
+	if err != nil {
+		if errors.Is(err, resourceindicator.ErrInvalidTarget) {
+			res.Error = rfcerrors.InvalidTarget().Build()
+		} else {
+			res.Error = rfcerrors.ServerError().Build()
+		}
+		return nil, fmt.Errorf("unable to resolve requested resources: %w", err)
+	}
+
+	// No error
+	return targets, nil
+}
+
+// accessTokenTarget returns the resource targeted by an access token. Access
+// tokens target only one resource, which must be part of the granted ones. The
+// fallback is used when no resource is requested.
+func accessTokenTarget(requested, granted []string, fallback string, res *flowv1.TokenResponse) (string, error) {
+	switch {
+	case len(requested) == 0:
+		return fallback, nil
+	case len(requested) > 1:
+		res.Error = rfcerrors.InvalidTarget().Build()
+		return "", fmt.Errorf("access token can only target one resource")
+	case !types.StringArray(granted).Contains(requested[0]):
+		res.Error = rfcerrors.InvalidTarget().Build()
+		return "", fmt.Errorf("resource '%s' has not been granted", requested[0])
+	default:
+	}
+
+	// No error
+	return requested[0], nil
+}
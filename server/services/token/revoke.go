@@ -19,6 +19,7 @@ package token
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -55,7 +56,7 @@ func (s *service) Revoke(ctx context.Context, req *tokenv1.RevokeRequest) (*toke
 	}
 
 	// Retrieve client information
-	_, err = s.clients.Get(ctx, req.Client.ClientId)
+	client, err := s.clients.Get(ctx, req.Client.ClientId)
 	if err != nil {
 		if err != storage.ErrNotFound {
 			res.Error = rfcerrors.ServerError().Build()
@@ -65,15 +66,152 @@ func (s *service) Revoke(ctx context.Context, req *tokenv1.RevokeRequest) (*toke
 		return res, fmt.Errorf("unable to retrieve client details: %w", err)
 	}
 
-	// Retrieve token by value
+	// Retrieve token by value, the token_type_hint is ignored since the token
+	// type is resolved from the storage.
+	// https://www.rfc-editor.org/rfc/rfc7009#section-2.1
 	t, err := s.tokens.GetByValue(ctx, req.Issuer, req.Token)
-	if err != nil {
-		return res, fmt.Errorf("unable to retrieve token '%s': %w", req.Token, err)
+	switch {
+	case err == nil:
+	case errors.Is(err, storage.ErrNotFound):
+		// Invalid tokens do not cause an error response
+		// https://www.rfc-editor.org/rfc/rfc7009#section-2.2
+		return res, nil
+	default:
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("client '%s' unable to retrieve token to revoke: %w", client.ClientId, err)
+	}
+
+	// Check token type
+	if t.TokenType != tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN && t.TokenType != tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN {
+		res.Error = rfcerrors.UnsupportedTokenType().Build()
+		return res, fmt.Errorf("client '%s' tried to revoke token '%s' of unsupported type '%s'", client.ClientId, t.TokenId, t.TokenType)
+	}
+
+	// Check token ownership
+	// https://www.rfc-editor.org/rfc/rfc7009#section-2.1
+	if t.GetMetadata().GetClientId() != client.ClientId {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return res, fmt.Errorf("client '%s' tried to revoke token '%s' issued to another client", client.ClientId, t.TokenId)
+	}
+
+	// Already revoked token
+	if t.Status == tokenv1.TokenStatus_TOKEN_STATUS_REVOKED {
+		return res, nil
 	}
 
 	// Update token status
 	if err := s.tokens.Revoke(ctx, req.Issuer, t.TokenId); err != nil {
-		return res, fmt.Errorf("unable to revoke token '%s': %w", req.Token, err)
+		if errors.Is(err, storage.ErrNotFound) {
+			// Token expired in the meantime
+			return res, nil
+		}
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("client '%s' unable to revoke token '%s': %w", client.ClientId, t.TokenId, err)
+	}
+
+	// Refresh token revocation cascades to the tokens issued from the same grant
+	// https://www.rfc-editor.org/rfc/rfc7009#section-2.1
+	if t.TokenType == tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN && t.GrantId != "" {
+		if err := s.tokens.RevokeByGrant(ctx, req.Issuer, t.GrantId); err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("client '%s' unable to revoke token family '%s': %w", client.ClientId, t.GrantId, err)
+		}
+	}
+
+	// No error
+	return res, nil
+}
+
+func (s *service) RevokeBySubject(ctx context.Context, req *tokenv1.RevokeBySubjectRequest) (*tokenv1.RevokeBySubjectResponse, error) {
+	res := &tokenv1.RevokeBySubjectResponse{}
+
+	// Check parameters
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("could not process nil request")
+	}
+	// Check issuer syntax
+	if req.Issuer == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must not be blank")
+	}
+	if _, err := url.ParseRequestURI(req.Issuer); err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must be a valid url: %w", err)
+	}
+	if req.Subject == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("subject parameter is mandatory")
+	}
+
+	// Revoke all subject tokens
+	if err := s.tokens.RevokeBySubject(ctx, req.Issuer, req.Subject); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to revoke tokens of subject '%s': %w", req.Subject, err)
+	}
+
+	// No error
+	return res, nil
+}
+
+func (s *service) RevokeByClient(ctx context.Context, req *tokenv1.RevokeByClientRequest) (*tokenv1.RevokeByClientResponse, error) {
+	res := &tokenv1.RevokeByClientResponse{}
+
+	// Check parameters
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("could not process nil request")
+	}
+	// Check issuer syntax
+	if req.Issuer == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must not be blank")
+	}
+	if _, err := url.ParseRequestURI(req.Issuer); err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must be a valid url: %w", err)
+	}
+	if req.ClientId == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("client_id parameter is mandatory")
+	}
+
+	// Revoke all client tokens
+	if err := s.tokens.RevokeByClient(ctx, req.Issuer, req.ClientId); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to revoke tokens of client '%s': %w", req.ClientId, err)
+	}
+
+	// No error
+	return res, nil
+}
+
+func (s *service) RevokeByGrant(ctx context.Context, req *tokenv1.RevokeByGrantRequest) (*tokenv1.RevokeByGrantResponse, error) {
+	res := &tokenv1.RevokeByGrantResponse{}
+
+	// Check parameters
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("could not process nil request")
+	}
+	// Check issuer syntax
+	if req.Issuer == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must not be blank")
+	}
+	if _, err := url.ParseRequestURI(req.Issuer); err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must be a valid url: %w", err)
+	}
+	if req.GrantId == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("grant_id parameter is mandatory")
+	}
+
+	// Revoke the whole token family
+	if err := s.tokens.RevokeByGrant(ctx, req.Issuer, req.GrantId); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to revoke token family '%s': %w", req.GrantId, err)
 	}
 
 	// No error
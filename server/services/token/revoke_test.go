@@ -30,6 +30,7 @@ import (
 	"zntr.io/solid/oidc"
 	"zntr.io/solid/sdk/rfcerrors"
 	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
 	"zntr.io/solid/server/storage"
 	storagemock "zntr.io/solid/server/storage/mock"
 )
@@ -180,11 +181,12 @@ func Test_service_Revoke(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
 				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, storage.ErrNotFound)
 			},
-			wantErr: true,
+			wantErr: false,
 			want:    &tokenv1.RevokeResponse{},
 		},
 		{
@@ -201,12 +203,81 @@ func Test_service_Revoke(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
 				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
-			want:    &tokenv1.RevokeResponse{},
+			want: &tokenv1.RevokeResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "unsupported token type",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeResponse{
+				Error: rfcerrors.UnsupportedTokenType().Build(),
+			},
+		},
+		{
+			name: "token issued to another client",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "0oa2hl2inow5Uqc6c357",
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
 		},
 		{
 			name: "revoke storage error",
@@ -221,17 +292,62 @@ func Test_service_Revoke(t *testing.T) {
 				},
 			},
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
-				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{}, nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
-					Issuer:  "https://honest.as.example.com",
-					Status:  tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
-					TokenId: "123456789",
-					Value:   "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
 				}, nil)
 				tokens.EXPECT().Revoke(gomock.Any(), "https://honest.as.example.com", "123456789").Return(fmt.Errorf("foo"))
 			},
 			wantErr: true,
-			want:    &tokenv1.RevokeResponse{},
+			want: &tokenv1.RevokeResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "refresh token family revocation error",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					GrantId:   "vRt8cGm5EJpbGXbw",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
+				}, nil)
+				tokens.EXPECT().Revoke(gomock.Any(), "https://honest.as.example.com", "123456789").Return(nil)
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "https://honest.as.example.com", "vRt8cGm5EJpbGXbw").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
 		},
 		// ---------------------------------------------------------------------
 		{
@@ -247,18 +363,189 @@ func Test_service_Revoke(t *testing.T) {
 				},
 			},
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
-				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{}, nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
-					Issuer:  "https://honest.as.example.com",
-					Status:  tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
-					TokenId: "123456789",
-					Value:   "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
 				}, nil)
 				tokens.EXPECT().Revoke(gomock.Any(), "https://honest.as.example.com", "123456789").Return(nil)
 			},
 			wantErr: false,
 			want:    &tokenv1.RevokeResponse{},
 		},
+		{
+			name: "valid - already revoked",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
+				}, nil)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeResponse{},
+		},
+		{
+			name: "valid - expired during revocation",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
+				}, nil)
+				tokens.EXPECT().Revoke(gomock.Any(), "https://honest.as.example.com", "123456789").Return(storage.ErrNotFound)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeResponse{},
+		},
+		{
+			name: "valid - token type hint mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token:         "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					TokenTypeHint: types.StringRef("refresh_token"),
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
+				}, nil)
+				tokens.EXPECT().Revoke(gomock.Any(), "https://honest.as.example.com", "123456789").Return(nil)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeResponse{},
+		},
+		{
+			name: "valid - unknown token type hint ignored",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token:         "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					TokenTypeHint: types.StringRef("id_token"),
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
+				}, nil)
+				tokens.EXPECT().Revoke(gomock.Any(), "https://honest.as.example.com", "123456789").Return(nil)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeResponse{},
+		},
+		{
+			name: "valid - refresh token cascades to token family",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeRequest{
+					Issuer: "https://honest.as.example.com",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Token:         "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					TokenTypeHint: types.StringRef("refresh_token"),
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
+					ClientId:   "s6BhdRkqt3",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				}, nil)
+				tokens.EXPECT().GetByValue(gomock.Any(), "https://honest.as.example.com", "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&tokenv1.Token{
+					Issuer:    "https://honest.as.example.com",
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					TokenId:   "123456789",
+					GrantId:   "vRt8cGm5EJpbGXbw",
+					Value:     "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
+					Metadata: &tokenv1.TokenMeta{
+						ClientId: "s6BhdRkqt3",
+					},
+				}, nil)
+				tokens.EXPECT().Revoke(gomock.Any(), "https://honest.as.example.com", "123456789").Return(nil)
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "https://honest.as.example.com", "vRt8cGm5EJpbGXbw").Return(nil)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeResponse{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -269,6 +556,7 @@ func Test_service_Revoke(t *testing.T) {
 			clients := storagemock.NewMockClientReader(ctrl)
 			accessTokens := tokenmock.NewMockGenerator(ctrl)
 			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			idTokens := tokenmock.NewMockIDTokenGenerator(ctrl)
 			tokens := storagemock.NewMockToken(ctrl)
 			authorizationRequests := storagemock.NewMockAuthorizationRequest(ctrl)
 			authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSession(ctrl)
@@ -280,7 +568,7 @@ func Test_service_Revoke(t *testing.T) {
 			}
 
 			// instantiate service
-			underTest := New(accessTokens, refreshTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, nil)
+			underTest := New(accessTokens, refreshTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, nil, WithIDTokenGenerator(idTokens))
 
 			got, err := underTest.Revoke(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -293,3 +581,336 @@ func Test_service_Revoke(t *testing.T) {
 		})
 	}
 }
+
+func Test_service_RevokeBySubject(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tokenv1.RevokeBySubjectRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockToken)
+		want    *tokenv1.RevokeBySubjectResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeBySubjectResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeBySubjectRequest{
+					Issuer:  "foo",
+					Subject: "user@example.com",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeBySubjectResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty subject",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeBySubjectRequest{
+					Issuer: "https://honest.as.example.com",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeBySubjectResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeBySubjectRequest{
+					Issuer:  "https://honest.as.example.com",
+					Subject: "user@example.com",
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken) {
+				tokens.EXPECT().RevokeBySubject(gomock.Any(), "https://honest.as.example.com", "user@example.com").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeBySubjectResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeBySubjectRequest{
+					Issuer:  "https://honest.as.example.com",
+					Subject: "user@example.com",
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken) {
+				tokens.EXPECT().RevokeBySubject(gomock.Any(), "https://honest.as.example.com", "user@example.com").Return(nil)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeBySubjectResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			tokens := storagemock.NewMockToken(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(tokens)
+			}
+
+			// instantiate service
+			underTest := New(nil, nil, nil, nil, nil, nil, tokens, nil)
+
+			got, err := underTest.RevokeBySubject(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.RevokeBySubject() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.RevokeBySubject() res = %s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_RevokeByClient(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tokenv1.RevokeByClientRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockToken)
+		want    *tokenv1.RevokeByClientResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByClientResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByClientRequest{
+					Issuer:   "foo",
+					ClientId: "s6BhdRkqt3",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByClientResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty client id",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByClientRequest{
+					Issuer: "https://honest.as.example.com",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByClientResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByClientRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken) {
+				tokens.EXPECT().RevokeByClient(gomock.Any(), "https://honest.as.example.com", "s6BhdRkqt3").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByClientResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByClientRequest{
+					Issuer:   "https://honest.as.example.com",
+					ClientId: "s6BhdRkqt3",
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken) {
+				tokens.EXPECT().RevokeByClient(gomock.Any(), "https://honest.as.example.com", "s6BhdRkqt3").Return(nil)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeByClientResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			tokens := storagemock.NewMockToken(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(tokens)
+			}
+
+			// instantiate service
+			underTest := New(nil, nil, nil, nil, nil, nil, tokens, nil)
+
+			got, err := underTest.RevokeByClient(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.RevokeByClient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.RevokeByClient() res = %s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_RevokeByGrant(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tokenv1.RevokeByGrantRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockToken)
+		want    *tokenv1.RevokeByGrantResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByGrantResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByGrantRequest{
+					Issuer:  "foo",
+					GrantId: "vRt8cGm5EJpbGXbw",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByGrantResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty grant id",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByGrantRequest{
+					Issuer: "https://honest.as.example.com",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByGrantResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByGrantRequest{
+					Issuer:  "https://honest.as.example.com",
+					GrantId: "vRt8cGm5EJpbGXbw",
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken) {
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "https://honest.as.example.com", "vRt8cGm5EJpbGXbw").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.RevokeByGrantResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.RevokeByGrantRequest{
+					Issuer:  "https://honest.as.example.com",
+					GrantId: "vRt8cGm5EJpbGXbw",
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken) {
+				tokens.EXPECT().RevokeByGrant(gomock.Any(), "https://honest.as.example.com", "vRt8cGm5EJpbGXbw").Return(nil)
+			},
+			wantErr: false,
+			want:    &tokenv1.RevokeByGrantResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			tokens := storagemock.NewMockToken(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(tokens)
+			}
+
+			// instantiate service
+			underTest := New(nil, nil, nil, nil, nil, nil, tokens, nil)
+
+			got, err := underTest.RevokeByGrant(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.RevokeByGrant() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.RevokeByGrant() res = %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	corev1 "zntr.io/solid/api/oidc/core/v1"
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/server/scopepolicy"
+)
+
+// requestedScope validates the scope requested by the client against its
+// allowed scopes and the scope registry.
+func (s *service) requestedScope(ctx context.Context, client *clientv1.Client, req *flowv1.TokenRequest, res *flowv1.TokenResponse) (string, error) {
+	// Nothing requested
+	if req.Scope == nil {
+		return "", nil
+	}
+
+	// Apply scope policy
+	scope, err := scopepolicy.Authorize(ctx, s.scopes, client, *req.Scope)
+	if err != nil {
+		res.Error = scopeError(err)
+		return "", fmt.Errorf("unable to authorize requested scope: %w", err)
+	}
+
+	// No error
+	return scope, nil
+}
+
+// narrowedScope returns the scope of a token derived from a previous grant.
+// The requested scope must be a subset of the granted one and allowed for the
+// client, the granted scope is restricted to the client allowed scopes when
+// nothing is requested.
+func (s *service) narrowedScope(ctx context.Context, client *clientv1.Client, granted string, req *flowv1.TokenRequest, res *flowv1.TokenResponse) (string, error) {
+	// Only downscoping is allowed
+	scope, err := scopepolicy.Downscope(granted, req.Scope)
+	if err != nil {
+		res.Error = scopeError(err)
+		return "", fmt.Errorf("unable to downscope granted scope: %w", err)
+	}
+
+	// Apply scope policy
+	if req.Scope != nil {
+		scope, err = scopepolicy.Authorize(ctx, s.scopes, client, scope)
+	} else {
+		scope, err = scopepolicy.Intersect(ctx, s.scopes, client, scope)
+	}
+	if err != nil {
+		res.Error = scopeError(err)
+		return "", fmt.Errorf("unable to authorize granted scope: %w", err)
+	}
+
+	// No error
+	return scope, nil
+}
+
+// scopeError maps a scope policy error to its rfc error.
+func scopeError(err error) *corev1.Error {
+	if errors.Is(err, scopepolicy.ErrInvalidScope) {
+		return rfcerrors.InvalidScope().Build()
+	}
+	return rfcerrors.ServerError().Build()
+}
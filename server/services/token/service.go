@@ -27,24 +27,38 @@ import (
 	"zntr.io/solid/sdk/token"
 	"zntr.io/solid/server/services"
 	"zntr.io/solid/server/storage"
+	"zntr.io/solid/server/trust"
 )
 
 type service struct {
 	accessTokenGen            token.Generator
 	refreshTokenGen           token.Generator
+	idTokenGen                token.IDTokenGenerator
+	phantomTokenGen           token.Generator
+	phantomSignedTokenGen     token.Generator
+	introspectionGen          token.IntrospectionGenerator
+	statusListGen             token.StatusListGenerator
+	lifetimes                 token.LifetimePolicy
+	tokenVerifier             token.Verifier
+	trustedIssuers            trust.Registry
 	clients                   storage.ClientReader
 	authorizationRequests     storage.AuthorizationRequestReader
 	authorizationCodeSessions storage.AuthorizationCodeSession
 	deviceCodeSessions        storage.DeviceCodeSession
 	tokens                    storage.Token
 	resources                 storage.ResourceReader
+	scopes                    storage.ScopeReader
+	assertionJTIs             storage.AssertionJTI
+	statusLists               storage.TokenStatusList
+	backchannelSessions       storage.BackchannelAuthenticationSession
 }
 
 // New build and returns an authorization service implementation.
-func New(accessTokenGen token.Generator, refreshTokenGen token.Generator, clients storage.ClientReader, authorizationRequests storage.AuthorizationRequestReader, authorizationCodeSessions storage.AuthorizationCodeSession, deviceCodeSessions storage.DeviceCodeSession, tokens storage.Token, resources storage.ResourceReader) services.Token {
-	return &service{
+func New(accessTokenGen, refreshTokenGen token.Generator, clients storage.ClientReader, authorizationRequests storage.AuthorizationRequestReader, authorizationCodeSessions storage.AuthorizationCodeSession, deviceCodeSessions storage.DeviceCodeSession, tokens storage.Token, resources storage.ResourceReader, opts ...Option) services.Token {
+	s := &service{
 		accessTokenGen:            accessTokenGen,
 		refreshTokenGen:           refreshTokenGen,
+		lifetimes:                 token.DefaultLifetimePolicy(),
 		clients:                   clients,
 		authorizationRequests:     authorizationRequests,
 		authorizationCodeSessions: authorizationCodeSessions,
@@ -52,6 +66,11 @@ func New(accessTokenGen token.Generator, refreshTokenGen token.Generator, client
 		tokens:                    tokens,
 		resources:                 resources,
 	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
 }
 
 // ----------------------------------------------------------------------------
@@ -88,6 +107,10 @@ func (s *service) Token(ctx context.Context, req *flowv1.TokenRequest) (*flowv1.
 		res, err = s.refreshToken(ctx, client, req)
 	case oidc.GrantTypeTokenExchange:
 		res, err = s.tokenExchange(ctx, client, req)
+	case oidc.GrantTypeJWTBearer:
+		res, err = s.jwtBearer(ctx, client, req)
+	case oidc.GrantTypeCIBA:
+		res, err = s.ciba(ctx, client, req)
 	default:
 		// Validated by the front validator but added for defensive principle.
 		res.Error = rfcerrors.InvalidGrant().Build()
@@ -41,7 +41,7 @@ import (
 	storagemock "zntr.io/solid/server/storage/mock"
 )
 
-var cmpOpts = []cmp.Option{cmpopts.IgnoreFields(tokenv1.Token{}, "TokenId"), cmpopts.IgnoreUnexported(wrappers.StringValue{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest{}), cmpopts.IgnoreUnexported(tokenv1.IntrospectRequest{}), cmpopts.IgnoreUnexported(tokenv1.RevokeRequest{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_AuthorizationCode{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_ClientCredentials{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_DeviceCode{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_RefreshToken{}), cmpopts.IgnoreUnexported(flowv1.TokenResponse{}), cmpopts.IgnoreUnexported(tokenv1.IntrospectResponse{}), cmpopts.IgnoreUnexported(tokenv1.RevokeResponse{}), cmpopts.IgnoreUnexported(corev1.Error{}), cmpopts.IgnoreUnexported(tokenv1.Token{}), cmpopts.IgnoreUnexported(tokenv1.TokenMeta{}), cmpopts.IgnoreUnexported(sessionv1.AuthorizationCodeSession{}), cmpopts.IgnoreUnexported(sessionv1.DeviceCodeSession{})}
+var cmpOpts = []cmp.Option{cmpopts.IgnoreFields(tokenv1.Token{}, "TokenId", "GrantId"), cmpopts.IgnoreUnexported(wrappers.StringValue{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest{}), cmpopts.IgnoreUnexported(tokenv1.IntrospectRequest{}), cmpopts.IgnoreUnexported(tokenv1.RevokeRequest{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_AuthorizationCode{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_ClientCredentials{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_DeviceCode{}), cmpopts.IgnoreUnexported(flowv1.TokenRequest_RefreshToken{}), cmpopts.IgnoreUnexported(flowv1.TokenResponse{}), cmpopts.IgnoreUnexported(tokenv1.IntrospectResponse{}), cmpopts.IgnoreUnexported(tokenv1.RevokeResponse{}), cmpopts.IgnoreUnexported(tokenv1.RevokeBySubjectResponse{}), cmpopts.IgnoreUnexported(tokenv1.RevokeByClientResponse{}), cmpopts.IgnoreUnexported(tokenv1.RevokeByGrantResponse{}), cmpopts.IgnoreUnexported(tokenv1.PhantomTokenResponse{}), cmpopts.IgnoreUnexported(tokenv1.StatusListResponse{}), cmpopts.IgnoreUnexported(tokenv1.TokenStatusReference{}), cmpopts.IgnoreUnexported(corev1.Error{}), cmpopts.IgnoreUnexported(tokenv1.Token{}), cmpopts.IgnoreUnexported(tokenv1.TokenMeta{}), cmpopts.IgnoreUnexported(tokenv1.TokenConfirmation{}), cmpopts.IgnoreUnexported(tokenv1.Actor{}), cmpopts.IgnoreUnexported(sessionv1.AuthorizationCodeSession{}), cmpopts.IgnoreUnexported(sessionv1.DeviceCodeSession{})}
 
 func Test_service_Token(t *testing.T) {
 	type args struct {
@@ -51,7 +51,7 @@ func Test_service_Token(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		prepare func(*storagemock.MockClientReader, *storagemock.MockAuthorizationRequestReader, *tokenmock.MockGenerator, *tokenmock.MockGenerator, *storagemock.MockAuthorizationCodeSession, *storagemock.MockDeviceCodeSession, *storagemock.MockToken)
+		prepare func(*storagemock.MockClientReader, *storagemock.MockAuthorizationRequestReader, *tokenmock.MockGenerator, *tokenmock.MockGenerator, *storagemock.MockAuthorizationCodeSession, *storagemock.MockDeviceCodeSession, *storagemock.MockToken, *tokenmock.MockIDTokenGenerator)
 		want    *flowv1.TokenResponse
 		wantErr bool
 	}{
@@ -211,6 +211,23 @@ func Test_service_Token(t *testing.T) {
 				Error: rfcerrors.InvalidGrant().Build(),
 			},
 		},
+		{
+			name: "grant_type mismatch: ciba",
+			args: args{
+				ctx: context.Background(),
+				req: &flowv1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &clientv1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeCIBA,
+				},
+			},
+			wantErr: true,
+			want: &flowv1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "client not found",
@@ -231,7 +248,7 @@ func Test_service_Token(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, _ *tokenmock.MockIDTokenGenerator) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
 			},
 			wantErr: true,
@@ -258,7 +275,7 @@ func Test_service_Token(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, _ *tokenmock.MockIDTokenGenerator) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, fmt.Errorf("foo"))
 			},
 			wantErr: true,
@@ -281,7 +298,7 @@ func Test_service_Token(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, _ *tokenmock.MockIDTokenGenerator) {
 				validateRequest = func(ctx context.Context, req *flowv1.TokenRequest) *corev1.Error {
 					// Disable request validator
 					return nil
@@ -312,7 +329,7 @@ func Test_service_Token(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, _ *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
@@ -357,7 +374,7 @@ func Test_service_Token(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, ar *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, sessions *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, ar *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, sessions *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, idt *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					GrantTypes:       []string{oidc.GrantTypeAuthorizationCode},
@@ -366,7 +383,7 @@ func Test_service_Token(t *testing.T) {
 					SubjectType:      oidc.SubjectTypePublic,
 					SectorIdentifier: "https://client.example.org",
 				}, nil)
-				sessions.EXPECT().Get(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(&sessionv1.AuthorizationCodeSession{
 					Request: &flowv1.AuthorizationRequest{
 						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
 						ResponseType:        "code",
@@ -378,11 +395,11 @@ func Test_service_Token(t *testing.T) {
 						CodeChallengeMethod: "S256",
 					},
 				}, nil)
-				sessions.EXPECT().Delete(gomock.Any(), "http://127.0.0.1:8080", "1234567891234567890").Return(nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				atSave := tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 				rt.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil).After(atSave)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
 			},
 			wantErr: false,
 			want: &flowv1.TokenResponse{
@@ -412,9 +429,22 @@ func Test_service_Token(t *testing.T) {
 						IssuedAt:  1,
 						NotBefore: 2,
 						ExpiresAt: 604801,
+						Resources: []string{"mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH"},
 					},
 					Value: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
 				},
+				IdToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+					},
+					Value: "eyJ.idt.sig",
+				},
 			},
 		},
 		// ---------------------------------------------------------------------
@@ -437,13 +467,13 @@ func Test_service_Token(t *testing.T) {
 					Scope: types.StringRef("openid admin"),
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, _ *storagemock.MockAuthorizationCodeSession, sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, _ *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
 					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{oidc.GrantTypeDeviceCode},
 				}, nil)
-				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&sessionv1.DeviceCodeSession{
+				session := &sessionv1.DeviceCodeSession{
 					Client: &clientv1.Client{
 						ClientId: "s6BhdRkqt3",
 					},
@@ -453,7 +483,9 @@ func Test_service_Token(t *testing.T) {
 					ExpiresAt: 200,
 					Status:    sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
 					Subject:   types.StringRef("user1"),
-				}, nil)
+				}
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
+				sessions.EXPECT().Consume(gomock.Any(), "http://127.0.0.1:8080", "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(session, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
 			},
@@ -493,10 +525,11 @@ func Test_service_Token(t *testing.T) {
 					},
 				},
 			},
-			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, sessions *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *tokenmock.MockGenerator, rt *tokenmock.MockGenerator, sessions *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken, idt *tokenmock.MockIDTokenGenerator) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&clientv1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					GrantTypes:           []string{oidc.GrantTypeRefreshToken},
+					RefreshTokenRotation: clientv1.RefreshTokenRotation_REFRESH_TOKEN_ROTATION_ON_EXPIRATION,
 				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "http://127.0.0.1:8080", "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&tokenv1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -514,11 +547,13 @@ func Test_service_Token(t *testing.T) {
 				}, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
 				tokens.EXPECT().Create(gomock.Any(), "http://127.0.0.1:8080", gomock.Any()).Return(nil)
+				idt.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("eyJ.idt.sig", nil)
 			},
 			wantErr: false,
 			want: &flowv1.TokenResponse{
 				AccessToken: &tokenv1.Token{
 					Value:     "xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM",
+					ParentId:  "0123456789",
 					TokenId:   "0123456789",
 					TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
 					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
@@ -531,6 +566,18 @@ func Test_service_Token(t *testing.T) {
 						ExpiresAt: 3601,
 					},
 				},
+				IdToken: &tokenv1.Token{
+					TokenType: tokenv1.TokenType_TOKEN_TYPE_ID_TOKEN,
+					Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &tokenv1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						NotBefore: 1,
+						ExpiresAt: 3601,
+					},
+					Value: "eyJ.idt.sig",
+				},
 			},
 		},
 	}
@@ -543,6 +590,7 @@ func Test_service_Token(t *testing.T) {
 			clients := storagemock.NewMockClientReader(ctrl)
 			accessTokens := tokenmock.NewMockGenerator(ctrl)
 			refreshTokens := tokenmock.NewMockGenerator(ctrl)
+			idTokens := tokenmock.NewMockIDTokenGenerator(ctrl)
 			tokens := storagemock.NewMockToken(ctrl)
 			authorizationRequests := storagemock.NewMockAuthorizationRequestReader(ctrl)
 			authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSession(ctrl)
@@ -550,11 +598,11 @@ func Test_service_Token(t *testing.T) {
 
 			// Prepare them
 			if tt.prepare != nil {
-				tt.prepare(clients, authorizationRequests, accessTokens, refreshTokens, authorizationCodeSessions, deviceCodeSessions, tokens)
+				tt.prepare(clients, authorizationRequests, accessTokens, refreshTokens, authorizationCodeSessions, deviceCodeSessions, tokens, idTokens)
 			}
 
 			// instantiate service
-			underTest := New(accessTokens, refreshTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, nil)
+			underTest := New(accessTokens, refreshTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, nil, WithIDTokenGenerator(idTokens))
 
 			// Under test
 			got, err := underTest.Token(tt.args.ctx, tt.args.req)
@@ -0,0 +1,91 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
+	"zntr.io/solid/sdk/types"
+)
+
+// statusListBits is the status bit size, tokens are either valid or revoked.
+const statusListBits = 1
+
+func (s *service) StatusList(ctx context.Context, req *tokenv1.StatusListRequest) (*tokenv1.StatusListResponse, error) {
+	res := &tokenv1.StatusListResponse{}
+
+	// Check parameters
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("could not process nil request")
+	}
+	// Check issuer syntax
+	if req.Issuer == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must not be blank")
+	}
+	_, err := url.ParseRequestURI(req.Issuer)
+	if err != nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("issuer must be a valid url: %w", err)
+	}
+
+	// Check status list support
+	if types.IsNil(s.statusListGen) || types.IsNil(s.statusLists) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("token status lists are not supported")
+	}
+
+	// Retrieve token statuses
+	size, revoked, err := s.statusLists.RevokedStatusIndexes(ctx, req.Issuer)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to retrieve token statuses: %w", err)
+	}
+
+	// Build the status list
+	list, err := token.NewStatusList(statusListBits, size)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to allocate status list: %w", err)
+	}
+	for _, idx := range revoked {
+		if err := list.Set(idx, token.StatusInvalid); err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to set token status: %w", err)
+		}
+	}
+
+	// Sign the status list
+	raw, err := s.statusListGen.Generate(ctx, req.Issuer, list)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to generate status list token: %w", err)
+	}
+
+	// Assign status list token
+	res.StatusList = types.StringRef(raw)
+
+	// No error
+	return res, nil
+}
@@ -0,0 +1,274 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/sdk/rfcerrors"
+	"zntr.io/solid/sdk/token"
+	tokenmock "zntr.io/solid/sdk/token/mock"
+	"zntr.io/solid/sdk/types"
+	storagemock "zntr.io/solid/server/storage/mock"
+)
+
+func Test_service_StatusList(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *tokenv1.StatusListRequest
+	}
+
+	request := &tokenv1.StatusListRequest{
+		Issuer: "https://honest.as.example.com",
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		disabled bool
+		prepare  func(*storagemock.MockTokenStatusList, *tokenmock.MockStatusListGenerator)
+		want     *tokenv1.StatusListResponse
+		wantErr  bool
+	}{
+		{
+			name:    "nil request",
+			args:    args{ctx: context.Background()},
+			wantErr: true,
+			want: &tokenv1.StatusListResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "blank issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.StatusListRequest{},
+			},
+			wantErr: true,
+			want: &tokenv1.StatusListResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &tokenv1.StatusListRequest{
+					Issuer: "foo",
+				},
+			},
+			wantErr: true,
+			want: &tokenv1.StatusListResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "not supported",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			disabled: true,
+			wantErr:  true,
+			want: &tokenv1.StatusListResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(statusLists *storagemock.MockTokenStatusList, _ *tokenmock.MockStatusListGenerator) {
+				statusLists.EXPECT().RevokedStatusIndexes(gomock.Any(), "https://honest.as.example.com").Return(uint64(0), nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.StatusListResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "index out of range",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(statusLists *storagemock.MockTokenStatusList, _ *tokenmock.MockStatusListGenerator) {
+				statusLists.EXPECT().RevokedStatusIndexes(gomock.Any(), "https://honest.as.example.com").Return(uint64(8), []uint64{8}, nil)
+			},
+			wantErr: true,
+			want: &tokenv1.StatusListResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "generator error",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(statusLists *storagemock.MockTokenStatusList, statusListGen *tokenmock.MockStatusListGenerator) {
+				statusLists.EXPECT().RevokedStatusIndexes(gomock.Any(), "https://honest.as.example.com").Return(uint64(8), []uint64{}, nil)
+				statusListGen.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com", gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &tokenv1.StatusListResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: request,
+			},
+			prepare: func(statusLists *storagemock.MockTokenStatusList, statusListGen *tokenmock.MockStatusListGenerator) {
+				statusLists.EXPECT().RevokedStatusIndexes(gomock.Any(), "https://honest.as.example.com").Return(uint64(10), []uint64{3, 9}, nil)
+				statusListGen.EXPECT().Generate(gomock.Any(), "https://honest.as.example.com", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, list *token.StatusList) (string, error) {
+					for idx := uint64(0); idx < 10; idx++ {
+						status, err := list.Get(idx)
+						if err != nil {
+							return "", err
+						}
+						revoked := idx == 3 || idx == 9
+						if revoked != (status == token.StatusInvalid) {
+							return "", fmt.Errorf("unexpected status %d for index %d", status, idx)
+						}
+					}
+					return "eyJhbGciOiJFUzM4NCJ9.e30.c2lnbmF0dXJl", nil
+				})
+			},
+			wantErr: false,
+			want: &tokenv1.StatusListResponse{
+				StatusList: types.StringRef("eyJhbGciOiJFUzM4NCJ9.e30.c2lnbmF0dXJl"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			statusLists := storagemock.NewMockTokenStatusList(ctrl)
+			statusListGen := tokenmock.NewMockStatusListGenerator(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(statusLists, statusListGen)
+			}
+
+			// instantiate service
+			underTest := New(nil, nil, nil, nil, nil, nil, nil, nil, WithStatusLists(statusListGen, statusLists))
+			if tt.disabled {
+				underTest = New(nil, nil, nil, nil, nil, nil, nil, nil)
+			}
+
+			got, err := underTest.StatusList(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.StatusList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.StatusList() res = %s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_assignStatusIndex(t *testing.T) {
+	accessToken := func() *tokenv1.Token {
+		return &tokenv1.Token{
+			TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			Metadata: &tokenv1.TokenMeta{
+				Issuer: "https://honest.as.example.com",
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		disabled bool
+		prepare  func(*storagemock.MockTokenStatusList, *tokenmock.MockStatusListGenerator)
+		want     *tokenv1.TokenStatusReference
+		wantErr  bool
+	}{
+		{
+			name:     "not supported",
+			disabled: true,
+		},
+		{
+			name: "storage error",
+			prepare: func(statusLists *storagemock.MockTokenStatusList, _ *tokenmock.MockStatusListGenerator) {
+				statusLists.EXPECT().AllocateStatusIndex(gomock.Any(), "https://honest.as.example.com").Return(uint64(0), fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			prepare: func(statusLists *storagemock.MockTokenStatusList, statusListGen *tokenmock.MockStatusListGenerator) {
+				statusLists.EXPECT().AllocateStatusIndex(gomock.Any(), "https://honest.as.example.com").Return(uint64(42), nil)
+				statusListGen.EXPECT().URI("https://honest.as.example.com").Return("https://honest.as.example.com/token/status")
+			},
+			want: &tokenv1.TokenStatusReference{
+				Idx: 42,
+				Uri: "https://honest.as.example.com/token/status",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			statusLists := storagemock.NewMockTokenStatusList(ctrl)
+			statusListGen := tokenmock.NewMockStatusListGenerator(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(statusLists, statusListGen)
+			}
+
+			s := &service{
+				statusListGen: statusListGen,
+				statusLists:   statusLists,
+			}
+			if tt.disabled {
+				s = &service{}
+			}
+
+			at := accessToken()
+			err := s.assignStatusIndex(context.Background(), at)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.assignStatusIndex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(at.StatusList, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.assignStatusIndex() res = %s", diff)
+			}
+		})
+	}
+}
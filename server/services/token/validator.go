@@ -68,10 +68,27 @@ var validateRequest = func(ctx context.Context, req *flowv1.TokenRequest) *corev
 		if req.GetTokenExchange() == nil {
 			return rfcerrors.InvalidGrant().Build()
 		}
+	case oidc.GrantTypeJWTBearer:
+		if req.GetJwtBearer() == nil {
+			return rfcerrors.InvalidGrant().Build()
+		}
+	case oidc.GrantTypeCIBA:
+		if req.GetCiba() == nil {
+			return rfcerrors.InvalidGrant().Build()
+		}
 	default:
 		return rfcerrors.InvalidGrant().Build()
 	}
 
+	// Authorization details can only narrow an existing grant
+	if req.AuthorizationDetails != "" {
+		switch req.GrantType {
+		case oidc.GrantTypeAuthorizationCode, oidc.GrantTypeRefreshToken:
+		default:
+			return rfcerrors.InvalidAuthorizationDetails().Build()
+		}
+	}
+
 	// Return result
 	return nil
 }
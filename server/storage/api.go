@@ -24,12 +24,17 @@ import (
 	clientv1 "zntr.io/solid/api/oidc/client/v1"
 	flowv1 "zntr.io/solid/api/oidc/flow/v1"
 	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	scopev1 "zntr.io/solid/api/oidc/scope/v1"
 	sessionv1 "zntr.io/solid/api/oidc/session/v1"
 	tokenv1 "zntr.io/solid/api/oidc/token/v1"
 )
 
-// ErrNotFound is returned when the query return no result.
-var ErrNotFound = errors.New("no result found")
+var (
+	// ErrNotFound is returned when the query return no result.
+	ErrNotFound = errors.New("no result found")
+	// ErrAlreadyUsed is returned when a single-use identifier is used twice.
+	ErrAlreadyUsed = errors.New("identifier already used")
+)
 
 //go:generate mockgen -destination mock/clientreader.gen.go -package mock zntr.io/solid/server/storage ClientReader
 
@@ -67,6 +72,9 @@ type AuthorizationRequestReader interface {
 type AuthorizationRequestWriter interface {
 	Register(ctx context.Context, issuer, requestURI string, req *flowv1.AuthorizationRequest) (uint64, error)
 	Delete(ctx context.Context, issuer, requestURI string) error
+	// Consume atomically retrieves and deletes the request, only one caller
+	// can consume a given request.
+	Consume(ctx context.Context, issuer, requestURI string) (*flowv1.AuthorizationRequest, error)
 }
 
 //go:generate mockgen -destination mock/authorization_request.gen.go -package mock zntr.io/solid/server/storage AuthorizationRequest
@@ -92,6 +100,16 @@ type TokenWriter interface {
 	Create(ctx context.Context, issuer string, t *tokenv1.Token) error
 	Delete(ctx context.Context, issuer, id string) error
 	Revoke(ctx context.Context, issuer, id string) error
+	// Consume atomically revokes the given active token, only one caller can
+	// consume a given token. ErrAlreadyUsed is returned when the token is no
+	// longer active.
+	Consume(ctx context.Context, issuer, id string) error
+	// RevokeByGrant revokes all tokens of the given token family.
+	RevokeByGrant(ctx context.Context, issuer, grantID string) error
+	// RevokeBySubject revokes all tokens issued to the given subject.
+	RevokeBySubject(ctx context.Context, issuer, subject string) error
+	// RevokeByClient revokes all tokens issued to the given client.
+	RevokeByClient(ctx context.Context, issuer, clientID string) error
 }
 
 //go:generate mockgen -destination mock/token.gen.go -package mock zntr.io/solid/server/storage Token
@@ -102,6 +120,27 @@ type Token interface {
 	TokenWriter
 }
 
+//go:generate mockgen -destination mock/token_status_list.gen.go -package mock zntr.io/solid/server/storage TokenStatusList
+
+// TokenStatusList describes token status list operation contract. Indexes are
+// allocated sequentially per issuer and the status of a token is derived from
+// its revocation.
+//
+// Indexes are never reclaimed, a verifier holding an older list can't tell a
+// reused index from the token it was first assigned to. The list grows with
+// every indexed access token and a 1-bit list reaches the verifier
+// decompression limit (16 MiB) after about 134 million tokens. Before reaching
+// it, roll over to a new list: publish it under a new URI with
+// token.WithStatusListURI backed by a new storage, and keep serving the
+// previous list until the last token referencing it expires.
+type TokenStatusList interface {
+	// AllocateStatusIndex reserves the next status list index of the issuer.
+	AllocateStatusIndex(ctx context.Context, issuer string) (uint64, error)
+	// RevokedStatusIndexes returns the number of allocated indexes and the
+	// indexes of revoked tokens.
+	RevokedStatusIndexes(ctx context.Context, issuer string) (uint64, []uint64, error)
+}
+
 //go:generate mockgen -destination mock/authorization_code_session_reader.gen.go -package mock zntr.io/solid/server/storage AuthorizationCodeSessionReader
 
 // AuthorizationCodeSessionReader describes read-only storage operation contract.
@@ -115,6 +154,9 @@ type AuthorizationCodeSessionReader interface {
 type AuthorizationCodeSessionWriter interface {
 	Register(ctx context.Context, issuer, code string, s *sessionv1.AuthorizationCodeSession) (uint64, error)
 	Delete(ctx context.Context, issuer, code string) error
+	// Consume atomically retrieves and deletes the session, only one caller
+	// can consume a given code.
+	Consume(ctx context.Context, issuer, code string) (*sessionv1.AuthorizationCodeSession, error)
 }
 
 //go:generate mockgen -destination mock/authorization_code_session.gen.go -package mock zntr.io/solid/server/storage AuthorizationCodeSession
@@ -132,6 +174,16 @@ type DeviceCodeSessionWriter interface {
 	Register(ctx context.Context, issuer, userCode string, r *sessionv1.DeviceCodeSession) (uint64, error)
 	Delete(ctx context.Context, issuer, userCode string) error
 	Validate(ctx context.Context, issuer, userCode string, r *sessionv1.DeviceCodeSession) error
+	// Update replaces the session reachable by its device code without
+	// extending its lifetime.
+	Update(ctx context.Context, issuer, deviceCode string, r *sessionv1.DeviceCodeSession) error
+	// RecordPoll atomically records the polling state of a session still
+	// pending an end-user decision, the rest of the session is left untouched.
+	// It returns ErrNotFound when the session is unknown or no longer pending.
+	RecordPoll(ctx context.Context, issuer, deviceCode string, lastPolledAt, interval uint64) error
+	// Consume atomically retrieves and deletes the session reachable by its
+	// device code, only one caller can redeem a given device code.
+	Consume(ctx context.Context, issuer, deviceCode string) (*sessionv1.DeviceCodeSession, error)
 }
 
 //go:generate mockgen -destination mock/device_code_session_reader.gen.go -package mock zntr.io/solid/server/storage DeviceCodeSessionReader
@@ -150,6 +202,36 @@ type DeviceCodeSession interface {
 	DeviceCodeSessionWriter
 }
 
+//go:generate mockgen -destination mock/backchannel_authentication_session_writer.gen.go -package mock zntr.io/solid/server/storage BackchannelAuthenticationSessionWriter
+
+// BackchannelAuthenticationSessionWriter describes backchannel authentication
+// session write-only operation contract.
+type BackchannelAuthenticationSessionWriter interface {
+	Register(ctx context.Context, issuer, authReqID string, s *sessionv1.BackchannelAuthenticationSession) error
+	Update(ctx context.Context, issuer, authReqID string, s *sessionv1.BackchannelAuthenticationSession) error
+	Delete(ctx context.Context, issuer, authReqID string) error
+	// Consume atomically retrieves and deletes the session, only one caller
+	// can consume a given authentication request.
+	Consume(ctx context.Context, issuer, authReqID string) (*sessionv1.BackchannelAuthenticationSession, error)
+}
+
+//go:generate mockgen -destination mock/backchannel_authentication_session_reader.gen.go -package mock zntr.io/solid/server/storage BackchannelAuthenticationSessionReader
+
+// BackchannelAuthenticationSessionReader describes backchannel authentication
+// session read-only operation contract.
+type BackchannelAuthenticationSessionReader interface {
+	Get(ctx context.Context, issuer, authReqID string) (*sessionv1.BackchannelAuthenticationSession, error)
+}
+
+//go:generate mockgen -destination mock/backchannel_authentication_session.gen.go -package mock zntr.io/solid/server/storage BackchannelAuthenticationSession
+
+// BackchannelAuthenticationSession describes backchannel authentication session
+// operation contract.
+type BackchannelAuthenticationSession interface {
+	BackchannelAuthenticationSessionReader
+	BackchannelAuthenticationSessionWriter
+}
+
 //go:generate mockgen -destination mock/dpop.gen.go -package mock zntr.io/solid/server/storage DPoP
 
 // DPoP describes dpop proof jti storage to prevent dpop replay attack.
@@ -159,6 +241,35 @@ type DPoP interface {
 	Exists(ctx context.Context, id string) (bool, error)
 }
 
+//go:generate mockgen -destination mock/assertion_jti.gen.go -package mock zntr.io/solid/server/storage AssertionJTI
+
+// AssertionJTI describes assertion identifier storage to prevent assertion
+// replay attack.
+type AssertionJTI interface {
+	// Use atomically registers the assertion identifier until its expiration,
+	// only one caller can use a given identifier for an issuer.
+	Use(ctx context.Context, issuer, jti string, expiresAt uint64) error
+}
+
+//go:generate mockgen -destination mock/device_user_code_attempt.gen.go -package mock zntr.io/solid/server/storage DeviceUserCodeAttempt
+
+// DeviceUserCodeAttempt describes failed user code attempt counters used to
+// throttle user code guessing.
+type DeviceUserCodeAttempt interface {
+	// Get returns the failure count and the last failure timestamp of the
+	// given counter, unknown or expired counters are returned as zero.
+	Get(ctx context.Context, issuer, key string) (failures, lastFailedAt uint64, err error)
+	// Fail atomically increments the given counter and returns the new failure
+	// count. A new counter expires at expiresAt, an existing counter keeps its
+	// expiration.
+	Fail(ctx context.Context, issuer, key string, expiresAt uint64) (uint64, error)
+	// Release atomically decrements the given counter, reverting a Fail. The
+	// last failure timestamp is kept, unknown or expired counters are ignored.
+	Release(ctx context.Context, issuer, key string) error
+	// Reset removes the given counter.
+	Reset(ctx context.Context, issuer, key string) error
+}
+
 //go:generate mockgen -destination mock/resource_reader.gen.go -package mock zntr.io/solid/server/storage ResourceReader
 
 // ResourceReader describes resource resolver contract.
@@ -171,3 +282,17 @@ type ResourceReader interface {
 type Resource interface {
 	ResourceReader
 }
+
+//go:generate mockgen -destination mock/scope_reader.gen.go -package mock zntr.io/solid/server/storage ScopeReader
+
+// ScopeReader describes scope resolver contract.
+type ScopeReader interface {
+	GetByName(ctx context.Context, name string) (*scopev1.Scope, error)
+}
+
+//go:generate mockgen -destination mock/scope.gen.go -package mock zntr.io/solid/server/storage Scope
+
+// Scope describes complete scope storage contract.
+type Scope interface {
+	ScopeReader
+}
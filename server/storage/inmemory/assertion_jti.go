@@ -0,0 +1,65 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"zntr.io/solid/server/storage"
+)
+
+type assertionJTICache struct {
+	backend *cache.Cache
+}
+
+// AssertionJTIs returns an assertion identifier cache.
+func AssertionJTIs() storage.AssertionJTI {
+	// Initialize in-memory caches
+	backendCache := cache.New(cache.NoExpiration, 10*time.Minute)
+
+	return &assertionJTICache{
+		backend: backendCache,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *assertionJTICache) Use(ctx context.Context, issuer, jti string, expiresAt uint64) error {
+	// Check arguments
+	if jti == "" {
+		return fmt.Errorf("jti must not be blank")
+	}
+
+	// Keep the identifier at least one second
+	ttl := time.Unix(int64(expiresAt), 0).Sub(timeFunc())
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+
+	// Insert in cache, fails when the key already exists
+	if err := s.backend.Add(fmt.Sprintf("%s|%s", issuer, jti), struct{}{}, ttl); err != nil {
+		return storage.ErrAlreadyUsed
+	}
+
+	// No error
+	return nil
+}
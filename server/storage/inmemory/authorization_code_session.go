@@ -20,6 +20,7 @@ package inmemory
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -30,6 +31,7 @@ import (
 )
 
 type sessionStorage struct {
+	sync.Mutex
 	backend *cache.Cache
 }
 
@@ -69,6 +71,24 @@ func (s *sessionStorage) Get(ctx context.Context, issuer, code string) (*session
 	return nil, storage.ErrNotFound
 }
 
+func (s *sessionStorage) Consume(ctx context.Context, issuer, code string) (*sessionv1.AuthorizationCodeSession, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Retrieve from cache
+	key := s.deriveKey(issuer, code)
+	x, found := s.backend.Get(key)
+	if !found {
+		return nil, storage.ErrNotFound
+	}
+
+	// Burn after read
+	s.backend.Delete(key)
+
+	// No error
+	return x.(*sessionv1.AuthorizationCodeSession), nil
+}
+
 // -----------------------------------------------------------------------------
 
 func (s *sessionStorage) deriveKey(issuer, code string) string {
@@ -20,6 +20,7 @@ package inmemory
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -30,6 +31,7 @@ import (
 )
 
 type authorizationRequestStorage struct {
+	sync.Mutex
 	backend *cache.Cache
 }
 
@@ -69,6 +71,24 @@ func (s *authorizationRequestStorage) Get(ctx context.Context, issuer, requestUR
 	return nil, storage.ErrNotFound
 }
 
+func (s *authorizationRequestStorage) Consume(ctx context.Context, issuer, requestURI string) (*flowv1.AuthorizationRequest, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Retrieve from cache
+	key := s.deriveKey(issuer, requestURI)
+	x, found := s.backend.Get(key)
+	if !found {
+		return nil, storage.ErrNotFound
+	}
+
+	// Burn after read
+	s.backend.Delete(key)
+
+	// No error
+	return x.(*flowv1.AuthorizationRequest), nil
+}
+
 // -----------------------------------------------------------------------------
 
 func (s *authorizationRequestStorage) deriveKey(issuer, requestURI string) string {
@@ -0,0 +1,154 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"golang.org/x/crypto/blake2b"
+	"google.golang.org/protobuf/proto"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/server/storage"
+)
+
+type backchannelAuthenticationSessionStorage struct {
+	sync.Mutex
+	backend *cache.Cache
+}
+
+// BackchannelAuthenticationSessions returns a backchannel authentication
+// session manager.
+func BackchannelAuthenticationSessions() storage.BackchannelAuthenticationSession {
+	// Initialize in-memory caches
+	backendCache := cache.New(cache.NoExpiration, 10*time.Minute)
+
+	return &backchannelAuthenticationSessionStorage{
+		backend: backendCache,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *backchannelAuthenticationSessionStorage) Register(ctx context.Context, issuer, authReqID string, req *sessionv1.BackchannelAuthenticationSession) error {
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to register nil backchannel authentication session")
+	}
+
+	// Insert a copy in cache, callers must not share the stored session
+	s.backend.Set(s.deriveAuthReqID(issuer, authReqID), proto.Clone(req), s.ttl(req))
+
+	// No error
+	return nil
+}
+
+func (s *backchannelAuthenticationSessionStorage) Update(ctx context.Context, issuer, authReqID string, req *sessionv1.BackchannelAuthenticationSession) error {
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to update nil backchannel authentication session")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Check existence
+	key := s.deriveAuthReqID(issuer, authReqID)
+	if _, found := s.backend.Get(key); !found {
+		return storage.ErrNotFound
+	}
+
+	// Replace in cache
+	s.backend.Set(key, proto.Clone(req), s.ttl(req))
+
+	// No error
+	return nil
+}
+
+func (s *backchannelAuthenticationSessionStorage) Delete(ctx context.Context, issuer, authReqID string) error {
+	s.backend.Delete(s.deriveAuthReqID(issuer, authReqID))
+	// No error
+	return nil
+}
+
+func (s *backchannelAuthenticationSessionStorage) Get(ctx context.Context, issuer, authReqID string) (*sessionv1.BackchannelAuthenticationSession, error) {
+	// Retrieve from cache
+	if x, found := s.backend.Get(s.deriveAuthReqID(issuer, authReqID)); found {
+		req := x.(*sessionv1.BackchannelAuthenticationSession)
+		if req.ExpiresAt > uint64(timeFunc().Unix()) {
+			return proto.Clone(req).(*sessionv1.BackchannelAuthenticationSession), nil
+		}
+	}
+
+	return nil, storage.ErrNotFound
+}
+
+func (s *backchannelAuthenticationSessionStorage) Consume(ctx context.Context, issuer, authReqID string) (*sessionv1.BackchannelAuthenticationSession, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Retrieve from cache
+	key := s.deriveAuthReqID(issuer, authReqID)
+	x, found := s.backend.Get(key)
+	if !found {
+		return nil, storage.ErrNotFound
+	}
+
+	// Burn after read
+	s.backend.Delete(key)
+
+	// Check expiration
+	req := x.(*sessionv1.BackchannelAuthenticationSession)
+	if req.ExpiresAt <= uint64(timeFunc().Unix()) {
+		return nil, storage.ErrNotFound
+	}
+
+	// No error
+	return req, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *backchannelAuthenticationSessionStorage) ttl(req *sessionv1.BackchannelAuthenticationSession) time.Duration {
+	// Keep the session at least one second
+	ttl := time.Unix(int64(req.ExpiresAt), 0).Sub(timeFunc())
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+
+	return ttl
+}
+
+func (s *backchannelAuthenticationSessionStorage) deriveAuthReqID(issuer, authReqID string) string {
+	// Create hasher
+	h, err := blake2b.New256([]byte(`9w&Lr$Ek4!tZ)qP[uB2^mX{o7Fj;Hc0]Nv=Ys?D+gA~i5:Ke|Ta<W8%bR@V1*e`))
+	if err != nil {
+		panic(err)
+	}
+
+	h.Write([]byte("solid:backchannel-authentication-request-id:v1"))
+	h.Write([]byte(issuer))
+	h.Write([]byte(authReqID))
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
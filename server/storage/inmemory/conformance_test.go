@@ -0,0 +1,91 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory_test
+
+import (
+	"testing"
+	"time"
+
+	"zntr.io/solid/server/storage"
+	"zntr.io/solid/server/storage/inmemory"
+	"zntr.io/solid/server/storage/storagetest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Run("clients", func(t *testing.T) {
+		storagetest.RunClientSuite(t, func(_ *testing.T) storage.Client {
+			return inmemory.Clients()
+		})
+	})
+	t.Run("authorization requests", func(t *testing.T) {
+		storagetest.RunAuthorizationRequestSuite(t, func(_ *testing.T) storage.AuthorizationRequest {
+			return inmemory.AuthorizationRequests()
+		})
+	})
+	t.Run("authorization code sessions", func(t *testing.T) {
+		storagetest.RunAuthorizationCodeSessionSuite(t, func(_ *testing.T) storage.AuthorizationCodeSession {
+			return inmemory.AuthorizationCodeSessions()
+		})
+	})
+	t.Run("device code sessions", func(t *testing.T) {
+		storagetest.RunDeviceCodeSessionSuite(t, func(_ *testing.T) storage.DeviceCodeSession {
+			return inmemory.DeviceCodeSessions()
+		})
+	})
+	t.Run("backchannel authentication sessions", func(t *testing.T) {
+		storagetest.RunBackchannelAuthenticationSessionSuite(t, func(_ *testing.T) storage.BackchannelAuthenticationSession {
+			return inmemory.BackchannelAuthenticationSessions()
+		})
+	})
+	t.Run("tokens", func(t *testing.T) {
+		now := inmemory.FreezeTime(t, time.Unix(1700000000, 0))
+		storagetest.RunTokenSuite(t, func(t *testing.T) storage.Token {
+			tokens := inmemory.Tokens(inmemory.WithRevokedRetention(time.Hour))
+			t.Cleanup(func() {
+				tokens.Close()
+			})
+			return tokens
+		}, storagetest.WithClock(func(d time.Duration) {
+			*now = now.Add(d)
+		}), storagetest.WithNow(func() time.Time { return *now }), storagetest.WithTokenRevokedRetention(time.Hour))
+	})
+	t.Run("token status lists", func(t *testing.T) {
+		storagetest.RunTokenStatusListSuite(t, func(t *testing.T) storagetest.TokenStatusListStorage {
+			tokens := inmemory.Tokens()
+			t.Cleanup(func() {
+				tokens.Close()
+			})
+			return tokens
+		})
+	})
+	t.Run("dpop proofs", func(t *testing.T) {
+		storagetest.RunDPoPSuite(t, func(_ *testing.T) storage.DPoP {
+			return inmemory.DPoPProofs()
+		})
+	})
+	t.Run("assertion identifiers", func(t *testing.T) {
+		storagetest.RunAssertionJTISuite(t, func(_ *testing.T) storage.AssertionJTI {
+			return inmemory.AssertionJTIs()
+		})
+	})
+	t.Run("device user code attempts", func(t *testing.T) {
+		storagetest.RunDeviceUserCodeAttemptSuite(t, func(_ *testing.T) storage.DeviceUserCodeAttempt {
+			return inmemory.DeviceUserCodeAttempts()
+		})
+	})
+}
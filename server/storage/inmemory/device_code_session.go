@@ -19,17 +19,21 @@ package inmemory
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/patrickmn/go-cache"
 	"golang.org/x/crypto/blake2b"
+	"google.golang.org/protobuf/proto"
 
 	sessionv1 "zntr.io/solid/api/oidc/session/v1"
 	"zntr.io/solid/server/storage"
 )
 
 type deviceCodeSessionStorage struct {
+	sync.Mutex
 	userCodeIndex   *cache.Cache
 	deviceCodeIndex *cache.Cache
 }
@@ -49,9 +53,18 @@ func DeviceCodeSessions() storage.DeviceCodeSession {
 // -----------------------------------------------------------------------------
 
 func (s *deviceCodeSessionStorage) Register(ctx context.Context, issuer, userCode string, req *sessionv1.DeviceCodeSession) (uint64, error) {
-	// Insert in cache
-	s.userCodeIndex.Set(s.deriveUserCode(req.Issuer, userCode), req, cache.DefaultExpiration)
-	s.deviceCodeIndex.Set(s.deriveDeviceCode(req.Issuer, req.DeviceCode), req, cache.DefaultExpiration)
+	// Check parameters
+	if req == nil {
+		return 0, errors.New("unable to register nil device code session")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Insert a copy in cache, callers must not share the stored session
+	stored := proto.Clone(req)
+	s.userCodeIndex.Set(s.deriveUserCode(req.Issuer, userCode), stored, cache.DefaultExpiration)
+	s.deviceCodeIndex.Set(s.deriveDeviceCode(req.Issuer, req.DeviceCode), stored, cache.DefaultExpiration)
 
 	// No error
 	return uint64(120), nil
@@ -64,34 +77,116 @@ func (s *deviceCodeSessionStorage) Delete(ctx context.Context, issuer, code stri
 }
 
 func (s *deviceCodeSessionStorage) GetByDeviceCode(ctx context.Context, issuer, deviceCode string) (*sessionv1.DeviceCodeSession, error) {
+	s.Lock()
+	defer s.Unlock()
+
 	// Retrieve from cache
 	if x, found := s.deviceCodeIndex.Get(s.deriveDeviceCode(issuer, deviceCode)); found {
-		req := x.(*sessionv1.DeviceCodeSession)
-		return req, nil
+		return proto.Clone(x.(*sessionv1.DeviceCodeSession)).(*sessionv1.DeviceCodeSession), nil
 	}
 
 	return nil, storage.ErrNotFound
 }
 
 func (s *deviceCodeSessionStorage) GetByUserCode(ctx context.Context, issuer, userCode string) (*sessionv1.DeviceCodeSession, error) {
+	s.Lock()
+	defer s.Unlock()
+
 	// Retrieve from cache
 	if x, found := s.userCodeIndex.Get(s.deriveUserCode(issuer, userCode)); found {
-		req := x.(*sessionv1.DeviceCodeSession)
-		return req, nil
+		return proto.Clone(x.(*sessionv1.DeviceCodeSession)).(*sessionv1.DeviceCodeSession), nil
 	}
 
 	return nil, storage.ErrNotFound
 }
 
 func (s *deviceCodeSessionStorage) Validate(ctx context.Context, issuer, userCode string, req *sessionv1.DeviceCodeSession) error {
-	// Insert in cache
-	s.userCodeIndex.Set(s.deriveUserCode(req.Issuer, userCode), req, cache.DefaultExpiration)
-	s.deviceCodeIndex.Set(s.deriveDeviceCode(req.Issuer, req.DeviceCode), req, cache.DefaultExpiration)
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to validate nil device code session")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Insert a copy in cache
+	stored := proto.Clone(req)
+	s.userCodeIndex.Set(s.deriveUserCode(req.Issuer, userCode), stored, cache.DefaultExpiration)
+	s.deviceCodeIndex.Set(s.deriveDeviceCode(req.Issuer, req.DeviceCode), stored, cache.DefaultExpiration)
+
+	// No error
+	return nil
+}
+
+func (s *deviceCodeSessionStorage) Update(ctx context.Context, issuer, deviceCode string, req *sessionv1.DeviceCodeSession) error {
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to update nil device code session")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Retrieve from cache
+	x, found := s.deviceCodeIndex.Get(s.deriveDeviceCode(issuer, deviceCode))
+	if !found {
+		return storage.ErrNotFound
+	}
+
+	// Update the shared instance in place to keep both indexes and the
+	// remaining lifetime consistent.
+	current := x.(*sessionv1.DeviceCodeSession)
+	proto.Reset(current)
+	proto.Merge(current, req)
 
 	// No error
 	return nil
 }
 
+func (s *deviceCodeSessionStorage) RecordPoll(ctx context.Context, issuer, deviceCode string, lastPolledAt, interval uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// Retrieve from cache
+	x, found := s.deviceCodeIndex.Get(s.deriveDeviceCode(issuer, deviceCode))
+	if !found {
+		return storage.ErrNotFound
+	}
+
+	// Only pending sessions are polled
+	current := x.(*sessionv1.DeviceCodeSession)
+	switch current.Status {
+	case sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING:
+	default:
+		return storage.ErrNotFound
+	}
+
+	// Update the shared instance in place
+	current.LastPolledAt = lastPolledAt
+	current.Interval = interval
+
+	// No error
+	return nil
+}
+
+func (s *deviceCodeSessionStorage) Consume(ctx context.Context, issuer, deviceCode string) (*sessionv1.DeviceCodeSession, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Retrieve from cache
+	key := s.deriveDeviceCode(issuer, deviceCode)
+	x, found := s.deviceCodeIndex.Get(key)
+	if !found {
+		return nil, storage.ErrNotFound
+	}
+
+	// Burn after read
+	s.deviceCodeIndex.Delete(key)
+
+	// No error
+	return proto.Clone(x.(*sessionv1.DeviceCodeSession)).(*sessionv1.DeviceCodeSession), nil
+}
+
 // -----------------------------------------------------------------------------
 
 func (s *deviceCodeSessionStorage) deriveUserCode(issuer, code string) string {
@@ -0,0 +1,143 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"zntr.io/solid/server/storage"
+)
+
+type deviceUserCodeAttempt struct {
+	failures     uint64
+	lastFailedAt uint64
+	expiresAt    uint64
+}
+
+type deviceUserCodeAttemptCache struct {
+	sync.Mutex
+	backend *cache.Cache
+}
+
+// DeviceUserCodeAttempts returns a failed user code attempt counter cache.
+func DeviceUserCodeAttempts() storage.DeviceUserCodeAttempt {
+	// Initialize in-memory caches
+	backendCache := cache.New(cache.NoExpiration, 10*time.Minute)
+
+	return &deviceUserCodeAttemptCache{
+		backend: backendCache,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *deviceUserCodeAttemptCache) Get(ctx context.Context, issuer, key string) (failures, lastFailedAt uint64, err error) {
+	// Retrieve from cache
+	item, ok := s.backend.Get(fmt.Sprintf("%s|%s", issuer, key))
+	if !ok {
+		return 0, 0, nil
+	}
+
+	// Cast as attempt counter
+	attempt, ok := item.(deviceUserCodeAttempt)
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid user code attempt type %T", item)
+	}
+
+	// Ignore expired counters
+	if attempt.expiresAt <= uint64(timeFunc().Unix()) {
+		return 0, 0, nil
+	}
+
+	// No error
+	return attempt.failures, attempt.lastFailedAt, nil
+}
+
+func (s *deviceUserCodeAttemptCache) Fail(ctx context.Context, issuer, key string, expiresAt uint64) (uint64, error) {
+	// Check arguments
+	if key == "" {
+		return 0, fmt.Errorf("key must not be blank")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := uint64(timeFunc().Unix())
+	cacheKey := fmt.Sprintf("%s|%s", issuer, key)
+
+	// Retrieve the current counter
+	attempt := deviceUserCodeAttempt{
+		expiresAt: expiresAt,
+	}
+	if item, ok := s.backend.Get(cacheKey); ok {
+		if current, ok := item.(deviceUserCodeAttempt); ok && current.expiresAt > now {
+			attempt = current
+		}
+	}
+
+	// Increment the counter
+	attempt.failures++
+	attempt.lastFailedAt = now
+
+	// Keep the counter at least one second
+	ttl := time.Unix(int64(attempt.expiresAt), 0).Sub(timeFunc())
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	s.backend.Set(cacheKey, attempt, ttl)
+
+	// No error
+	return attempt.failures, nil
+}
+
+func (s *deviceUserCodeAttemptCache) Release(ctx context.Context, issuer, key string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	cacheKey := fmt.Sprintf("%s|%s", issuer, key)
+
+	// Retrieve the current counter
+	item, expiration, ok := s.backend.GetWithExpiration(cacheKey)
+	if !ok {
+		return nil
+	}
+	attempt, ok := item.(deviceUserCodeAttempt)
+	if !ok || attempt.expiresAt <= uint64(timeFunc().Unix()) || attempt.failures == 0 {
+		return nil
+	}
+
+	// Decrement the counter, keeping its expiration
+	attempt.failures--
+	s.backend.Set(cacheKey, attempt, time.Until(expiration))
+
+	// No error
+	return nil
+}
+
+func (s *deviceUserCodeAttemptCache) Reset(ctx context.Context, issuer, key string) error {
+	// Remove from cache
+	s.backend.Delete(fmt.Sprintf("%s|%s", issuer, key))
+
+	// No error
+	return nil
+}
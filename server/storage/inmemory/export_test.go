@@ -0,0 +1,37 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"testing"
+	"time"
+)
+
+// FreezeTime sets the storage clock to the returned time until the end of the
+// test.
+func FreezeTime(t *testing.T, now time.Time) *time.Time {
+	t.Helper()
+
+	current := now
+	timeFunc = func() time.Time { return current }
+	t.Cleanup(func() {
+		timeFunc = time.Now
+	})
+
+	return &current
+}
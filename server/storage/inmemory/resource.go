@@ -43,6 +43,16 @@ func Resources() storage.Resource {
 					"https://backend.example.com/api",
 				},
 			},
+			"http://localhost:8085": {
+				Urn:         "http://localhost:8085",
+				Description: "Timestamp resource server",
+				Urls: []string{
+					"http://localhost:8085",
+				},
+				IntrospectionClients: []string{
+					"5stz52n91hr7aw9q1h5hbuvkt2ovevdw",
+				},
+			},
 		},
 	}
 }
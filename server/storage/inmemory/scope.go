@@ -0,0 +1,67 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+
+	scopev1 "zntr.io/solid/api/oidc/scope/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/server/storage"
+)
+
+type scopeStorage struct {
+	backend map[string]*scopev1.Scope
+}
+
+// Scopes returns a scope reader.
+func Scopes() storage.Scope {
+	return &scopeStorage{
+		backend: map[string]*scopev1.Scope{
+			oidc.ScopeOpenID: {
+				Name:        oidc.ScopeOpenID,
+				Description: "OpenID Connect authentication",
+			},
+			oidc.ScopeOfflineAccess: {
+				Name:        oidc.ScopeOfflineAccess,
+				Description: "Offline access",
+			},
+			"profile": {
+				Name:        "profile",
+				Description: "End-user default profile claims",
+			},
+			"email": {
+				Name:        "email",
+				Description: "End-user email address",
+			},
+		},
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *scopeStorage) GetByName(ctx context.Context, name string) (*scopev1.Scope, error) {
+	// Check if scope exists
+	scope, ok := s.backend[name]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	// No error
+	return scope, nil
+}
@@ -20,7 +20,9 @@ package inmemory
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
+	"time"
 
 	"golang.org/x/crypto/blake2b"
 	"google.golang.org/protobuf/proto"
@@ -29,17 +31,102 @@ import (
 	"zntr.io/solid/server/storage"
 )
 
+const (
+	defaultTokenSweepInterval    = 10 * time.Minute
+	defaultTokenRevokedRetention = 24 * time.Hour
+)
+
+// timeFunc is used to retrieve the current time, overridden for testing.
+var timeFunc = time.Now
+
+// TokenStorage describes an in-memory token storage. It must be closed to stop
+// the background sweeper.
+type TokenStorage interface {
+	storage.Token
+	storage.TokenStatusList
+	io.Closer
+}
+
+// TokenOption is used to tune the in-memory token storage.
+type TokenOption func(*tokenOptions)
+
+type tokenOptions struct {
+	sweepInterval    time.Duration
+	revokedRetention time.Duration
+}
+
+// WithSweepInterval sets the delay between two expired token collections.
+func WithSweepInterval(d time.Duration) TokenOption {
+	return func(o *tokenOptions) {
+		o.sweepInterval = d
+	}
+}
+
+// WithRevokedRetention sets how long a revoked token is at least kept after its
+// revocation, so that it can still be reported as revoked. A revoked token is
+// never collected before its expiration.
+func WithRevokedRetention(d time.Duration) TokenOption {
+	return func(o *tokenOptions) {
+		o.revokedRetention = d
+	}
+}
+
+// tokenKey identifies a token, identifiers are only unique per issuer.
+type tokenKey struct {
+	issuer string
+	id     string
+}
+
+type tokenEntry struct {
+	issuer    string
+	token     *tokenv1.Token
+	revokedAt time.Time
+}
+
+type statusListEntry struct {
+	size    uint64
+	revoked map[uint64]struct{}
+}
+
 type tokenStorage struct {
-	idIndex    sync.Map
-	valueIndex sync.Map
+	sync.RWMutex
+	idIndex          map[tokenKey]*tokenEntry
+	valueIndex       map[string]*tokenEntry
+	statusLists      map[string]*statusListEntry
+	revokedRetention time.Duration
+
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
 }
 
-// Tokens returns a token manager.
-func Tokens() storage.Token {
-	return &tokenStorage{
-		idIndex:    sync.Map{},
-		valueIndex: sync.Map{},
+// Tokens returns a token manager. Expired tokens are collected by a background
+// sweeper which is stopped by calling Close.
+func Tokens(opts ...TokenOption) TokenStorage {
+	// Default options
+	dopts := &tokenOptions{
+		sweepInterval:    defaultTokenSweepInterval,
+		revokedRetention: defaultTokenRevokedRetention,
+	}
+	for _, o := range opts {
+		o(dopts)
+	}
+
+	s := &tokenStorage{
+		idIndex:          map[tokenKey]*tokenEntry{},
+		valueIndex:       map[string]*tokenEntry{},
+		statusLists:      map[string]*statusListEntry{},
+		revokedRetention: dopts.revokedRetention,
+		done:             make(chan struct{}),
 	}
+
+	// Start the sweeper
+	if dopts.sweepInterval > 0 {
+		s.wg.Add(1)
+		go s.sweeper(dopts.sweepInterval)
+	}
+
+	return s
 }
 
 // -----------------------------------------------------------------------------
@@ -56,62 +143,186 @@ func (s *tokenStorage) Create(ctx context.Context, issuer string, t *tokenv1.Tok
 	// Compute token value hash
 	tCopy.Value = s.deriveValue(issuer, tCopy.Value)
 
-	s.idIndex.Store(tCopy.TokenId, tCopy)
-	s.valueIndex.Store(tCopy.Value, tCopy)
+	s.Lock()
+	defer s.Unlock()
+
+	entry := &tokenEntry{
+		issuer: issuer,
+		token:  tCopy,
+	}
+	s.idIndex[tokenKey{issuer: issuer, id: tCopy.TokenId}] = entry
+	s.valueIndex[tCopy.Value] = entry
 
 	// No error
 	return nil
 }
 
 func (s *tokenStorage) Get(ctx context.Context, issuer, id string) (*tokenv1.Token, error) {
-	// Check is client exists
-	client, ok := s.idIndex.Load(id)
-	if !ok {
+	s.RLock()
+	defer s.RUnlock()
+
+	// Check if token exists
+	entry, ok := s.idIndex[tokenKey{issuer: issuer, id: id}]
+	if !ok || s.isExpired(entry, timeFunc()) {
 		return nil, storage.ErrNotFound
 	}
 
 	// No error
-	return client.(*tokenv1.Token), nil
+	return proto.Clone(entry.token).(*tokenv1.Token), nil
 }
 
 func (s *tokenStorage) GetByValue(ctx context.Context, issuer, value string) (*tokenv1.Token, error) {
-	// Check is client exists
-	client, ok := s.valueIndex.Load(s.deriveValue(issuer, value))
-	if !ok {
+	s.RLock()
+	defer s.RUnlock()
+
+	// Check if token exists
+	entry, ok := s.valueIndex[s.deriveValue(issuer, value)]
+	if !ok || s.isExpired(entry, timeFunc()) {
 		return nil, storage.ErrNotFound
 	}
 
 	// No error
-	return client.(*tokenv1.Token), nil
+	return proto.Clone(entry.token).(*tokenv1.Token), nil
 }
 
 func (s *tokenStorage) Delete(ctx context.Context, issuer, id string) error {
+	s.Lock()
+	defer s.Unlock()
+
 	// Retrieve token
-	t, err := s.Get(ctx, issuer, id)
-	if err != nil {
-		return err
+	entry, ok := s.idIndex[tokenKey{issuer: issuer, id: id}]
+	if !ok {
+		return storage.ErrNotFound
 	}
 
-	s.idIndex.Delete(t.TokenId)
-	s.valueIndex.Delete(t.Value)
+	s.remove(entry)
 
 	// No error
 	return nil
 }
 
 func (s *tokenStorage) Revoke(ctx context.Context, issuer, id string) error {
+	s.Lock()
+	defer s.Unlock()
+
 	// Retrieve token
-	t, err := s.Get(ctx, issuer, id)
-	if err != nil {
-		return err
+	entry, ok := s.idIndex[tokenKey{issuer: issuer, id: id}]
+	if !ok || s.isExpired(entry, timeFunc()) {
+		return storage.ErrNotFound
 	}
 
 	// Set as revoked
-	t.Status = tokenv1.TokenStatus_TOKEN_STATUS_REVOKED
+	s.revoke(entry, timeFunc())
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) Consume(ctx context.Context, issuer, id string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// Retrieve token
+	entry, ok := s.idIndex[tokenKey{issuer: issuer, id: id}]
+	if !ok || s.isExpired(entry, timeFunc()) {
+		return storage.ErrNotFound
+	}
+
+	// Only an active token can be consumed
+	if entry.token.Status != tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE {
+		return storage.ErrAlreadyUsed
+	}
+
+	// Set as revoked
+	s.revoke(entry, timeFunc())
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) RevokeByGrant(ctx context.Context, issuer, grantID string) error {
+	// Check parameters
+	if grantID == "" {
+		return fmt.Errorf("unable to revoke tokens with a blank grant id")
+	}
+
+	// Revoke all family members
+	s.revokeAll(issuer, func(t *tokenv1.Token) bool {
+		return t.GrantId == grantID
+	})
+
+	// No error
+	return nil
+}
 
-	// Update maps
-	s.idIndex.Store(t.TokenId, t)
-	s.valueIndex.Store(t.Value, t)
+func (s *tokenStorage) RevokeBySubject(ctx context.Context, issuer, subject string) error {
+	// Check parameters
+	if subject == "" {
+		return fmt.Errorf("unable to revoke tokens with a blank subject")
+	}
+
+	// Revoke all subject tokens
+	s.revokeAll(issuer, func(t *tokenv1.Token) bool {
+		return t.GetMetadata().GetSubject() == subject
+	})
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) RevokeByClient(ctx context.Context, issuer, clientID string) error {
+	// Check parameters
+	if clientID == "" {
+		return fmt.Errorf("unable to revoke tokens with a blank client id")
+	}
+
+	// Revoke all client tokens
+	s.revokeAll(issuer, func(t *tokenv1.Token) bool {
+		return t.GetMetadata().GetClientId() == clientID
+	})
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) AllocateStatusIndex(ctx context.Context, issuer string) (uint64, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Reserve the next index
+	list := s.statusList(issuer)
+	idx := list.size
+	list.size++
+
+	// No error
+	return idx, nil
+}
+
+func (s *tokenStorage) RevokedStatusIndexes(ctx context.Context, issuer string) (uint64, []uint64, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	// Check if the issuer has a status list
+	list, ok := s.statusLists[issuer]
+	if !ok {
+		return 0, nil, nil
+	}
+
+	// Collect revoked indexes
+	revoked := make([]uint64, 0, len(list.revoked))
+	for idx := range list.revoked {
+		revoked = append(revoked, idx)
+	}
+
+	// No error
+	return list.size, revoked, nil
+}
+
+func (s *tokenStorage) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+	s.wg.Wait()
 
 	// No error
 	return nil
@@ -119,6 +330,93 @@ func (s *tokenStorage) Revoke(ctx context.Context, issuer, id string) error {
 
 // -----------------------------------------------------------------------------
 
+func (s *tokenStorage) sweeper(interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.sweep()
+		}
+	}
+}
+
+func (s *tokenStorage) sweep() {
+	s.Lock()
+	defer s.Unlock()
+
+	now := timeFunc()
+	for _, entry := range s.idIndex {
+		if s.isExpired(entry, now) {
+			s.remove(entry)
+		}
+	}
+}
+
+func (s *tokenStorage) revoke(entry *tokenEntry, now time.Time) {
+	if entry.token.Status != tokenv1.TokenStatus_TOKEN_STATUS_REVOKED {
+		entry.token.Status = tokenv1.TokenStatus_TOKEN_STATUS_REVOKED
+		entry.revokedAt = now
+	}
+
+	// Flip the status list bit, kept after the token collection
+	if ref := entry.token.StatusList; ref != nil {
+		s.statusList(entry.issuer).revoked[ref.Idx] = struct{}{}
+	}
+}
+
+func (s *tokenStorage) statusList(issuer string) *statusListEntry {
+	list, ok := s.statusLists[issuer]
+	if !ok {
+		list = &statusListEntry{
+			revoked: map[uint64]struct{}{},
+		}
+		s.statusLists[issuer] = list
+	}
+
+	return list
+}
+
+func (s *tokenStorage) revokeAll(issuer string, match func(*tokenv1.Token) bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	now := timeFunc()
+	for _, entry := range s.idIndex {
+		if entry.issuer != issuer || s.isExpired(entry, now) || !match(entry.token) {
+			continue
+		}
+		s.revoke(entry, now)
+	}
+}
+
+func (s *tokenStorage) remove(entry *tokenEntry) {
+	delete(s.idIndex, tokenKey{issuer: entry.issuer, id: entry.token.TokenId})
+	delete(s.valueIndex, entry.token.Value)
+}
+
+func (s *tokenStorage) isExpired(entry *tokenEntry, now time.Time) bool {
+	expiresAt := entry.token.GetMetadata().GetExpiresAt()
+
+	// Revoked tokens are retained to be reported as revoked, at least until
+	// their expiration.
+	if !entry.revokedAt.IsZero() {
+		return !now.Before(entry.revokedAt.Add(s.revokedRetention)) && now.Unix() >= int64(expiresAt)
+	}
+
+	// Tokens without expiration are never collected
+	if expiresAt == 0 {
+		return false
+	}
+
+	return now.Unix() >= int64(expiresAt)
+}
+
 func (s *tokenStorage) deriveValue(issuer, value string) string {
 	// Create hasher
 	h, err := blake2b.New256([]byte(`%JwQL_C=w^R@9?{J,=;LHe=&n0L1P{QrS=MsA}7H]V3fHd8&$noL&"hZH;&Uw)3`))
@@ -0,0 +1,144 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/server/storage"
+)
+
+func Test_tokenStorage_Expiry(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Unix(1700000000, 0)
+	timeFunc = func() time.Time { return now }
+	t.Cleanup(func() {
+		timeFunc = time.Now
+	})
+
+	tokens := Tokens(WithSweepInterval(0), WithRevokedRetention(time.Hour))
+	t.Cleanup(func() {
+		require.NoError(t, tokens.Close())
+	})
+
+	newToken := func(id, value string, expiresAt time.Time) *tokenv1.Token {
+		return &tokenv1.Token{
+			TokenId: id,
+			Value:   value,
+			Status:  tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+			Metadata: &tokenv1.TokenMeta{
+				ExpiresAt: uint64(expiresAt.Unix()),
+			},
+		}
+	}
+
+	require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", newToken("short", "at_short", now.Add(time.Minute))))
+	require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", newToken("long", "at_long", now.Add(2*time.Hour))))
+	require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", newToken("revoked", "at_revoked", now.Add(time.Minute))))
+	require.NoError(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "revoked"))
+	require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", newToken("revoked-long", "at_revoked_long", now.Add(3*time.Hour))))
+	require.NoError(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "revoked-long"))
+
+	t.Run("expired token is not returned", func(t *testing.T) {
+		now = now.Add(time.Minute)
+
+		_, err := tokens.GetByValue(ctx, "http://127.0.0.1:8080", "at_short")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		require.ErrorIs(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "short"), storage.ErrNotFound)
+	})
+
+	t.Run("revoked token is retained", func(t *testing.T) {
+		out, err := tokens.GetByValue(ctx, "http://127.0.0.1:8080", "at_revoked")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+	})
+
+	t.Run("sweep", func(t *testing.T) {
+		tokens.(*tokenStorage).sweep()
+
+		s := tokens.(*tokenStorage)
+		require.Len(t, s.idIndex, 3)
+		require.Len(t, s.valueIndex, 3)
+	})
+
+	t.Run("revoked token retention elapsed", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		tokens.(*tokenStorage).sweep()
+
+		_, err := tokens.Get(ctx, "http://127.0.0.1:8080", "revoked")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		out, err := tokens.Get(ctx, "http://127.0.0.1:8080", "long")
+		require.NoError(t, err)
+		require.Equal(t, "long", out.TokenId)
+
+		out, err = tokens.Get(ctx, "http://127.0.0.1:8080", "revoked-long")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+	})
+
+	t.Run("revoked token expiration elapsed", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+		tokens.(*tokenStorage).sweep()
+
+		_, err := tokens.Get(ctx, "http://127.0.0.1:8080", "revoked-long")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func Test_tokenStorage_IssuerScope(t *testing.T) {
+	ctx := context.Background()
+
+	tokens := Tokens(WithSweepInterval(0))
+	t.Cleanup(func() {
+		require.NoError(t, tokens.Close())
+	})
+
+	for _, issuer := range []string{"http://127.0.0.1:8080", "http://127.0.0.1:8081"} {
+		require.NoError(t, tokens.Create(ctx, issuer, &tokenv1.Token{
+			Issuer:  issuer,
+			TokenId: "123456789",
+			Value:   "at_" + issuer,
+			Status:  tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		}))
+	}
+
+	require.NoError(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "123456789"))
+	require.NoError(t, tokens.Delete(ctx, "http://127.0.0.1:8080", "123456789"))
+
+	out, err := tokens.Get(ctx, "http://127.0.0.1:8081", "123456789")
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8081", out.Issuer)
+	require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, out.Status)
+}
+
+func Test_tokenStorage_Close(t *testing.T) {
+	tokens := Tokens(WithSweepInterval(time.Millisecond))
+
+	// Let the sweeper run a few times
+	time.Sleep(5 * time.Millisecond)
+
+	require.NoError(t, tokens.Close())
+	require.NoError(t, tokens.Close())
+}
@@ -0,0 +1,73 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+
+	"zntr.io/solid/server/storage"
+)
+
+type assertionJTICache struct {
+	db *stdsql.DB
+}
+
+// AssertionJTIs returns an assertion identifier cache backed by the given
+// database.
+func AssertionJTIs(db *stdsql.DB) storage.AssertionJTI {
+	return &assertionJTICache{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *assertionJTICache) Use(ctx context.Context, issuer, jti string, expiresAt uint64) error {
+	// Check arguments
+	if jti == "" {
+		return fmt.Errorf("jti must not be blank")
+	}
+
+	// Insert in database, the primary key prevents concurrent use
+	now := timeFunc().Unix()
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM assertion_jtis WHERE issuer = ? AND jti = ? AND expires_at <= ?`, issuer, jti, now); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO assertion_jtis (issuer, jti, expires_at) VALUES (?, ?, ?)`, issuer, jti, int64(expiresAt))
+		return err
+	}); err != nil {
+		// Check if the failure is caused by an existing identifier
+		var expiration int64
+		errQuery := s.db.QueryRowContext(ctx, `SELECT expires_at FROM assertion_jtis WHERE issuer = ? AND jti = ? AND expires_at > ?`, issuer, jti, now).Scan(&expiration)
+		switch {
+		case errQuery == nil:
+			return storage.ErrAlreadyUsed
+		case errors.Is(errQuery, stdsql.ErrNoRows):
+			return fmt.Errorf("unable to insert assertion identifier: %w", err)
+		default:
+			return fmt.Errorf("unable to check assertion identifier: %w", errQuery)
+		}
+	}
+
+	// No error
+	return nil
+}
@@ -0,0 +1,150 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/server/storage"
+)
+
+const (
+	authorizationCodeSessionKey = `Q8t]Fz{2w@Lr^n7%Xk<dJ;0pV|Hs?e(3cY~M!u=a6B$9N*oT_x,C4E:yWgP.i5R`
+	authorizationCodeSessionTTL = 1 * time.Minute
+)
+
+type sessionStorage struct {
+	db *stdsql.DB
+}
+
+// AuthorizationCodeSessions returns an authorization session manager backed by
+// the given database.
+func AuthorizationCodeSessions(db *stdsql.DB) storage.AuthorizationCodeSession {
+	return &sessionStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *sessionStorage) Register(ctx context.Context, issuer, code string, req *sessionv1.AuthorizationCodeSession) (uint64, error) {
+	// Check parameters
+	if req == nil {
+		return 0, errors.New("unable to register nil authorization code session")
+	}
+
+	// Serialize session
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to encode authorization code session: %w", err)
+	}
+
+	// Insert in database
+	key := s.deriveKey(issuer, code)
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM authorization_code_sessions WHERE code_key = ?`, key); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO authorization_code_sessions (code_key, expires_at, payload) VALUES (?, ?, ?)`, key, expiresAt(authorizationCodeSessionTTL), payload)
+		return err
+	}); err != nil {
+		return 0, fmt.Errorf("unable to insert authorization code session: %w", err)
+	}
+
+	// No error
+	return uint64(authorizationCodeSessionTTL.Seconds()), nil
+}
+
+func (s *sessionStorage) Delete(ctx context.Context, issuer, code string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM authorization_code_sessions WHERE code_key = ?`, s.deriveKey(issuer, code)); err != nil {
+		return fmt.Errorf("unable to delete authorization code session: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *sessionStorage) Get(ctx context.Context, issuer, code string) (*sessionv1.AuthorizationCodeSession, error) {
+	// Retrieve from database
+	var payload []byte
+	if err := s.db.QueryRowContext(ctx,
+		`SELECT payload FROM authorization_code_sessions WHERE code_key = ? AND expires_at > ?`,
+		s.deriveKey(issuer, code), timeFunc().Unix(),
+	).Scan(&payload); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve authorization code session: %w", err)
+	}
+
+	// Decode session
+	var req sessionv1.AuthorizationCodeSession
+	if err := proto.Unmarshal(payload, &req); err != nil {
+		return nil, fmt.Errorf("unable to decode authorization code session: %w", err)
+	}
+
+	// No error
+	return &req, nil
+}
+
+func (s *sessionStorage) Consume(ctx context.Context, issuer, code string) (*sessionv1.AuthorizationCodeSession, error) {
+	key := s.deriveKey(issuer, code)
+
+	var payload []byte
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		// Retrieve from database
+		if err := tx.QueryRowContext(ctx, `SELECT payload FROM authorization_code_sessions WHERE code_key = ? AND expires_at > ?`, key, timeFunc().Unix()).Scan(&payload); err != nil {
+			return err
+		}
+
+		// Burn after read, only the caller which removed the row wins
+		res, err := tx.ExecContext(ctx, `DELETE FROM authorization_code_sessions WHERE code_key = ?`, key)
+		if err != nil {
+			return err
+		}
+
+		return expectAffected(res)
+	}); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) || errors.Is(err, storage.ErrNotFound) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to consume authorization code session: %w", err)
+	}
+
+	// Decode payload
+	var out sessionv1.AuthorizationCodeSession
+	if err := proto.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("unable to decode authorization code session: %w", err)
+	}
+
+	// No error
+	return &out, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *sessionStorage) deriveKey(issuer, code string) string {
+	return deriveKey(authorizationCodeSessionKey, "solid:authorization-code-sessions:v1", issuer, code)
+}
@@ -0,0 +1,150 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	"zntr.io/solid/server/storage"
+)
+
+const (
+	authorizationRequestKey = `b6}K^zM*&1sGv?f<p>Xc8!wR2uJ)Yq[N;e4Tn~D@0h=L.9k:3aPiE{VrH|Zm%oU`
+	authorizationRequestTTL = 1 * time.Minute
+)
+
+type authorizationRequestStorage struct {
+	db *stdsql.DB
+}
+
+// AuthorizationRequests returns an authorization request manager backed by
+// the given database.
+func AuthorizationRequests(db *stdsql.DB) storage.AuthorizationRequest {
+	return &authorizationRequestStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *authorizationRequestStorage) Register(ctx context.Context, issuer, requestURI string, req *flowv1.AuthorizationRequest) (uint64, error) {
+	// Check parameters
+	if req == nil {
+		return 0, errors.New("unable to register nil authorization request")
+	}
+
+	// Serialize request
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to encode authorization request: %w", err)
+	}
+
+	// Insert in database
+	key := s.deriveKey(issuer, requestURI)
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM authorization_requests WHERE request_key = ?`, key); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO authorization_requests (request_key, expires_at, payload) VALUES (?, ?, ?)`, key, expiresAt(authorizationRequestTTL), payload)
+		return err
+	}); err != nil {
+		return 0, fmt.Errorf("unable to insert authorization request: %w", err)
+	}
+
+	// No error
+	return uint64(authorizationRequestTTL.Seconds()), nil
+}
+
+func (s *authorizationRequestStorage) Delete(ctx context.Context, issuer, requestURI string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM authorization_requests WHERE request_key = ?`, s.deriveKey(issuer, requestURI)); err != nil {
+		return fmt.Errorf("unable to delete authorization request: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *authorizationRequestStorage) Get(ctx context.Context, issuer, requestURI string) (*flowv1.AuthorizationRequest, error) {
+	// Retrieve from database
+	var payload []byte
+	if err := s.db.QueryRowContext(ctx,
+		`SELECT payload FROM authorization_requests WHERE request_key = ? AND expires_at > ?`,
+		s.deriveKey(issuer, requestURI), timeFunc().Unix(),
+	).Scan(&payload); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve authorization request: %w", err)
+	}
+
+	// Decode request
+	var req flowv1.AuthorizationRequest
+	if err := proto.Unmarshal(payload, &req); err != nil {
+		return nil, fmt.Errorf("unable to decode authorization request: %w", err)
+	}
+
+	// No error
+	return &req, nil
+}
+
+func (s *authorizationRequestStorage) Consume(ctx context.Context, issuer, requestURI string) (*flowv1.AuthorizationRequest, error) {
+	key := s.deriveKey(issuer, requestURI)
+
+	var payload []byte
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		// Retrieve from database
+		if err := tx.QueryRowContext(ctx, `SELECT payload FROM authorization_requests WHERE request_key = ? AND expires_at > ?`, key, timeFunc().Unix()).Scan(&payload); err != nil {
+			return err
+		}
+
+		// Burn after read, only the caller which removed the row wins
+		res, err := tx.ExecContext(ctx, `DELETE FROM authorization_requests WHERE request_key = ?`, key)
+		if err != nil {
+			return err
+		}
+
+		return expectAffected(res)
+	}); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) || errors.Is(err, storage.ErrNotFound) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to consume authorization request: %w", err)
+	}
+
+	// Decode payload
+	var out flowv1.AuthorizationRequest
+	if err := proto.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("unable to decode authorization request: %w", err)
+	}
+
+	// No error
+	return &out, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *authorizationRequestStorage) deriveKey(issuer, requestURI string) string {
+	return deriveKey(authorizationRequestKey, "solid:authorization-requests:v1", issuer, requestURI)
+}
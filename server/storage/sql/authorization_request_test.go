@@ -0,0 +1,104 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/server/storage"
+)
+
+func TestAuthorizationRequests(t *testing.T) {
+	ctx := context.Background()
+	requests := AuthorizationRequests(newTestDB(t))
+	advance := withFrozenTime(t, time.Unix(1700000000, 0))
+
+	const (
+		issuer     = "http://127.0.0.1:8080"
+		requestURI = "urn:ietf:params:oauth:request_uri:bwc4JK-ESC0w8acc191e-Y1LTC2"
+	)
+
+	t.Run("register", func(t *testing.T) {
+		expiresIn, err := requests.Register(ctx, issuer, requestURI, &flowv1.AuthorizationRequest{
+			ClientId: "s6BhdRkqt3",
+			State:    "af0ifjsldkj",
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(60), expiresIn)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		out, err := requests.Get(ctx, issuer, requestURI)
+		require.NoError(t, err)
+		require.Equal(t, "af0ifjsldkj", out.State)
+	})
+
+	t.Run("get from another issuer", func(t *testing.T) {
+		_, err := requests.Get(ctx, "http://127.0.0.1:8081", requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		advance(time.Minute)
+
+		_, err := requests.Get(ctx, issuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, requests.Delete(ctx, issuer, requestURI))
+	})
+}
+
+func TestAuthorizationCodeSessions(t *testing.T) {
+	ctx := context.Background()
+	sessions := AuthorizationCodeSessions(newTestDB(t))
+
+	const (
+		issuer = "http://127.0.0.1:8080"
+		code   = "SplxlOBeZQQYbYS6WxSbIA"
+	)
+
+	t.Run("register", func(t *testing.T) {
+		expiresIn, err := sessions.Register(ctx, issuer, code, &sessionv1.AuthorizationCodeSession{
+			Issuer:  issuer,
+			Subject: "foo",
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(60), expiresIn)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		out, err := sessions.Get(ctx, issuer, code)
+		require.NoError(t, err)
+		require.Equal(t, "foo", out.Subject)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, sessions.Delete(ctx, issuer, code))
+
+		_, err := sessions.Get(ctx, issuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -0,0 +1,174 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/server/storage"
+)
+
+const (
+	backchannelAuthenticationSessionKey = `Qe7]x!Lm@2|vT^cZ0(Hk;Wn%9_rS+bF$uY8:oJ<gD=1{aP~iN&4?tE*X6)cR.M`
+)
+
+type backchannelAuthenticationSessionStorage struct {
+	db *stdsql.DB
+}
+
+// BackchannelAuthenticationSessions returns a backchannel authentication
+// session manager backed by the given database.
+func BackchannelAuthenticationSessions(db *stdsql.DB) storage.BackchannelAuthenticationSession {
+	return &backchannelAuthenticationSessionStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *backchannelAuthenticationSessionStorage) Register(ctx context.Context, issuer, authReqID string, req *sessionv1.BackchannelAuthenticationSession) error {
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to register nil backchannel authentication session")
+	}
+
+	// Serialize session
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("unable to encode backchannel authentication session: %w", err)
+	}
+
+	// Insert in database
+	key := s.deriveAuthReqID(issuer, authReqID)
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM backchannel_authentication_sessions WHERE auth_req_key = ?`, key); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO backchannel_authentication_sessions (auth_req_key, expires_at, payload) VALUES (?, ?, ?)`, key, int64(req.ExpiresAt), payload)
+		return err
+	}); err != nil {
+		return fmt.Errorf("unable to insert backchannel authentication session: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *backchannelAuthenticationSessionStorage) Update(ctx context.Context, issuer, authReqID string, req *sessionv1.BackchannelAuthenticationSession) error {
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to update nil backchannel authentication session")
+	}
+
+	// Serialize session
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("unable to encode backchannel authentication session: %w", err)
+	}
+
+	// Update the session
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE backchannel_authentication_sessions SET payload = ?, expires_at = ? WHERE auth_req_key = ? AND expires_at > ?`,
+		payload, int64(req.ExpiresAt), s.deriveAuthReqID(issuer, authReqID), timeFunc().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update backchannel authentication session: %w", err)
+	}
+
+	// No error
+	return expectAffected(res)
+}
+
+func (s *backchannelAuthenticationSessionStorage) Delete(ctx context.Context, issuer, authReqID string) error {
+	// Delete from database
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM backchannel_authentication_sessions WHERE auth_req_key = ?`, s.deriveAuthReqID(issuer, authReqID)); err != nil {
+		return fmt.Errorf("unable to delete backchannel authentication session: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *backchannelAuthenticationSessionStorage) Get(ctx context.Context, issuer, authReqID string) (*sessionv1.BackchannelAuthenticationSession, error) {
+	// Retrieve from database
+	var payload []byte
+	if err := s.db.QueryRowContext(ctx,
+		`SELECT payload FROM backchannel_authentication_sessions WHERE auth_req_key = ? AND expires_at > ?`,
+		s.deriveAuthReqID(issuer, authReqID), timeFunc().Unix(),
+	).Scan(&payload); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve backchannel authentication session: %w", err)
+	}
+
+	// Decode session
+	var req sessionv1.BackchannelAuthenticationSession
+	if err := proto.Unmarshal(payload, &req); err != nil {
+		return nil, fmt.Errorf("unable to decode backchannel authentication session: %w", err)
+	}
+
+	// No error
+	return &req, nil
+}
+
+func (s *backchannelAuthenticationSessionStorage) Consume(ctx context.Context, issuer, authReqID string) (*sessionv1.BackchannelAuthenticationSession, error) {
+	key := s.deriveAuthReqID(issuer, authReqID)
+
+	var payload []byte
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		// Retrieve from database
+		if err := tx.QueryRowContext(ctx, `SELECT payload FROM backchannel_authentication_sessions WHERE auth_req_key = ? AND expires_at > ?`, key, timeFunc().Unix()).Scan(&payload); err != nil {
+			return err
+		}
+
+		// Burn after read, only the caller which removed the row wins
+		res, err := tx.ExecContext(ctx, `DELETE FROM backchannel_authentication_sessions WHERE auth_req_key = ?`, key)
+		if err != nil {
+			return err
+		}
+
+		return expectAffected(res)
+	}); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) || errors.Is(err, storage.ErrNotFound) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to consume backchannel authentication session: %w", err)
+	}
+
+	// Decode session
+	var req sessionv1.BackchannelAuthenticationSession
+	if err := proto.Unmarshal(payload, &req); err != nil {
+		return nil, fmt.Errorf("unable to decode backchannel authentication session: %w", err)
+	}
+
+	// No error
+	return &req, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *backchannelAuthenticationSessionStorage) deriveAuthReqID(issuer, authReqID string) string {
+	return deriveKey(backchannelAuthenticationSessionKey, "solid:backchannel-authentication-request-id:v1", issuer, authReqID)
+}
@@ -0,0 +1,105 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dchest/uniuri"
+	"google.golang.org/protobuf/proto"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	"zntr.io/solid/server/storage"
+)
+
+type clientStorage struct {
+	db *stdsql.DB
+}
+
+// Clients returns a client manager backed by the given database.
+func Clients(db *stdsql.DB) storage.Client {
+	return &clientStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *clientStorage) Get(ctx context.Context, id string) (*clientv1.Client, error) {
+	// Retrieve from database
+	row := s.db.QueryRowContext(ctx, `SELECT payload FROM clients WHERE client_id = ?`, id)
+
+	// No error
+	return s.scan(row)
+}
+
+func (s *clientStorage) GetByName(ctx context.Context, name string) (*clientv1.Client, error) {
+	// Retrieve from database
+	row := s.db.QueryRowContext(ctx, `SELECT payload FROM clients WHERE client_name = ? LIMIT 1`, name)
+
+	// No error
+	return s.scan(row)
+}
+
+func (s *clientStorage) Register(ctx context.Context, c *clientv1.Client) (string, error) {
+	// Check parameters
+	if c == nil {
+		return "", errors.New("unable to register nil client")
+	}
+
+	// Assign client id
+	c.ClientId = uniuri.NewLen(16)
+
+	// Serialize client
+	payload, err := proto.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode client: %w", err)
+	}
+
+	// Insert in database
+	if _, err := s.db.ExecContext(ctx, `INSERT INTO clients (client_id, client_name, payload) VALUES (?, ?, ?)`, c.ClientId, c.ClientName, payload); err != nil {
+		return "", fmt.Errorf("unable to insert client: %w", err)
+	}
+
+	// No error
+	return c.ClientId, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *clientStorage) scan(row *stdsql.Row) (*clientv1.Client, error) {
+	var payload []byte
+	if err := row.Scan(&payload); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve client: %w", err)
+	}
+
+	// Decode client
+	var c clientv1.Client
+	if err := proto.Unmarshal(payload, &c); err != nil {
+		return nil, fmt.Errorf("unable to decode client: %w", err)
+	}
+
+	// No error
+	return &c, nil
+}
@@ -0,0 +1,115 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	scopev1 "zntr.io/solid/api/oidc/scope/v1"
+	"zntr.io/solid/server/storage"
+)
+
+func TestClients(t *testing.T) {
+	ctx := context.Background()
+	clients := Clients(newTestDB(t))
+
+	var clientID string
+	t.Run("register", func(t *testing.T) {
+		var err error
+		clientID, err = clients.Register(ctx, &clientv1.Client{
+			ClientName: "test-client",
+			GrantTypes: []string{"client_credentials"},
+		})
+		require.NoError(t, err)
+		require.Len(t, clientID, 16)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		out, err := clients.Get(ctx, clientID)
+		require.NoError(t, err)
+		require.Equal(t, "test-client", out.ClientName)
+	})
+
+	t.Run("get by name", func(t *testing.T) {
+		out, err := clients.GetByName(ctx, "test-client")
+		require.NoError(t, err)
+		require.Equal(t, clientID, out.ClientId)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := clients.Get(ctx, "unknown")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestResources(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	resources := Resources(db)
+
+	payload, err := proto.Marshal(&resourcev1.Resource{
+		Urn:         "urn:example:backend-api",
+		Description: "Backend API",
+		Urls:        []string{"https://backend.example.com/api"},
+	})
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO resources (urn, payload) VALUES (?, ?)`, "urn:example:backend-api", payload)
+	require.NoError(t, err)
+
+	t.Run("get", func(t *testing.T) {
+		out, err := resources.GetByURI(ctx, "urn:example:backend-api")
+		require.NoError(t, err)
+		require.Equal(t, []string{"https://backend.example.com/api"}, out.Urls)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := resources.GetByURI(ctx, "urn:example:unknown")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestScopes(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	scopes := Scopes(db)
+
+	payload, err := proto.Marshal(&scopev1.Scope{
+		Name:        "profile",
+		Description: "End-user default profile claims",
+	})
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO scopes (name, payload) VALUES (?, ?)`, "profile", payload)
+	require.NoError(t, err)
+
+	t.Run("get", func(t *testing.T) {
+		out, err := scopes.GetByName(ctx, "profile")
+		require.NoError(t, err)
+		require.Equal(t, "End-user default profile claims", out.Description)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := scopes.GetByName(ctx, "admin")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
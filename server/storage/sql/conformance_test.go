@@ -0,0 +1,81 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"zntr.io/solid/server/storage"
+	"zntr.io/solid/server/storage/storagetest"
+)
+
+func TestConformance(t *testing.T) {
+	clock := storagetest.WithClock(withFrozenTime(t, time.Unix(1700000000, 0)))
+
+	t.Run("clients", func(t *testing.T) {
+		storagetest.RunClientSuite(t, func(t *testing.T) storage.Client {
+			return Clients(newTestDB(t))
+		}, clock)
+	})
+	t.Run("authorization requests", func(t *testing.T) {
+		storagetest.RunAuthorizationRequestSuite(t, func(t *testing.T) storage.AuthorizationRequest {
+			return AuthorizationRequests(newTestDB(t))
+		}, clock)
+	})
+	t.Run("authorization code sessions", func(t *testing.T) {
+		storagetest.RunAuthorizationCodeSessionSuite(t, func(t *testing.T) storage.AuthorizationCodeSession {
+			return AuthorizationCodeSessions(newTestDB(t))
+		}, clock)
+	})
+	t.Run("device code sessions", func(t *testing.T) {
+		storagetest.RunDeviceCodeSessionSuite(t, func(t *testing.T) storage.DeviceCodeSession {
+			return DeviceCodeSessions(newTestDB(t))
+		}, clock)
+	})
+	t.Run("backchannel authentication sessions", func(t *testing.T) {
+		storagetest.RunBackchannelAuthenticationSessionSuite(t, func(t *testing.T) storage.BackchannelAuthenticationSession {
+			return BackchannelAuthenticationSessions(newTestDB(t))
+		}, clock, storagetest.WithNow(func() time.Time { return timeFunc() }))
+	})
+	t.Run("tokens", func(t *testing.T) {
+		storagetest.RunTokenSuite(t, func(t *testing.T) storage.Token {
+			return Tokens(newTestDB(t))
+		}, clock, storagetest.WithNow(func() time.Time { return timeFunc() }), storagetest.WithTokenRevokedRetention(tokenRevokedRetention))
+	})
+	t.Run("token status lists", func(t *testing.T) {
+		storagetest.RunTokenStatusListSuite(t, func(t *testing.T) storagetest.TokenStatusListStorage {
+			return Tokens(newTestDB(t))
+		}, clock)
+	})
+	t.Run("dpop proofs", func(t *testing.T) {
+		storagetest.RunDPoPSuite(t, func(t *testing.T) storage.DPoP {
+			return DPoPProofs(newTestDB(t))
+		}, clock)
+	})
+	t.Run("assertion identifiers", func(t *testing.T) {
+		storagetest.RunAssertionJTISuite(t, func(t *testing.T) storage.AssertionJTI {
+			return AssertionJTIs(newTestDB(t))
+		}, clock, storagetest.WithNow(func() time.Time { return timeFunc() }))
+	})
+	t.Run("device user code attempts", func(t *testing.T) {
+		storagetest.RunDeviceUserCodeAttemptSuite(t, func(t *testing.T) storage.DeviceUserCodeAttempt {
+			return DeviceUserCodeAttempts(newTestDB(t))
+		}, clock, storagetest.WithNow(func() time.Time { return timeFunc() }))
+	})
+}
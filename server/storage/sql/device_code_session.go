@@ -0,0 +1,244 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/server/storage"
+)
+
+const (
+	deviceCodeSessionKey = `r5&Gk!7zQ|Jd^Wv0{Lm;t8Y@c=Xe2]H+bN$u:a*S4~P<f9o?C%iE)1T.sVhR3n_`
+	deviceCodeSessionTTL = 2 * time.Minute
+)
+
+type deviceCodeSessionStorage struct {
+	db *stdsql.DB
+}
+
+// DeviceCodeSessions returns a device authorization session manager backed by
+// the given database.
+func DeviceCodeSessions(db *stdsql.DB) storage.DeviceCodeSession {
+	return &deviceCodeSessionStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *deviceCodeSessionStorage) Register(ctx context.Context, issuer, userCode string, req *sessionv1.DeviceCodeSession) (uint64, error) {
+	// Check parameters
+	if req == nil {
+		return 0, errors.New("unable to register nil device code session")
+	}
+
+	// Serialize session
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to encode device code session: %w", err)
+	}
+
+	// Insert in database
+	deviceCodeKey := s.deriveDeviceCode(issuer, req.DeviceCode)
+	userCodeKey := s.deriveUserCode(issuer, userCode)
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM device_code_sessions WHERE device_code_key = ? OR user_code_key = ?`, deviceCodeKey, userCodeKey); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO device_code_sessions (device_code_key, user_code_key, status, last_polled_at, polling_interval, expires_at, payload) VALUES (?, ?, ?, ?, ?, ?, ?)`, deviceCodeKey, userCodeKey, int32(req.Status), req.LastPolledAt, req.Interval, expiresAt(deviceCodeSessionTTL), payload)
+		return err
+	}); err != nil {
+		return 0, fmt.Errorf("unable to insert device code session: %w", err)
+	}
+
+	// No error
+	return uint64(deviceCodeSessionTTL.Seconds()), nil
+}
+
+func (s *deviceCodeSessionStorage) Delete(ctx context.Context, issuer, userCode string) error {
+	// Only remove the user code index, the session must remain reachable by
+	// its device code for polling clients.
+	if _, err := s.db.ExecContext(ctx, `UPDATE device_code_sessions SET user_code_key = NULL WHERE user_code_key = ?`, s.deriveUserCode(issuer, userCode)); err != nil {
+		return fmt.Errorf("unable to delete device code session: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *deviceCodeSessionStorage) GetByDeviceCode(ctx context.Context, issuer, deviceCode string) (*sessionv1.DeviceCodeSession, error) {
+	// Retrieve from database
+	row := s.db.QueryRowContext(ctx,
+		`SELECT payload, last_polled_at, polling_interval FROM device_code_sessions WHERE device_code_key = ? AND expires_at > ?`,
+		s.deriveDeviceCode(issuer, deviceCode), timeFunc().Unix(),
+	)
+
+	// No error
+	return s.scan(row)
+}
+
+func (s *deviceCodeSessionStorage) GetByUserCode(ctx context.Context, issuer, userCode string) (*sessionv1.DeviceCodeSession, error) {
+	// Retrieve from database
+	row := s.db.QueryRowContext(ctx,
+		`SELECT payload, last_polled_at, polling_interval FROM device_code_sessions WHERE user_code_key = ? AND expires_at > ?`,
+		s.deriveUserCode(issuer, userCode), timeFunc().Unix(),
+	)
+
+	// No error
+	return s.scan(row)
+}
+
+func (s *deviceCodeSessionStorage) Validate(ctx context.Context, issuer, userCode string, req *sessionv1.DeviceCodeSession) error {
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to validate nil device code session")
+	}
+
+	// Serialize session
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("unable to encode device code session: %w", err)
+	}
+
+	// Update the session and extend its lifetime, the polling state is kept
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE device_code_sessions SET status = ?, payload = ?, expires_at = ? WHERE device_code_key = ? AND expires_at > ?`,
+		int32(req.Status), payload, expiresAt(deviceCodeSessionTTL), s.deriveDeviceCode(issuer, req.DeviceCode), timeFunc().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update device code session: %w", err)
+	}
+
+	// No error
+	return expectAffected(res)
+}
+
+func (s *deviceCodeSessionStorage) Update(ctx context.Context, issuer, deviceCode string, req *sessionv1.DeviceCodeSession) error {
+	// Check parameters
+	if req == nil {
+		return errors.New("unable to update nil device code session")
+	}
+
+	// Serialize session
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("unable to encode device code session: %w", err)
+	}
+
+	// Update the session, keep its lifetime and polling state
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE device_code_sessions SET status = ?, payload = ? WHERE device_code_key = ? AND expires_at > ?`,
+		int32(req.Status), payload, s.deriveDeviceCode(issuer, deviceCode), timeFunc().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update device code session: %w", err)
+	}
+
+	// No error
+	return expectAffected(res)
+}
+
+func (s *deviceCodeSessionStorage) RecordPoll(ctx context.Context, issuer, deviceCode string, lastPolledAt, interval uint64) error {
+	// Update the polling state of pending sessions only
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE device_code_sessions SET last_polled_at = ?, polling_interval = ? WHERE device_code_key = ? AND status IN (?, ?) AND expires_at > ?`,
+		lastPolledAt, interval, s.deriveDeviceCode(issuer, deviceCode),
+		int32(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING), int32(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING),
+		timeFunc().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to record device code session poll: %w", err)
+	}
+
+	// No error
+	return expectAffected(res)
+}
+
+func (s *deviceCodeSessionStorage) Consume(ctx context.Context, issuer, deviceCode string) (*sessionv1.DeviceCodeSession, error) {
+	key := s.deriveDeviceCode(issuer, deviceCode)
+
+	var out *sessionv1.DeviceCodeSession
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		// Retrieve from database
+		var err error
+		out, err = s.scan(tx.QueryRowContext(ctx, `SELECT payload, last_polled_at, polling_interval FROM device_code_sessions WHERE device_code_key = ? AND expires_at > ?`, key, timeFunc().Unix()))
+		if err != nil {
+			return err
+		}
+
+		// Burn after read, only the caller which removed the row wins
+		res, err := tx.ExecContext(ctx, `DELETE FROM device_code_sessions WHERE device_code_key = ?`, key)
+		if err != nil {
+			return err
+		}
+
+		return expectAffected(res)
+	}); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to consume device code session: %w", err)
+	}
+
+	// No error
+	return out, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *deviceCodeSessionStorage) scan(row *stdsql.Row) (*sessionv1.DeviceCodeSession, error) {
+	var (
+		payload                []byte
+		lastPolledAt, interval uint64
+	)
+	if err := row.Scan(&payload, &lastPolledAt, &interval); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve device code session: %w", err)
+	}
+
+	// Decode session
+	var req sessionv1.DeviceCodeSession
+	if err := proto.Unmarshal(payload, &req); err != nil {
+		return nil, fmt.Errorf("unable to decode device code session: %w", err)
+	}
+
+	// Polling state is only maintained by its dedicated columns
+	req.LastPolledAt = lastPolledAt
+	req.Interval = interval
+
+	// No error
+	return &req, nil
+}
+
+func (s *deviceCodeSessionStorage) deriveUserCode(issuer, code string) string {
+	return deriveKey(deviceCodeSessionKey, "solid:device-authorization-user-code:v1", issuer, code)
+}
+
+func (s *deviceCodeSessionStorage) deriveDeviceCode(issuer, code string) string {
+	return deriveKey(deviceCodeSessionKey, "solid:device-authorization-device-code:v1", issuer, code)
+}
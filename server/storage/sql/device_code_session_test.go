@@ -0,0 +1,87 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/server/storage"
+)
+
+func TestDeviceCodeSessions(t *testing.T) {
+	ctx := context.Background()
+	sessions := DeviceCodeSessions(newTestDB(t))
+	advance := withFrozenTime(t, time.Unix(1700000000, 0))
+
+	const (
+		issuer     = "http://127.0.0.1:8080"
+		userCode   = "WDJB-MJHT"
+		deviceCode = "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS"
+	)
+
+	t.Run("register", func(t *testing.T) {
+		expiresIn, err := sessions.Register(ctx, issuer, userCode, &sessionv1.DeviceCodeSession{
+			Issuer:     issuer,
+			DeviceCode: deviceCode,
+			Status:     sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(120), expiresIn)
+	})
+
+	t.Run("get by user code", func(t *testing.T) {
+		out, err := sessions.GetByUserCode(ctx, issuer, userCode)
+		require.NoError(t, err)
+		require.Equal(t, deviceCode, out.DeviceCode)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		require.NoError(t, sessions.Validate(ctx, issuer, userCode, &sessionv1.DeviceCodeSession{
+			Issuer:     issuer,
+			DeviceCode: deviceCode,
+			Status:     sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
+		}))
+		require.NoError(t, sessions.Delete(ctx, issuer, userCode))
+	})
+
+	t.Run("user code is burnt", func(t *testing.T) {
+		_, err := sessions.GetByUserCode(ctx, issuer, userCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("device code still reachable", func(t *testing.T) {
+		out, err := sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED, out.Status)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		advance(2 * time.Minute)
+
+		_, err := sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		require.ErrorIs(t, sessions.Validate(ctx, issuer, userCode, &sessionv1.DeviceCodeSession{
+			DeviceCode: deviceCode,
+		}), storage.ErrNotFound)
+	})
+}
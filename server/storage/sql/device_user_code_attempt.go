@@ -0,0 +1,116 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+
+	"zntr.io/solid/server/storage"
+)
+
+type deviceUserCodeAttemptStorage struct {
+	db *stdsql.DB
+}
+
+// DeviceUserCodeAttempts returns a failed user code attempt counter storage
+// backed by the given database.
+func DeviceUserCodeAttempts(db *stdsql.DB) storage.DeviceUserCodeAttempt {
+	return &deviceUserCodeAttemptStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *deviceUserCodeAttemptStorage) Get(ctx context.Context, issuer, key string) (failures, lastFailedAt uint64, err error) {
+	// Retrieve from database
+	if err := s.db.QueryRowContext(ctx,
+		`SELECT failures, last_failed_at FROM device_user_code_attempts WHERE issuer = ? AND attempt_key = ? AND expires_at > ?`,
+		issuer, key, timeFunc().Unix(),
+	).Scan(&failures, &lastFailedAt); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return 0, 0, nil
+		}
+		return 0, 0, fmt.Errorf("unable to retrieve user code attempts: %w", err)
+	}
+
+	// No error
+	return failures, lastFailedAt, nil
+}
+
+func (s *deviceUserCodeAttemptStorage) Fail(ctx context.Context, issuer, key string, expiresAt uint64) (uint64, error) {
+	// Check arguments
+	if key == "" {
+		return 0, fmt.Errorf("key must not be blank")
+	}
+
+	// Increment the counter, or create it when missing or expired
+	now := timeFunc().Unix()
+	var failures uint64
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM device_user_code_attempts WHERE issuer = ? AND attempt_key = ? AND expires_at <= ?`, issuer, key, now); err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, `UPDATE device_user_code_attempts SET failures = failures + 1, last_failed_at = ? WHERE issuer = ? AND attempt_key = ?`, now, issuer, key)
+		if err != nil {
+			return err
+		}
+		if errAffected := expectAffected(res); errAffected != nil {
+			if !errors.Is(errAffected, storage.ErrNotFound) {
+				return errAffected
+			}
+			if _, err := tx.ExecContext(ctx, `INSERT INTO device_user_code_attempts (issuer, attempt_key, failures, last_failed_at, expires_at) VALUES (?, ?, 1, ?, ?)`, issuer, key, now, int64(expiresAt)); err != nil {
+				return err
+			}
+		}
+
+		return tx.QueryRowContext(ctx, `SELECT failures FROM device_user_code_attempts WHERE issuer = ? AND attempt_key = ?`, issuer, key).Scan(&failures)
+	}); err != nil {
+		return 0, fmt.Errorf("unable to register user code attempt: %w", err)
+	}
+
+	// No error
+	return failures, nil
+}
+
+func (s *deviceUserCodeAttemptStorage) Release(ctx context.Context, issuer, key string) error {
+	// Decrement the counter
+	if _, err := s.db.ExecContext(ctx,
+		`UPDATE device_user_code_attempts SET failures = failures - 1 WHERE issuer = ? AND attempt_key = ? AND failures > 0 AND expires_at > ?`,
+		issuer, key, timeFunc().Unix(),
+	); err != nil {
+		return fmt.Errorf("unable to release user code attempt: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *deviceUserCodeAttemptStorage) Reset(ctx context.Context, issuer, key string) error {
+	// Delete from database
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM device_user_code_attempts WHERE issuer = ? AND attempt_key = ?`, issuer, key); err != nil {
+		return fmt.Errorf("unable to reset user code attempts: %w", err)
+	}
+
+	// No error
+	return nil
+}
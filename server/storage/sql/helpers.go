@@ -0,0 +1,133 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+
+	"zntr.io/solid/server/storage"
+)
+
+// timeFunc is used to retrieve the current time, overridden for testing.
+var timeFunc = time.Now
+
+// Purge removes all expired ephemeral objects (authorization requests,
+// sessions, dpop proofs, assertion identifiers, user code attempts) and
+// expired tokens from the database. Expired rows are never returned by
+// readers, this function must be scheduled by the caller to reclaim space.
+// Revoked tokens are kept until the end of their revoked retention.
+func Purge(ctx context.Context, db *stdsql.DB) (int64, error) {
+	now := timeFunc().Unix()
+
+	var total int64
+	for _, table := range []string{
+		"authorization_requests",
+		"authorization_code_sessions",
+		"device_code_sessions",
+		"backchannel_authentication_sessions",
+		"dpop_proofs",
+		"assertion_jtis",
+		"device_user_code_attempts",
+	} {
+		res, err := db.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE expires_at <= ?`, table), now)
+		if err != nil {
+			return total, fmt.Errorf("unable to purge expired %s: %w", table, err)
+		}
+
+		count, err := res.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("unable to count purged %s: %w", table, err)
+		}
+		total += count
+	}
+
+	// Tokens without expiration are never collected
+	res, err := db.ExecContext(ctx, `DELETE FROM tokens WHERE expires_at > 0 AND expires_at <= ?`, now)
+	if err != nil {
+		return total, fmt.Errorf("unable to purge expired tokens: %w", err)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return total, fmt.Errorf("unable to count purged tokens: %w", err)
+	}
+	total += count
+
+	// No error
+	return total, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func withTx(ctx context.Context, db *stdsql.DB, fn func(tx *stdsql.Tx) error) error {
+	// Start a transaction
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %w", err)
+	}
+
+	// Delegate to callback
+	if err := fn(tx); err != nil {
+		//nolint:errcheck // the callback error is more relevant
+		tx.Rollback()
+		return err
+	}
+
+	// Commit changes
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit transaction: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func expectAffected(res stdsql.Result) error {
+	count, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to count affected rows: %w", err)
+	}
+	if count == 0 {
+		return storage.ErrNotFound
+	}
+
+	// No error
+	return nil
+}
+
+func expiresAt(ttl time.Duration) int64 {
+	return timeFunc().Add(ttl).Unix()
+}
+
+func deriveKey(secret, purpose, issuer, value string) string {
+	// Create hasher
+	h, err := blake2b.New256([]byte(secret))
+	if err != nil {
+		panic(err)
+	}
+
+	h.Write([]byte(purpose))
+	h.Write([]byte(issuer))
+	h.Write([]byte(value))
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
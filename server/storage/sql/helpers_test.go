@@ -0,0 +1,118 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+)
+
+func newTestDB(t *testing.T) *stdsql.DB {
+	t.Helper()
+
+	db, err := stdsql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	// In-memory databases are bound to their connection
+	db.SetMaxOpenConns(1)
+
+	require.NoError(t, Migrate(context.Background(), db))
+
+	return db
+}
+
+func withFrozenTime(t *testing.T, now time.Time) func(time.Duration) {
+	t.Helper()
+
+	current := now
+	timeFunc = func() time.Time { return current }
+	t.Cleanup(func() {
+		timeFunc = time.Now
+	})
+
+	return func(d time.Duration) {
+		current = current.Add(d)
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	t.Run("idempotent", func(t *testing.T) {
+		require.NoError(t, Migrate(ctx, db))
+	})
+
+	t.Run("version recorded", func(t *testing.T) {
+		var version uint64
+		require.NoError(t, db.QueryRowContext(ctx, `SELECT MAX(version) FROM schema_migrations`).Scan(&version))
+		require.Equal(t, migrations[len(migrations)-1].version, version)
+	})
+
+	t.Run("nil database", func(t *testing.T) {
+		require.Error(t, Migrate(ctx, nil))
+	})
+}
+
+func TestPurge(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	advance := withFrozenTime(t, time.Unix(1700000000, 0))
+
+	proofs := DPoPProofs(db)
+	require.NoError(t, proofs.Register(ctx, "expired"))
+
+	tokens := Tokens(db)
+	for id, expiresAt := range map[string]uint64{
+		"expired":   uint64(timeFunc().Add(time.Minute).Unix()),
+		"unbounded": 0,
+	} {
+		require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", &tokenv1.Token{
+			TokenId:  id,
+			Value:    "at_" + id,
+			Status:   tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+			Metadata: &tokenv1.TokenMeta{ExpiresAt: expiresAt},
+		}))
+	}
+
+	advance(2 * time.Minute)
+	require.NoError(t, proofs.Register(ctx, "alive"))
+
+	count, err := Purge(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	found, err := proofs.Exists(ctx, "alive")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = tokens.Get(ctx, "http://127.0.0.1:8080", "unbounded")
+	require.NoError(t, err)
+}
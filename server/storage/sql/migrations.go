@@ -0,0 +1,226 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+)
+
+// migration describes a versioned schema change.
+type migration struct {
+	version     uint64
+	description string
+	statements  []string
+}
+
+// migrations holds the ordered list of schema changes. Applied migrations
+// must never be edited, append a new version instead.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "initial schema",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS clients (
+				client_id VARCHAR(255) NOT NULL PRIMARY KEY,
+				client_name VARCHAR(255) NOT NULL,
+				payload BLOB NOT NULL
+			)`,
+			`CREATE INDEX IF NOT EXISTS clients_client_name_idx ON clients (client_name)`,
+			`CREATE TABLE IF NOT EXISTS resources (
+				urn VARCHAR(255) NOT NULL PRIMARY KEY,
+				payload BLOB NOT NULL
+			)`,
+			`CREATE TABLE IF NOT EXISTS tokens (
+				issuer VARCHAR(255) NOT NULL,
+				token_id VARCHAR(255) NOT NULL,
+				value_hash VARCHAR(64) NOT NULL,
+				status INTEGER NOT NULL,
+				expires_at BIGINT NOT NULL,
+				payload BLOB NOT NULL,
+				PRIMARY KEY (issuer, token_id)
+			)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS tokens_value_hash_idx ON tokens (value_hash)`,
+			`CREATE TABLE IF NOT EXISTS authorization_requests (
+				request_key VARCHAR(64) NOT NULL PRIMARY KEY,
+				expires_at BIGINT NOT NULL,
+				payload BLOB NOT NULL
+			)`,
+			`CREATE TABLE IF NOT EXISTS authorization_code_sessions (
+				code_key VARCHAR(64) NOT NULL PRIMARY KEY,
+				expires_at BIGINT NOT NULL,
+				payload BLOB NOT NULL
+			)`,
+			`CREATE TABLE IF NOT EXISTS device_code_sessions (
+				device_code_key VARCHAR(64) NOT NULL PRIMARY KEY,
+				user_code_key VARCHAR(64) NULL,
+				expires_at BIGINT NOT NULL,
+				payload BLOB NOT NULL
+			)`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS device_code_sessions_user_code_key_idx ON device_code_sessions (user_code_key)`,
+			`CREATE TABLE IF NOT EXISTS dpop_proofs (
+				proof_id VARCHAR(255) NOT NULL PRIMARY KEY,
+				expires_at BIGINT NOT NULL
+			)`,
+		},
+	},
+	{
+		version:     2,
+		description: "token families",
+		statements: []string{
+			`ALTER TABLE tokens ADD COLUMN grant_id VARCHAR(255) NOT NULL DEFAULT ''`,
+			`CREATE INDEX IF NOT EXISTS tokens_grant_id_idx ON tokens (issuer, grant_id)`,
+		},
+	},
+	{
+		version:     3,
+		description: "assertion identifiers",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS assertion_jtis (
+				issuer VARCHAR(255) NOT NULL,
+				jti VARCHAR(255) NOT NULL,
+				expires_at BIGINT NOT NULL,
+				PRIMARY KEY (issuer, jti)
+			)`,
+		},
+	},
+	{
+		version:     4,
+		description: "scope registry",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS scopes (
+				name VARCHAR(255) NOT NULL PRIMARY KEY,
+				payload BLOB NOT NULL
+			)`,
+		},
+	},
+	{
+		version:     5,
+		description: "token owners",
+		statements: []string{
+			`ALTER TABLE tokens ADD COLUMN subject VARCHAR(255) NOT NULL DEFAULT ''`,
+			`ALTER TABLE tokens ADD COLUMN client_id VARCHAR(255) NOT NULL DEFAULT ''`,
+			`CREATE INDEX IF NOT EXISTS tokens_subject_idx ON tokens (issuer, subject)`,
+			`CREATE INDEX IF NOT EXISTS tokens_client_id_idx ON tokens (issuer, client_id)`,
+		},
+	},
+	{
+		version:     6,
+		description: "token status lists",
+		statements: []string{
+			`ALTER TABLE tokens ADD COLUMN status_index BIGINT NULL`,
+			`CREATE INDEX IF NOT EXISTS tokens_status_index_idx ON tokens (issuer, status_index)`,
+			`CREATE TABLE IF NOT EXISTS token_status_lists (
+				issuer VARCHAR(255) NOT NULL PRIMARY KEY,
+				size BIGINT NOT NULL
+			)`,
+		},
+	},
+	{
+		version:     7,
+		description: "backchannel authentication sessions",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS backchannel_authentication_sessions (
+				auth_req_key VARCHAR(64) NOT NULL PRIMARY KEY,
+				expires_at BIGINT NOT NULL,
+				payload BLOB NOT NULL
+			)`,
+		},
+	},
+	{
+		version:     8,
+		description: "device user code attempts",
+		statements: []string{
+			`CREATE TABLE IF NOT EXISTS device_user_code_attempts (
+				issuer VARCHAR(255) NOT NULL,
+				attempt_key VARCHAR(255) NOT NULL,
+				failures BIGINT NOT NULL,
+				last_failed_at BIGINT NOT NULL,
+				expires_at BIGINT NOT NULL,
+				PRIMARY KEY (issuer, attempt_key)
+			)`,
+		},
+	},
+	{
+		version:     9,
+		description: "device code polling state",
+		statements: []string{
+			`ALTER TABLE device_code_sessions ADD COLUMN status INTEGER NOT NULL DEFAULT 0`,
+			`ALTER TABLE device_code_sessions ADD COLUMN last_polled_at BIGINT NOT NULL DEFAULT 0`,
+			`ALTER TABLE device_code_sessions ADD COLUMN polling_interval BIGINT NOT NULL DEFAULT 0`,
+		},
+	},
+}
+
+// Migrate applies all pending schema migrations to the given database.
+// It is safe to call it on every startup, already applied versions are
+// skipped.
+func Migrate(ctx context.Context, db *stdsql.DB) error {
+	// Check arguments
+	if db == nil {
+		return errors.New("unable to migrate a nil database")
+	}
+
+	// Ensure the version table exists
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT NOT NULL PRIMARY KEY,
+		description VARCHAR(255) NOT NULL,
+		applied_at BIGINT NOT NULL
+	)`); err != nil {
+		return fmt.Errorf("unable to create schema version table: %w", err)
+	}
+
+	// Retrieve current schema version
+	var current uint64
+	if err := db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return fmt.Errorf("unable to retrieve current schema version: %w", err)
+	}
+
+	// Apply pending migrations
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		if err := applyMigration(ctx, db, m); err != nil {
+			return fmt.Errorf("unable to apply migration %d (%s): %w", m.version, m.description, err)
+		}
+	}
+
+	// No error
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+
+func applyMigration(ctx context.Context, db *stdsql.DB, m migration) error {
+	return withTx(ctx, db, func(tx *stdsql.Tx) error {
+		// Execute schema statements
+		for _, stmt := range m.statements {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+
+		// Record the applied version
+		_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, description, applied_at) VALUES (?, ?, ?)`, m.version, m.description, timeFunc().Unix())
+		return err
+	})
+}
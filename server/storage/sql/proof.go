@@ -0,0 +1,82 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"zntr.io/solid/server/storage"
+)
+
+const dpopProofTTL = 1 * time.Minute
+
+type proofCache struct {
+	db *stdsql.DB
+}
+
+// DPoPProofs returns a dpop proof cache backed by the given database.
+func DPoPProofs(db *stdsql.DB) storage.DPoP {
+	return &proofCache{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *proofCache) Register(ctx context.Context, id string) error {
+	// Insert in database
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM dpop_proofs WHERE proof_id = ?`, id); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO dpop_proofs (proof_id, expires_at) VALUES (?, ?)`, id, expiresAt(dpopProofTTL))
+		return err
+	}); err != nil {
+		return fmt.Errorf("unable to insert dpop proof: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *proofCache) Delete(ctx context.Context, id string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM dpop_proofs WHERE proof_id = ?`, id); err != nil {
+		return fmt.Errorf("unable to delete dpop proof: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *proofCache) Exists(ctx context.Context, id string) (bool, error) {
+	// Retrieve from database
+	var expiration int64
+	if err := s.db.QueryRowContext(ctx, `SELECT expires_at FROM dpop_proofs WHERE proof_id = ? AND expires_at > ?`, id, timeFunc().Unix()).Scan(&expiration); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to retrieve dpop proof: %w", err)
+	}
+
+	// No error
+	return true, nil
+}
@@ -0,0 +1,63 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	resourcev1 "zntr.io/solid/api/oidc/resource/v1"
+	"zntr.io/solid/server/storage"
+)
+
+type resourceStorage struct {
+	db *stdsql.DB
+}
+
+// Resources returns a resource reader backed by the given database.
+func Resources(db *stdsql.DB) storage.Resource {
+	return &resourceStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *resourceStorage) GetByURI(ctx context.Context, urn string) (*resourcev1.Resource, error) {
+	// Retrieve from database
+	var payload []byte
+	if err := s.db.QueryRowContext(ctx, `SELECT payload FROM resources WHERE urn = ?`, urn).Scan(&payload); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve resource: %w", err)
+	}
+
+	// Decode resource
+	var r resourcev1.Resource
+	if err := proto.Unmarshal(payload, &r); err != nil {
+		return nil, fmt.Errorf("unable to decode resource: %w", err)
+	}
+
+	// No error
+	return &r, nil
+}
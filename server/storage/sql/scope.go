@@ -0,0 +1,63 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	scopev1 "zntr.io/solid/api/oidc/scope/v1"
+	"zntr.io/solid/server/storage"
+)
+
+type scopeStorage struct {
+	db *stdsql.DB
+}
+
+// Scopes returns a scope reader backed by the given database.
+func Scopes(db *stdsql.DB) storage.Scope {
+	return &scopeStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *scopeStorage) GetByName(ctx context.Context, name string) (*scopev1.Scope, error) {
+	// Retrieve from database
+	var payload []byte
+	if err := s.db.QueryRowContext(ctx, `SELECT payload FROM scopes WHERE name = ?`, name).Scan(&payload); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve scope: %w", err)
+	}
+
+	// Decode scope
+	var sc scopev1.Scope
+	if err := proto.Unmarshal(payload, &sc); err != nil {
+		return nil, fmt.Errorf("unable to decode scope: %w", err)
+	}
+
+	// No error
+	return &sc, nil
+}
@@ -0,0 +1,319 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/server/storage"
+)
+
+const (
+	tokenValueKey = `w#.C{#hE3cQ]u)VMA%Dq[,Q4TPEt/Hq"F0mj3z@o5M=YJ>+Q$e;x_$bU8w*9'N7`
+
+	// tokenRevokedRetention defines how long a revoked token is at least kept
+	// after its revocation, so that it can still be reported as revoked.
+	tokenRevokedRetention = 24 * time.Hour
+
+	// tokenAlive restricts queries to tokens which are not expired, tokens
+	// without expiration are never collected.
+	tokenAlive = `(expires_at = 0 OR expires_at > ?)`
+)
+
+// TokenStorage describes a database token storage.
+type TokenStorage interface {
+	storage.Token
+	storage.TokenStatusList
+}
+
+type tokenStorage struct {
+	db *stdsql.DB
+}
+
+// Tokens returns a token manager backed by the given database.
+func Tokens(db *stdsql.DB) TokenStorage {
+	return &tokenStorage{
+		db: db,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *tokenStorage) Create(ctx context.Context, issuer string, t *tokenv1.Token) error {
+	// Check parameters
+	if t == nil {
+		return errors.New("unable to store nil token")
+	}
+
+	// Clone the token object
+	tCopy := proto.Clone(t).(*tokenv1.Token)
+
+	// Compute token value hash
+	tCopy.Value = s.deriveValue(issuer, tCopy.Value)
+
+	// Serialize token
+	payload, err := proto.Marshal(tCopy)
+	if err != nil {
+		return fmt.Errorf("unable to encode token: %w", err)
+	}
+
+	// Status list index is only set for indexed tokens
+	var statusIndex stdsql.NullInt64
+	if ref := tCopy.StatusList; ref != nil {
+		statusIndex = stdsql.NullInt64{Int64: int64(ref.Idx), Valid: true}
+	}
+
+	// Insert in database
+	if _, err := s.db.ExecContext(ctx,
+		`INSERT INTO tokens (issuer, token_id, grant_id, subject, client_id, value_hash, status, status_index, expires_at, payload) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		issuer, tCopy.TokenId, tCopy.GrantId, tCopy.GetMetadata().GetSubject(), tCopy.GetMetadata().GetClientId(), tCopy.Value, int32(tCopy.Status), statusIndex, int64(tCopy.GetMetadata().GetExpiresAt()), payload,
+	); err != nil {
+		return fmt.Errorf("unable to insert token: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) Get(ctx context.Context, issuer, id string) (*tokenv1.Token, error) {
+	// Retrieve from database
+	row := s.db.QueryRowContext(ctx, `SELECT payload, status FROM tokens WHERE issuer = ? AND token_id = ? AND `+tokenAlive, issuer, id, timeFunc().Unix())
+
+	// No error
+	return s.scan(row)
+}
+
+func (s *tokenStorage) GetByValue(ctx context.Context, issuer, value string) (*tokenv1.Token, error) {
+	// Retrieve from database
+	row := s.db.QueryRowContext(ctx, `SELECT payload, status FROM tokens WHERE issuer = ? AND value_hash = ? AND `+tokenAlive, issuer, s.deriveValue(issuer, value), timeFunc().Unix())
+
+	// No error
+	return s.scan(row)
+}
+
+func (s *tokenStorage) Delete(ctx context.Context, issuer, id string) error {
+	// Delete from database
+	res, err := s.db.ExecContext(ctx, `DELETE FROM tokens WHERE issuer = ? AND token_id = ?`, issuer, id)
+	if err != nil {
+		return fmt.Errorf("unable to delete token: %w", err)
+	}
+
+	// No error
+	return expectAffected(res)
+}
+
+func (s *tokenStorage) Revoke(ctx context.Context, issuer, id string) error {
+	// Set as revoked
+	res, err := s.revoke(ctx, `issuer = ? AND token_id = ?`, issuer, id)
+	if err != nil {
+		return fmt.Errorf("unable to revoke token: %w", err)
+	}
+
+	// No error
+	return expectAffected(res)
+}
+
+func (s *tokenStorage) Consume(ctx context.Context, issuer, id string) error {
+	// Set as revoked, only the caller which flipped the status wins
+	res, err := s.revoke(ctx, `issuer = ? AND token_id = ? AND status = ?`, issuer, id, int32(tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE))
+	if err != nil {
+		return fmt.Errorf("unable to consume token: %w", err)
+	}
+	if err := expectAffected(res); !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+
+	// Distinguish unknown tokens from already consumed ones
+	var status int32
+	if err := s.db.QueryRowContext(ctx, `SELECT status FROM tokens WHERE issuer = ? AND token_id = ? AND `+tokenAlive, issuer, id, timeFunc().Unix()).Scan(&status); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return storage.ErrNotFound
+		}
+		return fmt.Errorf("unable to retrieve token status: %w", err)
+	}
+
+	// Token exists but is no longer active
+	return storage.ErrAlreadyUsed
+}
+
+func (s *tokenStorage) RevokeByGrant(ctx context.Context, issuer, grantID string) error {
+	// Check parameters
+	if grantID == "" {
+		return errors.New("unable to revoke tokens with a blank grant id")
+	}
+
+	// Set all family members as revoked
+	if _, err := s.revoke(ctx, `issuer = ? AND grant_id = ?`, issuer, grantID); err != nil {
+		return fmt.Errorf("unable to revoke token family: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) RevokeBySubject(ctx context.Context, issuer, subject string) error {
+	// Check parameters
+	if subject == "" {
+		return errors.New("unable to revoke tokens with a blank subject")
+	}
+
+	// Set all subject tokens as revoked
+	if _, err := s.revoke(ctx, `issuer = ? AND subject = ?`, issuer, subject); err != nil {
+		return fmt.Errorf("unable to revoke subject tokens: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) RevokeByClient(ctx context.Context, issuer, clientID string) error {
+	// Check parameters
+	if clientID == "" {
+		return errors.New("unable to revoke tokens with a blank client id")
+	}
+
+	// Set all client tokens as revoked
+	if _, err := s.revoke(ctx, `issuer = ? AND client_id = ?`, issuer, clientID); err != nil {
+		return fmt.Errorf("unable to revoke client tokens: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *tokenStorage) AllocateStatusIndex(ctx context.Context, issuer string) (uint64, error) {
+	var idx uint64
+	if err := withTx(ctx, s.db, func(tx *stdsql.Tx) error {
+		// Increment the list size, locking the issuer row
+		res, err := tx.ExecContext(ctx, `UPDATE token_status_lists SET size = size + 1 WHERE issuer = ?`, issuer)
+		if err != nil {
+			return err
+		}
+		if err := expectAffected(res); err != nil {
+			if !errors.Is(err, storage.ErrNotFound) {
+				return err
+			}
+
+			// First index of the issuer
+			_, err = tx.ExecContext(ctx, `INSERT INTO token_status_lists (issuer, size) VALUES (?, 1)`, issuer)
+			return err
+		}
+
+		// Retrieve the reserved index
+		var size int64
+		if err := tx.QueryRowContext(ctx, `SELECT size FROM token_status_lists WHERE issuer = ?`, issuer).Scan(&size); err != nil {
+			return err
+		}
+		idx = uint64(size - 1)
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("unable to allocate status list index: %w", err)
+	}
+
+	// No error
+	return idx, nil
+}
+
+func (s *tokenStorage) RevokedStatusIndexes(ctx context.Context, issuer string) (uint64, []uint64, error) {
+	// Retrieve list size
+	var size int64
+	if err := s.db.QueryRowContext(ctx, `SELECT size FROM token_status_lists WHERE issuer = ?`, issuer).Scan(&size); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return 0, nil, nil
+		}
+		return 0, nil, fmt.Errorf("unable to retrieve status list size: %w", err)
+	}
+
+	// Retrieve revoked indexes
+	rows, err := s.db.QueryContext(ctx, `SELECT status_index FROM tokens WHERE issuer = ? AND status = ? AND status_index IS NOT NULL`, issuer, int32(tokenv1.TokenStatus_TOKEN_STATUS_REVOKED))
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to retrieve revoked status indexes: %w", err)
+	}
+	defer rows.Close()
+
+	revoked := []uint64{}
+	for rows.Next() {
+		var idx int64
+		if err := rows.Scan(&idx); err != nil {
+			return 0, nil, fmt.Errorf("unable to decode revoked status index: %w", err)
+		}
+		revoked = append(revoked, uint64(idx))
+	}
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("unable to retrieve revoked status indexes: %w", err)
+	}
+
+	// No error
+	return uint64(size), revoked, nil
+}
+
+// -----------------------------------------------------------------------------
+
+// revoke sets the alive tokens matching the given condition as revoked. The
+// expiration column is extended to the revoked retention so that a revoked
+// token is kept until max(expiration, revocation + retention), revoked tokens
+// without expiration are collected after the retention.
+func (s *tokenStorage) revoke(ctx context.Context, cond string, args ...any) (stdsql.Result, error) {
+	now := timeFunc()
+	revoked := int32(tokenv1.TokenStatus_TOKEN_STATUS_REVOKED)
+	retainUntil := now.Add(tokenRevokedRetention).Unix()
+
+	// Expiration is assigned first as some engines evaluate assignments
+	// sequentially.
+	query := `UPDATE tokens SET expires_at = CASE WHEN status = ? OR expires_at >= ? THEN expires_at ELSE ? END, status = ? WHERE ` + cond + ` AND ` + tokenAlive
+	params := append([]any{revoked, retainUntil, retainUntil, revoked}, args...)
+
+	return s.db.ExecContext(ctx, query, append(params, now.Unix())...)
+}
+
+func (s *tokenStorage) scan(row *stdsql.Row) (*tokenv1.Token, error) {
+	var (
+		payload []byte
+		status  int32
+	)
+	if err := row.Scan(&payload, &status); err != nil {
+		if errors.Is(err, stdsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("unable to retrieve token: %w", err)
+	}
+
+	// Decode token
+	var t tokenv1.Token
+	if err := proto.Unmarshal(payload, &t); err != nil {
+		return nil, fmt.Errorf("unable to decode token: %w", err)
+	}
+
+	// Status column is the source of truth
+	t.Status = tokenv1.TokenStatus(status)
+
+	// No error
+	return &t, nil
+}
+
+func (s *tokenStorage) deriveValue(issuer, value string) string {
+	return deriveKey(tokenValueKey, "solid:token:v1", issuer, value)
+}
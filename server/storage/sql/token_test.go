@@ -0,0 +1,170 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/server/storage"
+)
+
+func TestTokens(t *testing.T) {
+	ctx := context.Background()
+	tokens := Tokens(newTestDB(t))
+	withFrozenTime(t, time.Unix(1700000000, 0))
+
+	in := &tokenv1.Token{
+		Issuer:    "http://127.0.0.1:8080",
+		TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+		TokenId:   "123456789",
+		Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		Value:     "at_cruQ7jbMrvQdpQdMBlvNzzbWPCYNlhrP",
+		Metadata: &tokenv1.TokenMeta{
+			Issuer:    "http://127.0.0.1:8080",
+			Subject:   "foo",
+			ClientId:  "s6BhdRkqt3",
+			ExpiresAt: 1700003600,
+		},
+	}
+
+	t.Run("nil token", func(t *testing.T) {
+		require.Error(t, tokens.Create(ctx, "http://127.0.0.1:8080", nil))
+	})
+
+	t.Run("create", func(t *testing.T) {
+		require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", in))
+		require.Equal(t, "at_cruQ7jbMrvQdpQdMBlvNzzbWPCYNlhrP", in.Value, "input token must not be mutated")
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		require.Error(t, tokens.Create(ctx, "http://127.0.0.1:8080", in))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		out, err := tokens.Get(ctx, "http://127.0.0.1:8080", "123456789")
+		require.NoError(t, err)
+		require.Equal(t, "foo", out.Metadata.Subject)
+		require.NotEqual(t, in.Value, out.Value)
+	})
+
+	t.Run("get from another issuer", func(t *testing.T) {
+		_, err := tokens.Get(ctx, "http://127.0.0.1:8081", "123456789")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get by value", func(t *testing.T) {
+		out, err := tokens.GetByValue(ctx, "http://127.0.0.1:8080", "at_cruQ7jbMrvQdpQdMBlvNzzbWPCYNlhrP")
+		require.NoError(t, err)
+		require.Equal(t, "123456789", out.TokenId)
+	})
+
+	t.Run("get by unknown value", func(t *testing.T) {
+		_, err := tokens.GetByValue(ctx, "http://127.0.0.1:8080", "at_unknown")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		require.NoError(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "123456789"))
+
+		out, err := tokens.GetByValue(ctx, "http://127.0.0.1:8080", "at_cruQ7jbMrvQdpQdMBlvNzzbWPCYNlhrP")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+	})
+
+	t.Run("revoke unknown", func(t *testing.T) {
+		require.ErrorIs(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "unknown"), storage.ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, tokens.Delete(ctx, "http://127.0.0.1:8080", "123456789"))
+
+		_, err := tokens.Get(ctx, "http://127.0.0.1:8080", "123456789")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		require.ErrorIs(t, tokens.Delete(ctx, "http://127.0.0.1:8080", "123456789"), storage.ErrNotFound)
+	})
+}
+
+func TestTokens_Expiration(t *testing.T) {
+	ctx := context.Background()
+	advance := withFrozenTime(t, time.Unix(1700000000, 0))
+
+	newToken := func(id string, expiresAt uint64) *tokenv1.Token {
+		return &tokenv1.Token{
+			Issuer:    "http://127.0.0.1:8080",
+			TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			TokenId:   id,
+			Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+			Value:     "at_" + id,
+			Metadata: &tokenv1.TokenMeta{
+				Issuer:    "http://127.0.0.1:8080",
+				Subject:   "foo",
+				ClientId:  "s6BhdRkqt3",
+				ExpiresAt: expiresAt,
+			},
+		}
+	}
+
+	t.Run("expired", func(t *testing.T) {
+		tokens := Tokens(newTestDB(t))
+		require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", newToken("123456789", uint64(timeFunc().Add(time.Hour).Unix()))))
+
+		advance(time.Hour)
+
+		_, err := tokens.Get(ctx, "http://127.0.0.1:8080", "123456789")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = tokens.GetByValue(ctx, "http://127.0.0.1:8080", "at_123456789")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		require.ErrorIs(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "123456789"), storage.ErrNotFound)
+		require.ErrorIs(t, tokens.Consume(ctx, "http://127.0.0.1:8080", "123456789"), storage.ErrNotFound)
+	})
+
+	t.Run("revoked is retained after expiration", func(t *testing.T) {
+		tokens := Tokens(newTestDB(t))
+		require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", newToken("123456789", uint64(timeFunc().Add(time.Hour).Unix()))))
+		require.NoError(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "123456789"))
+
+		advance(2 * time.Hour)
+		out, err := tokens.Get(ctx, "http://127.0.0.1:8080", "123456789")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+
+		advance(tokenRevokedRetention)
+		_, err = tokens.Get(ctx, "http://127.0.0.1:8080", "123456789")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("revoked is retained until expiration", func(t *testing.T) {
+		tokens := Tokens(newTestDB(t))
+		require.NoError(t, tokens.Create(ctx, "http://127.0.0.1:8080", newToken("123456789", uint64(timeFunc().Add(2*tokenRevokedRetention).Unix()))))
+		require.NoError(t, tokens.Revoke(ctx, "http://127.0.0.1:8080", "123456789"))
+
+		advance(tokenRevokedRetention + time.Hour)
+		out, err := tokens.Get(ctx, "http://127.0.0.1:8080", "123456789")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+
+		advance(tokenRevokedRetention)
+		_, err = tokens.Get(ctx, "http://127.0.0.1:8080", "123456789")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -0,0 +1,86 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"zntr.io/solid/server/storage"
+)
+
+// RunAssertionJTISuite checks the given assertion identifier storage
+// implementation against the replay protection expected by assertion grants.
+func RunAssertionJTISuite(t *testing.T, factory func(t *testing.T) storage.AssertionJTI, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	const jti = "id501"
+
+	t.Run("use", func(t *testing.T) {
+		jtis := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		require.NoError(t, jtis.Use(ctx, issuer, jti, expiresAt))
+		require.ErrorIs(t, jtis.Use(ctx, issuer, jti, expiresAt), storage.ErrAlreadyUsed)
+	})
+
+	t.Run("blank", func(t *testing.T) {
+		jtis := factory(t)
+
+		require.Error(t, jtis.Use(ctx, issuer, "", uint64(dopts.now().Add(time.Hour).Unix())))
+	})
+
+	t.Run("issuer scoped", func(t *testing.T) {
+		jtis := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		require.NoError(t, jtis.Use(ctx, issuer, jti, expiresAt))
+		require.NoError(t, jtis.Use(ctx, otherIssuer, jti, expiresAt))
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		jtis := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		winners := raceConsume(t, func() error {
+			err := jtis.Use(ctx, issuer, jti, expiresAt)
+			if errors.Is(err, storage.ErrAlreadyUsed) {
+				return storage.ErrNotFound
+			}
+			return err
+		})
+		require.Equal(t, 1, winners)
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		jtis := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+		require.NoError(t, jtis.Use(ctx, issuer, jti, expiresAt))
+
+		// Identifiers can be reused once the assertion is expired
+		dopts.travel(t, 2*time.Hour)
+		require.NoError(t, jtis.Use(ctx, issuer, jti, uint64(dopts.now().Add(3*time.Hour).Unix())))
+	})
+}
@@ -0,0 +1,149 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/server/storage"
+)
+
+// RunAuthorizationCodeSessionSuite checks the given authorization code session
+// storage implementation against the behaviour expected by the authorization
+// and token services.
+func RunAuthorizationCodeSessionSuite(t *testing.T, factory func(t *testing.T) storage.AuthorizationCodeSession, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	const code = "SplxlOBeZQQYbYS6WxSbIA"
+
+	newSession := func() *sessionv1.AuthorizationCodeSession {
+		return &sessionv1.AuthorizationCodeSession{
+			Issuer:  issuer,
+			Subject: "foo",
+		}
+	}
+
+	t.Run("register", func(t *testing.T) {
+		sessions := factory(t)
+
+		expiresIn, err := sessions.Register(ctx, issuer, code, newSession())
+		require.NoError(t, err)
+		require.Positive(t, expiresIn)
+
+		out, err := sessions.Get(ctx, issuer, code)
+		require.NoError(t, err)
+		require.Equal(t, "foo", out.Subject)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		_, err := sessions.Get(ctx, issuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get is issuer scoped", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, code, newSession())
+		require.NoError(t, err)
+
+		_, err = sessions.Get(ctx, otherIssuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("burn after read", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, code, newSession())
+		require.NoError(t, err)
+
+		_, err = sessions.Get(ctx, issuer, code)
+		require.NoError(t, err)
+		require.NoError(t, sessions.Delete(ctx, issuer, code))
+
+		_, err = sessions.Get(ctx, issuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, code, newSession())
+		require.NoError(t, err)
+
+		out, err := sessions.Consume(ctx, issuer, code)
+		require.NoError(t, err)
+		require.Equal(t, "foo", out.Subject)
+
+		// Consumed object must not be reachable anymore
+		_, err = sessions.Consume(ctx, issuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.Get(ctx, issuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		_, err := sessions.Consume(ctx, issuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume is issuer scoped", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, code, newSession())
+		require.NoError(t, err)
+
+		_, err = sessions.Consume(ctx, otherIssuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("concurrent consume has a single winner", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, code, newSession())
+		require.NoError(t, err)
+
+		winners := raceConsume(t, func() error {
+			_, err := sessions.Consume(ctx, issuer, code)
+			return err
+		})
+		require.Equal(t, 1, winners)
+	})
+
+	t.Run("delete unknown", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Delete(ctx, issuer, code))
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		sessions := factory(t)
+		expiresIn, err := sessions.Register(ctx, issuer, code, newSession())
+		require.NoError(t, err)
+
+		dopts.travel(t, time.Duration(expiresIn)*time.Second)
+
+		_, err = sessions.Get(ctx, issuer, code)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
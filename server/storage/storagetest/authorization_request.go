@@ -0,0 +1,149 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	flowv1 "zntr.io/solid/api/oidc/flow/v1"
+	"zntr.io/solid/server/storage"
+)
+
+// RunAuthorizationRequestSuite checks the given authorization request storage
+// implementation against the behaviour expected by the authorization service.
+func RunAuthorizationRequestSuite(t *testing.T, factory func(t *testing.T) storage.AuthorizationRequest, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	const requestURI = "urn:ietf:params:oauth:request_uri:bwc4JK-ESC0w8acc191e-Y1LTC2"
+
+	newRequest := func() *flowv1.AuthorizationRequest {
+		return &flowv1.AuthorizationRequest{
+			ClientId:     "s6BhdRkqt3",
+			ResponseType: "code",
+			State:        "af0ifjsldkj",
+		}
+	}
+
+	t.Run("register", func(t *testing.T) {
+		requests := factory(t)
+
+		expiresIn, err := requests.Register(ctx, issuer, requestURI, newRequest())
+		require.NoError(t, err)
+		require.Positive(t, expiresIn)
+
+		out, err := requests.Get(ctx, issuer, requestURI)
+		require.NoError(t, err)
+		require.Equal(t, "af0ifjsldkj", out.State)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		requests := factory(t)
+
+		_, err := requests.Get(ctx, issuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get is issuer scoped", func(t *testing.T) {
+		requests := factory(t)
+		_, err := requests.Register(ctx, issuer, requestURI, newRequest())
+		require.NoError(t, err)
+
+		_, err = requests.Get(ctx, otherIssuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("burn after read", func(t *testing.T) {
+		requests := factory(t)
+		_, err := requests.Register(ctx, issuer, requestURI, newRequest())
+		require.NoError(t, err)
+
+		_, err = requests.Get(ctx, issuer, requestURI)
+		require.NoError(t, err)
+		require.NoError(t, requests.Delete(ctx, issuer, requestURI))
+
+		_, err = requests.Get(ctx, issuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume", func(t *testing.T) {
+		requests := factory(t)
+		_, err := requests.Register(ctx, issuer, requestURI, newRequest())
+		require.NoError(t, err)
+
+		out, err := requests.Consume(ctx, issuer, requestURI)
+		require.NoError(t, err)
+		require.Equal(t, "af0ifjsldkj", out.State)
+
+		// Consumed object must not be reachable anymore
+		_, err = requests.Consume(ctx, issuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = requests.Get(ctx, issuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume unknown", func(t *testing.T) {
+		requests := factory(t)
+
+		_, err := requests.Consume(ctx, issuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume is issuer scoped", func(t *testing.T) {
+		requests := factory(t)
+		_, err := requests.Register(ctx, issuer, requestURI, newRequest())
+		require.NoError(t, err)
+
+		_, err = requests.Consume(ctx, otherIssuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("concurrent consume has a single winner", func(t *testing.T) {
+		requests := factory(t)
+		_, err := requests.Register(ctx, issuer, requestURI, newRequest())
+		require.NoError(t, err)
+
+		winners := raceConsume(t, func() error {
+			_, err := requests.Consume(ctx, issuer, requestURI)
+			return err
+		})
+		require.Equal(t, 1, winners)
+	})
+
+	t.Run("delete unknown", func(t *testing.T) {
+		requests := factory(t)
+		require.NoError(t, requests.Delete(ctx, issuer, requestURI))
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		requests := factory(t)
+		expiresIn, err := requests.Register(ctx, issuer, requestURI, newRequest())
+		require.NoError(t, err)
+
+		dopts.travel(t, time.Duration(expiresIn)*time.Second)
+
+		_, err = requests.Get(ctx, issuer, requestURI)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -0,0 +1,188 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+)
+
+// RunBackchannelAuthenticationSessionSuite checks the given backchannel
+// authentication session storage implementation against the behaviour expected
+// by the backchannel and token services.
+func RunBackchannelAuthenticationSessionSuite(t *testing.T, factory func(t *testing.T) storage.BackchannelAuthenticationSession, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	const (
+		authReqID = "1c266114-a1be-4252-8ad1-04986c5b9ac1"
+		lifetime  = 2 * time.Minute
+	)
+
+	newSession := func(status sessionv1.BackchannelAuthenticationStatus) *sessionv1.BackchannelAuthenticationSession {
+		return &sessionv1.BackchannelAuthenticationSession{
+			Issuer:    issuer,
+			ExpiresAt: uint64(dopts.now().Add(lifetime).Unix()),
+			Status:    status,
+			Interval:  5,
+		}
+	}
+
+	t.Run("register", func(t *testing.T) {
+		sessions := factory(t)
+
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING)))
+
+		out, err := sessions.Get(ctx, issuer, authReqID)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING, out.Status)
+		require.Equal(t, uint64(5), out.Interval)
+	})
+
+	t.Run("register nil", func(t *testing.T) {
+		sessions := factory(t)
+		require.Error(t, sessions.Register(ctx, issuer, authReqID, nil))
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		_, err := sessions.Get(ctx, issuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get is issuer scoped", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING)))
+
+		_, err := sessions.Get(ctx, otherIssuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING)))
+
+		updated := newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED)
+		updated.Subject = types.StringRef("foo")
+		require.NoError(t, sessions.Update(ctx, issuer, authReqID, updated))
+
+		out, err := sessions.Get(ctx, issuer, authReqID)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED, out.Status)
+		require.Equal(t, "foo", out.GetSubject())
+	})
+
+	t.Run("update unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		err := sessions.Update(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED))
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get returns a copy", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING)))
+
+		out, err := sessions.Get(ctx, issuer, authReqID)
+		require.NoError(t, err)
+		out.Interval = 10
+
+		// Only an update can change the stored session
+		out, err = sessions.Get(ctx, issuer, authReqID)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), out.Interval)
+	})
+
+	t.Run("consume", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED)))
+
+		out, err := sessions.Consume(ctx, issuer, authReqID)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED, out.Status)
+
+		// Consumed object must not be reachable anymore
+		_, err = sessions.Consume(ctx, issuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.Get(ctx, issuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		require.ErrorIs(t, sessions.Update(ctx, issuer, authReqID, out), storage.ErrNotFound)
+	})
+
+	t.Run("consume unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		_, err := sessions.Consume(ctx, issuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume is issuer scoped", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED)))
+
+		_, err := sessions.Consume(ctx, otherIssuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("concurrent consume has a single winner", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZED)))
+
+		winners := raceConsume(t, func() error {
+			_, err := sessions.Consume(ctx, issuer, authReqID)
+			return err
+		})
+		require.Equal(t, 1, winners)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING)))
+		require.NoError(t, sessions.Delete(ctx, issuer, authReqID))
+
+		_, err := sessions.Get(ctx, issuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("delete unknown", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Delete(ctx, issuer, authReqID))
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Register(ctx, issuer, authReqID, newSession(sessionv1.BackchannelAuthenticationStatus_BACKCHANNEL_AUTHENTICATION_STATUS_AUTHORIZATION_PENDING)))
+
+		dopts.travel(t, lifetime)
+
+		_, err := sessions.Get(ctx, issuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.Consume(ctx, issuer, authReqID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
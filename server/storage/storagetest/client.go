@@ -0,0 +1,68 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clientv1 "zntr.io/solid/api/oidc/client/v1"
+	"zntr.io/solid/oidc"
+	"zntr.io/solid/server/storage"
+)
+
+// RunClientSuite checks the given client storage implementation against the
+// behaviour expected by the client authentication and services.
+func RunClientSuite(t *testing.T, factory func(t *testing.T) storage.Client, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	const clientName = "storagetest-client"
+
+	t.Run("register", func(t *testing.T) {
+		clients := factory(t)
+
+		clientID, err := clients.Register(ctx, &clientv1.Client{
+			ClientName: clientName,
+			GrantTypes: []string{oidc.GrantTypeClientCredentials},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, clientID)
+
+		out, err := clients.Get(ctx, clientID)
+		require.NoError(t, err)
+		require.Equal(t, clientID, out.ClientId)
+		require.Equal(t, []string{oidc.GrantTypeClientCredentials}, out.GrantTypes)
+
+		out, err = clients.GetByName(ctx, clientName)
+		require.NoError(t, err)
+		require.Equal(t, clientID, out.ClientId)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		clients := factory(t)
+
+		_, err := clients.Get(ctx, "unknown-client-id")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = clients.GetByName(ctx, "unknown-client-name")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -0,0 +1,306 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sessionv1 "zntr.io/solid/api/oidc/session/v1"
+	"zntr.io/solid/sdk/types"
+	"zntr.io/solid/server/storage"
+)
+
+// RunDeviceCodeSessionSuite checks the given device code session storage
+// implementation against the behaviour expected by the device and token
+// services.
+func RunDeviceCodeSessionSuite(t *testing.T, factory func(t *testing.T) storage.DeviceCodeSession, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	const (
+		userCode   = "WDJB-MJHT"
+		deviceCode = "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS"
+	)
+
+	newSession := func(status sessionv1.DeviceCodeStatus) *sessionv1.DeviceCodeSession {
+		return &sessionv1.DeviceCodeSession{
+			Issuer:     issuer,
+			DeviceCode: deviceCode,
+			Status:     status,
+		}
+	}
+
+	t.Run("register", func(t *testing.T) {
+		sessions := factory(t)
+
+		expiresIn, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+		require.Positive(t, expiresIn)
+
+		out, err := sessions.GetByUserCode(ctx, issuer, userCode)
+		require.NoError(t, err)
+		require.Equal(t, deviceCode, out.DeviceCode)
+
+		out, err = sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING, out.Status)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		_, err := sessions.GetByUserCode(ctx, issuer, userCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get is issuer scoped", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+
+		_, err = sessions.GetByUserCode(ctx, otherIssuer, userCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.GetByDeviceCode(ctx, otherIssuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+
+		// Same sequence as the device validation service
+		require.NoError(t, sessions.Validate(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED)))
+		require.NoError(t, sessions.Delete(ctx, issuer, userCode))
+
+		// User code must be burnt
+		_, err = sessions.GetByUserCode(ctx, issuer, userCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		// Device code must remain reachable for polling clients
+		out, err := sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED, out.Status)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+
+		// Same sequence as the device validation service confirmation
+		confirmed := newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING)
+		confirmed.Subject = types.StringRef("user1")
+		require.NoError(t, sessions.Update(ctx, issuer, deviceCode, confirmed))
+
+		out, err := sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING, out.Status)
+		require.Equal(t, "user1", out.GetSubject())
+
+		// Confirmation must be visible to the validation service
+		out, err = sessions.GetByUserCode(ctx, issuer, userCode)
+		require.NoError(t, err)
+		require.Equal(t, "user1", out.GetSubject())
+	})
+
+	t.Run("update unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		err := sessions.Update(ctx, issuer, deviceCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("retrieved sessions are not shared", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+
+		out, err := sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		out.Status = sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED
+
+		out, err = sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING, out.Status)
+	})
+
+	t.Run("record poll", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+
+		// Same sequence as the token service polling check
+		require.NoError(t, sessions.RecordPoll(ctx, issuer, deviceCode, 1700000000, 10))
+
+		out, err := sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), out.Interval)
+		require.Equal(t, uint64(1700000000), out.LastPolledAt)
+
+		// Polling state must be visible to the validation service
+		out, err = sessions.GetByUserCode(ctx, issuer, userCode)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), out.Interval)
+
+		// Polling state must survive the confirmation
+		confirmed := newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING)
+		require.NoError(t, sessions.Update(ctx, issuer, deviceCode, confirmed))
+		require.NoError(t, sessions.RecordPoll(ctx, issuer, deviceCode, 1700000010, 15))
+
+		out, err = sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_CONFIRMATION_PENDING, out.Status)
+		require.Equal(t, uint64(15), out.Interval)
+		require.Equal(t, uint64(1700000010), out.LastPolledAt)
+	})
+
+	t.Run("record poll not pending", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+		require.NoError(t, sessions.Validate(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED)))
+
+		err = sessions.RecordPoll(ctx, issuer, deviceCode, 1700000000, 10)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("record poll unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		err := sessions.RecordPoll(ctx, issuer, deviceCode, 1700000000, 10)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("record poll does not lose concurrent decisions", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+
+		// Polling clients race with the end-user decision
+		var (
+			wg    sync.WaitGroup
+			start = make(chan struct{})
+		)
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				<-start
+
+				err := sessions.RecordPoll(ctx, issuer, deviceCode, 1700000000+uint64(i), 5)
+				if err != nil && !errors.Is(err, storage.ErrNotFound) {
+					t.Errorf("unexpected record poll error: %v", err)
+				}
+			}(i)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			if err := sessions.Validate(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED)); err != nil {
+				t.Errorf("unexpected validate error: %v", err)
+			}
+		}()
+		close(start)
+		wg.Wait()
+
+		out, err := sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED, out.Status)
+	})
+
+	t.Run("consume", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+		require.NoError(t, sessions.Validate(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED)))
+		require.NoError(t, sessions.Delete(ctx, issuer, userCode))
+
+		out, err := sessions.Consume(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+		require.Equal(t, sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED, out.Status)
+
+		// Device code must be burnt
+		_, err = sessions.Consume(ctx, issuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume unknown", func(t *testing.T) {
+		sessions := factory(t)
+
+		_, err := sessions.Consume(ctx, issuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("consume is issuer scoped", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED))
+		require.NoError(t, err)
+
+		_, err = sessions.Consume(ctx, otherIssuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("consume once", func(t *testing.T) {
+		sessions := factory(t)
+		_, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED))
+		require.NoError(t, err)
+
+		winners := raceConsume(t, func() error {
+			_, err := sessions.Consume(ctx, issuer, deviceCode)
+			return err
+		})
+		require.Equal(t, 1, winners)
+	})
+
+	t.Run("delete unknown", func(t *testing.T) {
+		sessions := factory(t)
+		require.NoError(t, sessions.Delete(ctx, issuer, userCode))
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		sessions := factory(t)
+		expiresIn, err := sessions.Register(ctx, issuer, userCode, newSession(sessionv1.DeviceCodeStatus_DEVICE_CODE_STATUS_AUTHORIZATION_PENDING))
+		require.NoError(t, err)
+
+		dopts.travel(t, time.Duration(expiresIn)*time.Second)
+
+		_, err = sessions.GetByUserCode(ctx, issuer, userCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.GetByDeviceCode(ctx, issuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = sessions.Consume(ctx, issuer, deviceCode)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -0,0 +1,153 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"zntr.io/solid/server/storage"
+)
+
+// RunDeviceUserCodeAttemptSuite checks the given user code attempt counter
+// storage implementation against the throttling expected by the device flow.
+func RunDeviceUserCodeAttemptSuite(t *testing.T, factory func(t *testing.T) storage.DeviceUserCodeAttempt, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	const key = "subject:user1"
+
+	t.Run("unknown", func(t *testing.T) {
+		attempts := factory(t)
+
+		failures, lastFailedAt, err := attempts.Get(ctx, issuer, key)
+		require.NoError(t, err)
+		require.Zero(t, failures)
+		require.Zero(t, lastFailedAt)
+	})
+
+	t.Run("blank", func(t *testing.T) {
+		attempts := factory(t)
+
+		_, err := attempts.Fail(ctx, issuer, "", uint64(dopts.now().Add(time.Hour).Unix()))
+		require.Error(t, err)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		attempts := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		for i := uint64(1); i <= 3; i++ {
+			failures, err := attempts.Fail(ctx, issuer, key, expiresAt)
+			require.NoError(t, err)
+			require.Equal(t, i, failures)
+		}
+
+		failures, lastFailedAt, err := attempts.Get(ctx, issuer, key)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), failures)
+		require.NotZero(t, lastFailedAt)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		attempts := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		_, err := attempts.Fail(ctx, issuer, key, expiresAt)
+		require.NoError(t, err)
+		require.NoError(t, attempts.Reset(ctx, issuer, key))
+
+		failures, _, err := attempts.Get(ctx, issuer, key)
+		require.NoError(t, err)
+		require.Zero(t, failures)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		attempts := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		_, err := attempts.Fail(ctx, issuer, key, expiresAt)
+		require.NoError(t, err)
+		_, err = attempts.Fail(ctx, issuer, key, expiresAt)
+		require.NoError(t, err)
+		_, lastFailedAt, err := attempts.Get(ctx, issuer, key)
+		require.NoError(t, err)
+
+		require.NoError(t, attempts.Release(ctx, issuer, key))
+
+		failures, releasedLastFailedAt, err := attempts.Get(ctx, issuer, key)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), failures)
+		require.Equal(t, lastFailedAt, releasedLastFailedAt)
+
+		// Counters never go below zero
+		require.NoError(t, attempts.Release(ctx, issuer, key))
+		require.NoError(t, attempts.Release(ctx, issuer, key))
+
+		failures, err = attempts.Fail(ctx, issuer, key, expiresAt)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), failures)
+	})
+
+	t.Run("release unknown", func(t *testing.T) {
+		attempts := factory(t)
+		require.NoError(t, attempts.Release(ctx, issuer, key))
+
+		failures, _, err := attempts.Get(ctx, issuer, key)
+		require.NoError(t, err)
+		require.Zero(t, failures)
+	})
+
+	t.Run("issuer scoped", func(t *testing.T) {
+		attempts := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		_, err := attempts.Fail(ctx, issuer, key, expiresAt)
+		require.NoError(t, err)
+
+		failures, _, err := attempts.Get(ctx, otherIssuer, key)
+		require.NoError(t, err)
+		require.Zero(t, failures)
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		attempts := factory(t)
+		expiresAt := uint64(dopts.now().Add(time.Hour).Unix())
+
+		_, err := attempts.Fail(ctx, issuer, key, expiresAt)
+		require.NoError(t, err)
+		_, err = attempts.Fail(ctx, issuer, key, expiresAt)
+		require.NoError(t, err)
+
+		// Counters restart once the window is expired
+		dopts.travel(t, 2*time.Hour)
+
+		failures, _, err := attempts.Get(ctx, issuer, key)
+		require.NoError(t, err)
+		require.Zero(t, failures)
+
+		failures, err = attempts.Fail(ctx, issuer, key, uint64(dopts.now().Add(time.Hour).Unix()))
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), failures)
+	})
+}
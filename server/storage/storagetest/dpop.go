@@ -0,0 +1,82 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"zntr.io/solid/sdk/dpop"
+	"zntr.io/solid/server/storage"
+)
+
+// RunDPoPSuite checks the given DPoP proof storage implementation against the
+// behaviour expected by the DPoP verifier.
+func RunDPoPSuite(t *testing.T, factory func(t *testing.T) storage.DPoP, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	const proofID = "e1j3V_bKic8-LAEB"
+
+	t.Run("register", func(t *testing.T) {
+		proofs := factory(t)
+		require.NoError(t, proofs.Register(ctx, proofID))
+
+		found, err := proofs.Exists(ctx, proofID)
+		require.NoError(t, err)
+		require.True(t, found)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		proofs := factory(t)
+
+		found, err := proofs.Exists(ctx, proofID)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		proofs := factory(t)
+		require.NoError(t, proofs.Register(ctx, proofID))
+		require.NoError(t, proofs.Delete(ctx, proofID))
+
+		found, err := proofs.Exists(ctx, proofID)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		proofs := factory(t)
+		require.NoError(t, proofs.Register(ctx, proofID))
+
+		// Proofs must outlive the verifier acceptance window
+		dopts.travel(t, 2*dpop.ExpirationTreshold)
+		found, err := proofs.Exists(ctx, proofID)
+		require.NoError(t, err)
+		require.True(t, found)
+
+		dopts.travel(t, dpopProofLifetime-2*dpop.ExpirationTreshold)
+		found, err = proofs.Exists(ctx, proofID)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+}
@@ -0,0 +1,138 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"zntr.io/solid/server/storage"
+)
+
+// Option is used to tune the conformance suites.
+type Option func(*options)
+
+type options struct {
+	advance               func(time.Duration)
+	now                   func() time.Time
+	tokenRevokedRetention time.Duration
+}
+
+// WithClock registers a function used to move the storage clock forward. When
+// provided, the suites also assert expiry semantics; otherwise expiry checks
+// are skipped as they would require waiting for real time to elapse.
+func WithClock(advance func(time.Duration)) Option {
+	return func(o *options) {
+		o.advance = advance
+	}
+}
+
+// WithNow registers the storage clock, used to compute absolute expirations.
+// Defaults to time.Now.
+func WithNow(now func() time.Time) Option {
+	return func(o *options) {
+		o.now = now
+	}
+}
+
+// WithTokenRevokedRetention registers the revoked token retention configured
+// on the token storage. Defaults to 24 hours.
+func WithTokenRevokedRetention(d time.Duration) Option {
+	return func(o *options) {
+		o.tokenRevokedRetention = d
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+const (
+	issuer      = "http://127.0.0.1:8080"
+	otherIssuer = "http://127.0.0.1:8081"
+
+	// dpopProofLifetime is the proof retention expected from DPoP storages.
+	dpopProofLifetime = time.Minute
+	// defaultTokenRevokedRetention is the revoked token retention expected
+	// from token storages when not specified.
+	defaultTokenRevokedRetention = 24 * time.Hour
+)
+
+func buildOptions(opts []Option) *options {
+	dopts := &options{
+		now:                   time.Now,
+		tokenRevokedRetention: defaultTokenRevokedRetention,
+	}
+	for _, o := range opts {
+		o(dopts)
+	}
+	return dopts
+}
+
+func (o *options) travel(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	if o.advance == nil {
+		t.Skip("no clock registered, expiry can't be checked")
+	}
+
+	o.advance(d)
+}
+
+// raceConsume runs the given consume function concurrently and returns the
+// number of successful calls.
+func raceConsume(t *testing.T, consume func() error) int {
+	t.Helper()
+
+	const contenders = 32
+
+	var (
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		winners atomic.Int32
+		errs    = make(chan error, contenders)
+	)
+
+	for i := 0; i < contenders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			err := consume()
+			switch {
+			case err == nil:
+				winners.Add(1)
+			case !errors.Is(err, storage.ErrNotFound):
+				errs <- err
+			}
+		}()
+	}
+
+	// Release all contenders at once
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected consume error: %v", err)
+	}
+
+	return int(winners.Load())
+}
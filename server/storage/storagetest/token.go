@@ -0,0 +1,526 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tokenv1 "zntr.io/solid/api/oidc/token/v1"
+	"zntr.io/solid/server/storage"
+)
+
+// RunTokenSuite checks the given token storage implementation against the
+// behaviour expected by the token services.
+func RunTokenSuite(t *testing.T, factory func(t *testing.T) storage.Token, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+	dopts := buildOptions(opts)
+
+	newToken := func(id, value string) *tokenv1.Token {
+		return &tokenv1.Token{
+			Issuer:    issuer,
+			TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			TokenId:   id,
+			Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+			Value:     value,
+			Metadata: &tokenv1.TokenMeta{
+				Issuer:   issuer,
+				Subject:  "foo",
+				ClientId: "s6BhdRkqt3",
+				Scope:    "openid",
+			},
+		}
+	}
+
+	t.Run("create nil", func(t *testing.T) {
+		tokens := factory(t)
+		require.Error(t, tokens.Create(ctx, issuer, nil))
+	})
+
+	t.Run("create does not mutate input", func(t *testing.T) {
+		tokens := factory(t)
+
+		in := newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")
+		require.NoError(t, tokens.Create(ctx, issuer, in))
+		require.Equal(t, "at_uzwwjCKQ8yPfqgDN0Uxr", in.Value)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+
+		out, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.NoError(t, err)
+		require.Equal(t, "Q5IzcLSB", out.TokenId)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, out.Status)
+		require.Equal(t, "foo", out.GetMetadata().GetSubject())
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		tokens := factory(t)
+
+		_, err := tokens.Get(ctx, issuer, "unknown")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get is issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+
+		_, err := tokens.Get(ctx, otherIssuer, "Q5IzcLSB")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get by value", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+
+		out, err := tokens.GetByValue(ctx, issuer, "at_uzwwjCKQ8yPfqgDN0Uxr")
+		require.NoError(t, err)
+		require.Equal(t, "Q5IzcLSB", out.TokenId)
+	})
+
+	t.Run("get by unknown value", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+
+		_, err := tokens.GetByValue(ctx, issuer, "at_unknown")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("get by value is issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+
+		_, err := tokens.GetByValue(ctx, otherIssuer, "at_uzwwjCKQ8yPfqgDN0Uxr")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+		require.NoError(t, tokens.Revoke(ctx, issuer, "Q5IzcLSB"))
+
+		out, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+
+		out, err = tokens.GetByValue(ctx, issuer, "at_uzwwjCKQ8yPfqgDN0Uxr")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+	})
+
+	t.Run("revoke unknown", func(t *testing.T) {
+		tokens := factory(t)
+		require.ErrorIs(t, tokens.Revoke(ctx, issuer, "unknown"), storage.ErrNotFound)
+	})
+
+	t.Run("revoke is issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+		require.ErrorIs(t, tokens.Revoke(ctx, otherIssuer, "Q5IzcLSB"), storage.ErrNotFound)
+
+		out, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, out.Status)
+	})
+
+	t.Run("consume", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "rt_uzwwjCKQ8yPfqgDN0Uxr")))
+		require.NoError(t, tokens.Consume(ctx, issuer, "Q5IzcLSB"))
+
+		out, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+
+		require.ErrorIs(t, tokens.Consume(ctx, issuer, "Q5IzcLSB"), storage.ErrAlreadyUsed)
+	})
+
+	t.Run("consume unknown", func(t *testing.T) {
+		tokens := factory(t)
+		require.ErrorIs(t, tokens.Consume(ctx, issuer, "unknown"), storage.ErrNotFound)
+	})
+
+	t.Run("consume is issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "rt_uzwwjCKQ8yPfqgDN0Uxr")))
+		require.ErrorIs(t, tokens.Consume(ctx, otherIssuer, "Q5IzcLSB"), storage.ErrNotFound)
+		require.NoError(t, tokens.Consume(ctx, issuer, "Q5IzcLSB"))
+	})
+
+	t.Run("concurrent consume has a single winner", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "rt_uzwwjCKQ8yPfqgDN0Uxr")))
+
+		winners := raceConsume(t, func() error {
+			err := tokens.Consume(ctx, issuer, "Q5IzcLSB")
+			if errors.Is(err, storage.ErrAlreadyUsed) {
+				return storage.ErrNotFound
+			}
+			return err
+		})
+		require.Equal(t, 1, winners)
+	})
+
+	t.Run("revoke by grant", func(t *testing.T) {
+		tokens := factory(t)
+
+		family := newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")
+		family.GrantId = "vRt8cGm5EJpbGXbw"
+		require.NoError(t, tokens.Create(ctx, issuer, family))
+		sibling := newToken("b2ThR5Xu", "rt_WgUcIvk0oc4DRAfyJBXz")
+		sibling.TokenType = tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN
+		sibling.GrantId = "vRt8cGm5EJpbGXbw"
+		require.NoError(t, tokens.Create(ctx, issuer, sibling))
+		stranger := newToken("JKv1iAQ5", "at_2FhJrKRbwGH9oCQTnmk6")
+		stranger.GrantId = "D6nhZuGgSekDpGNa"
+		require.NoError(t, tokens.Create(ctx, issuer, stranger))
+
+		require.NoError(t, tokens.RevokeByGrant(ctx, issuer, "vRt8cGm5EJpbGXbw"))
+
+		for id, status := range map[string]tokenv1.TokenStatus{
+			"Q5IzcLSB": tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+			"b2ThR5Xu": tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+			"JKv1iAQ5": tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		} {
+			out, err := tokens.Get(ctx, issuer, id)
+			require.NoError(t, err)
+			require.Equal(t, status, out.Status, id)
+		}
+	})
+
+	t.Run("revoke by grant is issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+
+		in := newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")
+		in.GrantId = "vRt8cGm5EJpbGXbw"
+		require.NoError(t, tokens.Create(ctx, issuer, in))
+		require.NoError(t, tokens.RevokeByGrant(ctx, otherIssuer, "vRt8cGm5EJpbGXbw"))
+
+		out, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, out.Status)
+	})
+
+	t.Run("revoke by blank grant", func(t *testing.T) {
+		tokens := factory(t)
+		require.Error(t, tokens.RevokeByGrant(ctx, issuer, ""))
+	})
+
+	t.Run("revoke by subject", func(t *testing.T) {
+		tokens := factory(t)
+
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+		sibling := newToken("b2ThR5Xu", "rt_WgUcIvk0oc4DRAfyJBXz")
+		sibling.TokenType = tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN
+		sibling.Metadata.ClientId = "0oa2hl2inow5Uqc6c357"
+		require.NoError(t, tokens.Create(ctx, issuer, sibling))
+		stranger := newToken("JKv1iAQ5", "at_2FhJrKRbwGH9oCQTnmk6")
+		stranger.Metadata.Subject = "bar"
+		require.NoError(t, tokens.Create(ctx, issuer, stranger))
+		foreign := newToken("Xq3n9TzA", "at_Lk2mB7wQpR4sV9yZ1cHd")
+		require.NoError(t, tokens.Create(ctx, otherIssuer, foreign))
+
+		require.NoError(t, tokens.RevokeBySubject(ctx, issuer, "foo"))
+
+		for id, status := range map[string]tokenv1.TokenStatus{
+			"Q5IzcLSB": tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+			"b2ThR5Xu": tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+			"JKv1iAQ5": tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		} {
+			out, err := tokens.Get(ctx, issuer, id)
+			require.NoError(t, err)
+			require.Equal(t, status, out.Status, id)
+		}
+
+		out, err := tokens.Get(ctx, otherIssuer, "Xq3n9TzA")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, out.Status)
+	})
+
+	t.Run("revoke by blank subject", func(t *testing.T) {
+		tokens := factory(t)
+		require.Error(t, tokens.RevokeBySubject(ctx, issuer, ""))
+	})
+
+	t.Run("revoke by client", func(t *testing.T) {
+		tokens := factory(t)
+
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+		sibling := newToken("b2ThR5Xu", "rt_WgUcIvk0oc4DRAfyJBXz")
+		sibling.TokenType = tokenv1.TokenType_TOKEN_TYPE_REFRESH_TOKEN
+		sibling.Metadata.Subject = "bar"
+		require.NoError(t, tokens.Create(ctx, issuer, sibling))
+		stranger := newToken("JKv1iAQ5", "at_2FhJrKRbwGH9oCQTnmk6")
+		stranger.Metadata.ClientId = "0oa2hl2inow5Uqc6c357"
+		require.NoError(t, tokens.Create(ctx, issuer, stranger))
+		foreign := newToken("Xq3n9TzA", "at_Lk2mB7wQpR4sV9yZ1cHd")
+		require.NoError(t, tokens.Create(ctx, otherIssuer, foreign))
+
+		require.NoError(t, tokens.RevokeByClient(ctx, issuer, "s6BhdRkqt3"))
+
+		for id, status := range map[string]tokenv1.TokenStatus{
+			"Q5IzcLSB": tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+			"b2ThR5Xu": tokenv1.TokenStatus_TOKEN_STATUS_REVOKED,
+			"JKv1iAQ5": tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		} {
+			out, err := tokens.Get(ctx, issuer, id)
+			require.NoError(t, err)
+			require.Equal(t, status, out.Status, id)
+		}
+
+		out, err := tokens.Get(ctx, otherIssuer, "Xq3n9TzA")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, out.Status)
+	})
+
+	t.Run("revoke by blank client", func(t *testing.T) {
+		tokens := factory(t)
+		require.Error(t, tokens.RevokeByClient(ctx, issuer, ""))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+		require.NoError(t, tokens.Delete(ctx, issuer, "Q5IzcLSB"))
+
+		_, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = tokens.GetByValue(ctx, issuer, "at_uzwwjCKQ8yPfqgDN0Uxr")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("delete unknown", func(t *testing.T) {
+		tokens := factory(t)
+		require.ErrorIs(t, tokens.Delete(ctx, issuer, "unknown"), storage.ErrNotFound)
+	})
+
+	t.Run("delete is issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+		require.ErrorIs(t, tokens.Delete(ctx, otherIssuer, "Q5IzcLSB"), storage.ErrNotFound)
+
+		_, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.NoError(t, err)
+	})
+
+	t.Run("identifiers are issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")))
+		foreign := newToken("Q5IzcLSB", "at_Lk2mB7wQpR4sV9yZ1cHd")
+		foreign.Issuer = otherIssuer
+		require.NoError(t, tokens.Create(ctx, otherIssuer, foreign))
+
+		require.NoError(t, tokens.Revoke(ctx, issuer, "Q5IzcLSB"))
+		out, err := tokens.Get(ctx, otherIssuer, "Q5IzcLSB")
+		require.NoError(t, err)
+		require.Equal(t, otherIssuer, out.Issuer)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE, out.Status)
+
+		require.NoError(t, tokens.Delete(ctx, issuer, "Q5IzcLSB"))
+		out, err = tokens.GetByValue(ctx, otherIssuer, "at_Lk2mB7wQpR4sV9yZ1cHd")
+		require.NoError(t, err)
+		require.Equal(t, "Q5IzcLSB", out.TokenId)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		tokens := factory(t)
+		in := newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")
+		in.Metadata.ExpiresAt = uint64(dopts.now().Add(time.Hour).Unix())
+		require.NoError(t, tokens.Create(ctx, issuer, in))
+		require.NoError(t, tokens.Create(ctx, issuer, newToken("b2ThR5Xu", "at_WgUcIvk0oc4DRAfyJBXz")))
+
+		dopts.travel(t, time.Hour)
+
+		_, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = tokens.GetByValue(ctx, issuer, "at_uzwwjCKQ8yPfqgDN0Uxr")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		require.ErrorIs(t, tokens.Revoke(ctx, issuer, "Q5IzcLSB"), storage.ErrNotFound)
+		require.ErrorIs(t, tokens.Consume(ctx, issuer, "Q5IzcLSB"), storage.ErrNotFound)
+
+		// Tokens without expiration are kept
+		_, err = tokens.Get(ctx, issuer, "b2ThR5Xu")
+		require.NoError(t, err)
+	})
+
+	t.Run("revoked is reported after expiration", func(t *testing.T) {
+		tokens := factory(t)
+		in := newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")
+		in.Metadata.ExpiresAt = uint64(dopts.now().Add(dopts.tokenRevokedRetention / 4).Unix())
+		require.NoError(t, tokens.Create(ctx, issuer, in))
+		require.NoError(t, tokens.Revoke(ctx, issuer, "Q5IzcLSB"))
+
+		dopts.travel(t, dopts.tokenRevokedRetention/2)
+
+		out, err := tokens.GetByValue(ctx, issuer, "at_uzwwjCKQ8yPfqgDN0Uxr")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+
+		dopts.travel(t, dopts.tokenRevokedRetention)
+
+		_, err = tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("revoked is retained until expiration", func(t *testing.T) {
+		tokens := factory(t)
+		in := newToken("Q5IzcLSB", "at_uzwwjCKQ8yPfqgDN0Uxr")
+		in.Metadata.ExpiresAt = uint64(dopts.now().Add(2 * dopts.tokenRevokedRetention).Unix())
+		require.NoError(t, tokens.Create(ctx, issuer, in))
+		require.NoError(t, tokens.Revoke(ctx, issuer, "Q5IzcLSB"))
+
+		dopts.travel(t, dopts.tokenRevokedRetention+dopts.tokenRevokedRetention/2)
+
+		out, err := tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.NoError(t, err)
+		require.Equal(t, tokenv1.TokenStatus_TOKEN_STATUS_REVOKED, out.Status)
+
+		dopts.travel(t, dopts.tokenRevokedRetention)
+
+		_, err = tokens.Get(ctx, issuer, "Q5IzcLSB")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+// TokenStatusListStorage describes a token storage maintaining token status
+// lists.
+type TokenStatusListStorage interface {
+	storage.Token
+	storage.TokenStatusList
+}
+
+// RunTokenStatusListSuite checks the given token status list implementation
+// against the behaviour expected by the token services.
+func RunTokenStatusListSuite(t *testing.T, factory func(t *testing.T) TokenStatusListStorage, opts ...Option) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	newToken := func(id, value, grantID string, idx uint64) *tokenv1.Token {
+		return &tokenv1.Token{
+			Issuer:    issuer,
+			TokenType: tokenv1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+			TokenId:   id,
+			Status:    tokenv1.TokenStatus_TOKEN_STATUS_ACTIVE,
+			Value:     value,
+			GrantId:   grantID,
+			Metadata: &tokenv1.TokenMeta{
+				Issuer:   issuer,
+				Subject:  "foo",
+				ClientId: "s6BhdRkqt3",
+				Scope:    "openid",
+			},
+			StatusList: &tokenv1.TokenStatusReference{
+				Idx: idx,
+				Uri: issuer + "/token/status",
+			},
+		}
+	}
+
+	t.Run("empty list", func(t *testing.T) {
+		tokens := factory(t)
+
+		size, revoked, err := tokens.RevokedStatusIndexes(ctx, issuer)
+		require.NoError(t, err)
+		require.Zero(t, size)
+		require.Empty(t, revoked)
+	})
+
+	t.Run("allocate", func(t *testing.T) {
+		tokens := factory(t)
+
+		for want := uint64(0); want < 3; want++ {
+			idx, err := tokens.AllocateStatusIndex(ctx, issuer)
+			require.NoError(t, err)
+			require.Equal(t, want, idx)
+		}
+
+		size, revoked, err := tokens.RevokedStatusIndexes(ctx, issuer)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), size)
+		require.Empty(t, revoked)
+	})
+
+	t.Run("allocate is issuer scoped", func(t *testing.T) {
+		tokens := factory(t)
+
+		_, err := tokens.AllocateStatusIndex(ctx, issuer)
+		require.NoError(t, err)
+
+		idx, err := tokens.AllocateStatusIndex(ctx, otherIssuer)
+		require.NoError(t, err)
+		require.Zero(t, idx)
+	})
+
+	t.Run("revoke flips the status", func(t *testing.T) {
+		tokens := factory(t)
+
+		for i, id := range []string{"LhNeXNwE", "kDuZ3rZ8", "Nh8mFQTp"} {
+			idx, err := tokens.AllocateStatusIndex(ctx, issuer)
+			require.NoError(t, err)
+			require.NoError(t, tokens.Create(ctx, issuer, newToken(id, "at_"+id, "", idx)))
+			require.Equal(t, uint64(i), idx)
+		}
+		require.NoError(t, tokens.Revoke(ctx, issuer, "kDuZ3rZ8"))
+
+		size, revoked, err := tokens.RevokedStatusIndexes(ctx, issuer)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), size)
+		require.Equal(t, []uint64{1}, revoked)
+
+		_, revoked, err = tokens.RevokedStatusIndexes(ctx, otherIssuer)
+		require.NoError(t, err)
+		require.Empty(t, revoked)
+	})
+
+	t.Run("revoke by grant flips the statuses", func(t *testing.T) {
+		tokens := factory(t)
+
+		for _, id := range []string{"pQ4jYeXv", "Fs2ZzK7w"} {
+			idx, err := tokens.AllocateStatusIndex(ctx, issuer)
+			require.NoError(t, err)
+			require.NoError(t, tokens.Create(ctx, issuer, newToken(id, "at_"+id, "rsx9p6bSMiAuqT8n", idx)))
+		}
+		require.NoError(t, tokens.RevokeByGrant(ctx, issuer, "rsx9p6bSMiAuqT8n"))
+
+		_, revoked, err := tokens.RevokedStatusIndexes(ctx, issuer)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uint64{0, 1}, revoked)
+	})
+
+	t.Run("revoke unindexed token", func(t *testing.T) {
+		tokens := factory(t)
+
+		tok := newToken("Wz5bV1cd", "at_Wz5bV1cd", "", 0)
+		tok.StatusList = nil
+		require.NoError(t, tokens.Create(ctx, issuer, tok))
+		require.NoError(t, tokens.Revoke(ctx, issuer, "Wz5bV1cd"))
+
+		_, revoked, err := tokens.RevokedStatusIndexes(ctx, issuer)
+		require.NoError(t, err)
+		require.Empty(t, revoked)
+	})
+}
@@ -0,0 +1,86 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trust
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"zntr.io/solid/sdk/jwk"
+)
+
+// ErrUntrustedIssuer is returned when the assertion issuer is not registered.
+var ErrUntrustedIssuer = errors.New("untrusted issuer")
+
+//go:generate mockgen -destination mock/registry.gen.go -package mock zntr.io/solid/server/trust Registry
+
+// Registry describes trusted external issuer registry contract.
+type Registry interface {
+	// Verify validates the given assertion against its issuer settings and
+	// returns the mapped local identity.
+	Verify(ctx context.Context, assertion string) (*Assertion, error)
+}
+
+// SubjectMapper maps verified external claims to a local subject.
+type SubjectMapper func(ctx context.Context, claims map[string]any) (string, error)
+
+// ScopeMapping grants scopes when the claim matches the given value. Array
+// claims match when one of their items matches.
+type ScopeMapping struct {
+	Claim  string
+	Value  string
+	Scopes []string
+}
+
+// Issuer describes trusted external issuer settings.
+type Issuer struct {
+	// Issuer is the expected `iss` claim value.
+	Issuer string
+	// KeySet returns the issuer signing keys.
+	KeySet jwk.KeySetProviderFunc
+	// SupportedAlgorithms lists accepted signature algorithms.
+	SupportedAlgorithms []jose.SignatureAlgorithm
+	// Audiences lists accepted `aud` claim values, one must match.
+	Audiences []string
+	// SubjectClaim is the claim used as subject, `sub` when blank.
+	SubjectClaim string
+	// SubjectPrefix is prepended to the subject to prevent collisions with
+	// local identities.
+	SubjectPrefix string
+	// SubjectMapper overrides subject claim and prefix rules when defined.
+	SubjectMapper SubjectMapper
+	// DefaultScopes are granted to every verified assertion.
+	DefaultScopes []string
+	// ScopeMappings grants additional scopes from assertion claims.
+	ScopeMappings []ScopeMapping
+}
+
+// Assertion describes a verified external assertion.
+type Assertion struct {
+	Issuer    string
+	Subject   string
+	Scope     string
+	Audience  []string
+	JTI       string
+	IssuedAt  uint64
+	NotBefore uint64
+	ExpiresAt uint64
+	Claims    map[string]any
+}
@@ -0,0 +1,244 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trust
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+
+	"zntr.io/solid/sdk/token"
+	"zntr.io/solid/sdk/token/jwt"
+	"zntr.io/solid/sdk/types"
+)
+
+var timeFunc = time.Now
+
+// signatureAlgorithms lists algorithms accepted to read the unverified
+// assertion issuer, the signature is then verified with issuer settings.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.EdDSA,
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.PS256, jose.PS384, jose.PS512,
+}
+
+// StaticRegistry returns a trusted issuer registry built from the given
+// issuer settings.
+func StaticRegistry(issuers ...*Issuer) (Registry, error) {
+	r := &staticRegistry{
+		issuers: map[string]*trustedIssuer{},
+	}
+
+	for _, iss := range issuers {
+		// Check settings
+		switch {
+		case iss == nil:
+			return nil, fmt.Errorf("unable to register nil issuer")
+		case iss.Issuer == "":
+			return nil, fmt.Errorf("issuer must not be blank")
+		case iss.KeySet == nil:
+			return nil, fmt.Errorf("issuer '%s' must have a key set provider", iss.Issuer)
+		case len(iss.SupportedAlgorithms) == 0:
+			return nil, fmt.Errorf("issuer '%s' must have supported algorithms", iss.Issuer)
+		case len(iss.Audiences) == 0:
+			return nil, fmt.Errorf("issuer '%s' must have allowed audiences", iss.Issuer)
+		default:
+		}
+		if _, ok := r.issuers[iss.Issuer]; ok {
+			return nil, fmt.Errorf("issuer '%s' is already registered", iss.Issuer)
+		}
+
+		// Register issuer
+		r.issuers[iss.Issuer] = &trustedIssuer{
+			Issuer:   iss,
+			verifier: jwt.DefaultVerifier(iss.KeySet, iss.SupportedAlgorithms),
+		}
+	}
+
+	// No error
+	return r, nil
+}
+
+// -----------------------------------------------------------------------------
+
+type staticRegistry struct {
+	issuers map[string]*trustedIssuer
+}
+
+type trustedIssuer struct {
+	*Issuer
+	verifier token.Verifier
+}
+
+type registeredClaims struct {
+	Issuer    string               `json:"iss"`
+	Audience  josejwt.Audience     `json:"aud"`
+	Expiry    *josejwt.NumericDate `json:"exp"`
+	NotBefore *josejwt.NumericDate `json:"nbf"`
+	IssuedAt  *josejwt.NumericDate `json:"iat"`
+	ID        string               `json:"jti"`
+}
+
+func (r *staticRegistry) Verify(ctx context.Context, assertion string) (*Assertion, error) {
+	// Check arguments
+	if assertion == "" {
+		return nil, fmt.Errorf("assertion must not be blank")
+	}
+
+	// Retrieve issuer from unverified claims
+	t, err := josejwt.ParseSigned(assertion, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse assertion: %w", err)
+	}
+	var unverified registeredClaims
+	if err := t.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return nil, fmt.Errorf("unable to decode assertion claims: %w", err)
+	}
+	iss, ok := r.issuers[unverified.Issuer]
+	if !ok {
+		return nil, fmt.Errorf("issuer '%s': %w", unverified.Issuer, ErrUntrustedIssuer)
+	}
+
+	// Verify signature
+	var claims map[string]any
+	if err := iss.verifier.Claims(ctx, assertion, &claims); err != nil {
+		return nil, fmt.Errorf("unable to verify assertion: %w", err)
+	}
+
+	// Decode registered claims
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode assertion claims: %w", err)
+	}
+	var rc registeredClaims
+	if err := json.Unmarshal(payload, &rc); err != nil {
+		return nil, fmt.Errorf("unable to decode assertion registered claims: %w", err)
+	}
+
+	// Check claims
+	now := timeFunc()
+	switch {
+	case rc.Issuer != iss.Issuer.Issuer:
+		return nil, fmt.Errorf("assertion issuer mismatch")
+	case rc.Expiry == nil:
+		return nil, fmt.Errorf("assertion must have an expiration")
+	case now.After(rc.Expiry.Time()):
+		return nil, fmt.Errorf("assertion is expired")
+	case rc.NotBefore != nil && now.Before(rc.NotBefore.Time()):
+		return nil, fmt.Errorf("assertion is not yet valid")
+	case !types.StringArray(iss.Audiences).HasOneOf(rc.Audience...):
+		return nil, fmt.Errorf("assertion audience is not accepted")
+	default:
+	}
+
+	// Map subject
+	sub, err := iss.subject(ctx, claims)
+	if err != nil {
+		return nil, fmt.Errorf("unable to map assertion subject: %w", err)
+	}
+
+	// Prepare result
+	res := &Assertion{
+		Issuer:    rc.Issuer,
+		Subject:   sub,
+		Scope:     strings.Join(iss.scopes(claims), " "),
+		Audience:  rc.Audience,
+		JTI:       rc.ID,
+		ExpiresAt: uint64(rc.Expiry.Time().Unix()),
+		Claims:    claims,
+	}
+	if rc.IssuedAt != nil {
+		res.IssuedAt = uint64(rc.IssuedAt.Time().Unix())
+	}
+	if rc.NotBefore != nil {
+		res.NotBefore = uint64(rc.NotBefore.Time().Unix())
+	}
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (iss *trustedIssuer) subject(ctx context.Context, claims map[string]any) (string, error) {
+	// Delegate to custom mapper
+	if iss.SubjectMapper != nil {
+		sub, err := iss.SubjectMapper(ctx, claims)
+		if err != nil {
+			return "", err
+		}
+		if sub == "" {
+			return "", fmt.Errorf("mapped subject must not be blank")
+		}
+
+		return sub, nil
+	}
+
+	// Extract subject claim
+	claim := iss.SubjectClaim
+	if claim == "" {
+		claim = "sub"
+	}
+	sub, ok := claims[claim].(string)
+	if !ok || sub == "" {
+		return "", fmt.Errorf("claim '%s' must be a non blank string", claim)
+	}
+
+	// No error
+	return iss.SubjectPrefix + sub, nil
+}
+
+func (iss *trustedIssuer) scopes(claims map[string]any) []string {
+	scopes := types.StringArray{}
+	for _, s := range iss.DefaultScopes {
+		scopes.AddIfNotContains(s)
+	}
+
+	for _, m := range iss.ScopeMappings {
+		if !claimMatches(claims[m.Claim], m.Value) {
+			continue
+		}
+		for _, s := range m.Scopes {
+			scopes.AddIfNotContains(s)
+		}
+	}
+
+	return scopes
+}
+
+func claimMatches(claim any, value string) bool {
+	switch v := claim.(type) {
+	case string:
+		return v == value
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s == value {
+				return true
+			}
+		}
+	default:
+	}
+
+	return false
+}
@@ -0,0 +1,302 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package trust
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+)
+
+func mustKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return pk
+}
+
+func mustSign(t *testing.T, pk *ecdsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.ES256,
+		Key:       jose.JSONWebKey{Key: pk, KeyID: "partner-1"},
+	}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatalf("unable to prepare signer: %v", err)
+	}
+
+	raw, err := josejwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		t.Fatalf("unable to sign assertion: %v", err)
+	}
+
+	return raw
+}
+
+func keySet(pk *ecdsa.PrivateKey) func(context.Context) (*jose.JSONWebKeySet, error) {
+	return func(_ context.Context) (*jose.JSONWebKeySet, error) {
+		return &jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{
+				{Key: pk.Public(), KeyID: "partner-1", Algorithm: string(jose.ES256), Use: "sig"},
+			},
+		}, nil
+	}
+}
+
+func TestStaticRegistry(t *testing.T) {
+	pk := mustKey(t)
+
+	tests := []struct {
+		name    string
+		issuers []*Issuer
+		wantErr bool
+	}{
+		{
+			name:    "nil issuer",
+			issuers: []*Issuer{nil},
+			wantErr: true,
+		},
+		{
+			name:    "blank issuer",
+			issuers: []*Issuer{{}},
+			wantErr: true,
+		},
+		{
+			name: "missing key set",
+			issuers: []*Issuer{
+				{Issuer: "https://partner.example.com", SupportedAlgorithms: []jose.SignatureAlgorithm{jose.ES256}, Audiences: []string{"https://as.example.com"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing algorithms",
+			issuers: []*Issuer{
+				{Issuer: "https://partner.example.com", KeySet: keySet(pk), Audiences: []string{"https://as.example.com"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing audiences",
+			issuers: []*Issuer{
+				{Issuer: "https://partner.example.com", KeySet: keySet(pk), SupportedAlgorithms: []jose.SignatureAlgorithm{jose.ES256}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate issuer",
+			issuers: []*Issuer{
+				{Issuer: "https://partner.example.com", KeySet: keySet(pk), SupportedAlgorithms: []jose.SignatureAlgorithm{jose.ES256}, Audiences: []string{"https://as.example.com"}},
+				{Issuer: "https://partner.example.com", KeySet: keySet(pk), SupportedAlgorithms: []jose.SignatureAlgorithm{jose.ES256}, Audiences: []string{"https://as.example.com"}},
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			issuers: []*Issuer{
+				{Issuer: "https://partner.example.com", KeySet: keySet(pk), SupportedAlgorithms: []jose.SignatureAlgorithm{jose.ES256}, Audiences: []string{"https://as.example.com"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := StaticRegistry(tt.issuers...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StaticRegistry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_staticRegistry_Verify(t *testing.T) {
+	pk := mustKey(t)
+	otherKey := mustKey(t)
+
+	timeFunc = func() time.Time { return time.Unix(1000, 0) }
+	defer func() { timeFunc = time.Now }()
+
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":    "https://partner.example.com",
+			"sub":    "jdoe",
+			"aud":    "https://as.example.com",
+			"iat":    900,
+			"exp":    1900,
+			"jti":    "abc",
+			"groups": []string{"admins", "users"},
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+
+	underTest, err := StaticRegistry(
+		&Issuer{
+			Issuer:              "https://partner.example.com",
+			KeySet:              keySet(pk),
+			SupportedAlgorithms: []jose.SignatureAlgorithm{jose.ES256},
+			Audiences:           []string{"https://as.example.com"},
+			SubjectPrefix:       "partner|",
+			DefaultScopes:       []string{"profile"},
+			ScopeMappings: []ScopeMapping{
+				{Claim: "groups", Value: "admins", Scopes: []string{"admin", "profile"}},
+				{Claim: "groups", Value: "auditors", Scopes: []string{"audit"}},
+			},
+		},
+		&Issuer{
+			Issuer:              "https://mapped.example.com",
+			KeySet:              keySet(pk),
+			SupportedAlgorithms: []jose.SignatureAlgorithm{jose.ES256},
+			Audiences:           []string{"https://as.example.com"},
+			SubjectMapper: func(_ context.Context, claims map[string]any) (string, error) {
+				email, ok := claims["email"].(string)
+				if !ok {
+					return "", fmt.Errorf("email is missing")
+				}
+				return email, nil
+			},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to build registry: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		assertion     string
+		want          *Assertion
+		wantErr       bool
+		wantUntrusted bool
+	}{
+		{
+			name:    "blank",
+			wantErr: true,
+		},
+		{
+			name:      "invalid",
+			assertion: "not-a-jwt",
+			wantErr:   true,
+		},
+		{
+			name:          "untrusted issuer",
+			assertion:     mustSign(t, pk, claims(map[string]any{"iss": "https://unknown.example.com"})),
+			wantErr:       true,
+			wantUntrusted: true,
+		},
+		{
+			name:      "invalid signature",
+			assertion: mustSign(t, otherKey, claims(nil)),
+			wantErr:   true,
+		},
+		{
+			name:      "missing expiration",
+			assertion: mustSign(t, pk, claims(map[string]any{"exp": nil})),
+			wantErr:   true,
+		},
+		{
+			name:      "expired",
+			assertion: mustSign(t, pk, claims(map[string]any{"exp": 999})),
+			wantErr:   true,
+		},
+		{
+			name:      "not yet valid",
+			assertion: mustSign(t, pk, claims(map[string]any{"nbf": 1100})),
+			wantErr:   true,
+		},
+		{
+			name:      "audience mismatch",
+			assertion: mustSign(t, pk, claims(map[string]any{"aud": "https://other.example.com"})),
+			wantErr:   true,
+		},
+		{
+			name:      "missing subject",
+			assertion: mustSign(t, pk, claims(map[string]any{"sub": nil})),
+			wantErr:   true,
+		},
+		{
+			name:      "subject mapper error",
+			assertion: mustSign(t, pk, claims(map[string]any{"iss": "https://mapped.example.com"})),
+			wantErr:   true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:      "valid",
+			assertion: mustSign(t, pk, claims(map[string]any{"aud": []string{"https://other.example.com", "https://as.example.com"}})),
+			want: &Assertion{
+				Issuer:    "https://partner.example.com",
+				Subject:   "partner|jdoe",
+				Scope:     "profile admin",
+				Audience:  []string{"https://other.example.com", "https://as.example.com"},
+				JTI:       "abc",
+				IssuedAt:  900,
+				ExpiresAt: 1900,
+			},
+		},
+		{
+			name:      "valid - subject mapper",
+			assertion: mustSign(t, pk, claims(map[string]any{"iss": "https://mapped.example.com", "email": "jdoe@partner.example.com", "groups": nil})),
+			want: &Assertion{
+				Issuer:    "https://mapped.example.com",
+				Subject:   "jdoe@partner.example.com",
+				Audience:  []string{"https://as.example.com"},
+				JTI:       "abc",
+				IssuedAt:  900,
+				ExpiresAt: 1900,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := underTest.Verify(context.Background(), tt.assertion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("staticRegistry.Verify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrUntrustedIssuer) != tt.wantUntrusted {
+				t.Errorf("staticRegistry.Verify() error = %v, wantUntrusted %v", err, tt.wantUntrusted)
+				return
+			}
+			if got != nil {
+				// Ignore raw claims
+				got.Claims = nil
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("staticRegistry.Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}